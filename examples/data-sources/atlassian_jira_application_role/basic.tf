@@ -0,0 +1,3 @@
+data "atlassian_jira_application_role" "example" {
+  key = "jira-software"
+}
@@ -0,0 +1,4 @@
+data "atlassian_jira_field" "example" {
+  name = "Story Points"
+  type = "com.atlassian.jira.plugin.system.customfieldtypes:float"
+}
@@ -0,0 +1,3 @@
+data "atlassian_jira_fields" "example" {
+  is_custom = true
+}
@@ -0,0 +1,6 @@
+data "atlassian_jira_myself" "example" {}
+
+data "atlassian_jira_global_permission" "example" {
+  key        = "ADMINISTER"
+  account_id = data.atlassian_jira_myself.example.account_id
+}
@@ -1,3 +1,7 @@
 data "atlassian_jira_group" "example" {
   name = "foo"
 }
+
+data "atlassian_jira_group" "example_by_id" {
+  group_id = data.atlassian_jira_group.example.group_id
+}
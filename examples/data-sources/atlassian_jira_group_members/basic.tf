@@ -0,0 +1,3 @@
+data "atlassian_jira_group_members" "example" {
+  group_name = "jira-software-users"
+}
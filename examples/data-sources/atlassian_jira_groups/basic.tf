@@ -0,0 +1,3 @@
+data "atlassian_jira_groups" "example" {
+  name_prefix = "team-"
+}
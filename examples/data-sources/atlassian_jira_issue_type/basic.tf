@@ -1,5 +1,5 @@
 data "atlassian_jira_issue_type" "example" {
-  id = "10000" // default id of epic issue type
+  name = "Epic"
 }
 
 resource "atlassian_jira_issue_type_scheme" "example" {
@@ -0,0 +1,3 @@
+data "atlassian_jira_notification_scheme" "example" {
+  name = "Default Notification Scheme"
+}
@@ -1,3 +1,3 @@
 data "atlassian_jira_permission_scheme" "example" {
-  id = "10000"
+  name = "Default Permission Scheme"
 }
@@ -0,0 +1,5 @@
+data "atlassian_jira_permission_schemes" "example" {}
+
+output "permission_scheme_grants" {
+  value = { for s in data.atlassian_jira_permission_schemes.example.permission_schemes : s.name => s.grants_count }
+}
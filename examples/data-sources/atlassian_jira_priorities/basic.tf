@@ -0,0 +1,5 @@
+data "atlassian_jira_priorities" "example" {}
+
+output "priority_ids" {
+  value = { for p in data.atlassian_jira_priorities.example.priorities : p.name => p.id }
+}
@@ -0,0 +1,3 @@
+data "atlassian_jira_priority_scheme" "example" {
+  name = "Default Priority Scheme"
+}
@@ -0,0 +1,3 @@
+data "atlassian_jira_project" "example" {
+  key = "FOO"
+}
@@ -0,0 +1,7 @@
+data "atlassian_jira_project_roles" "developers" {
+  name = "Developers"
+}
+
+output "developers_role_id" {
+  value = data.atlassian_jira_project_roles.developers.project_roles[0].id
+}
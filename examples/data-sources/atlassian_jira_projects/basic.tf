@@ -0,0 +1,5 @@
+data "atlassian_jira_projects" "example" {
+  query     = "platform"
+  type_keys = ["software"]
+  order_by  = "name"
+}
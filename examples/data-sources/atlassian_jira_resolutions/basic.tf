@@ -0,0 +1,5 @@
+data "atlassian_jira_resolutions" "example" {}
+
+output "resolution_ids" {
+  value = { for r in data.atlassian_jira_resolutions.example.resolutions : r.name => r.id }
+}
@@ -0,0 +1,3 @@
+data "atlassian_jira_status" "example" {
+  name = "In Progress"
+}
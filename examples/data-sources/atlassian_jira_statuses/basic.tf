@@ -0,0 +1,3 @@
+data "atlassian_jira_statuses" "example" {
+  status_category = "DONE"
+}
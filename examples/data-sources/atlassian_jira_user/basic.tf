@@ -0,0 +1,3 @@
+data "atlassian_jira_user" "example" {
+  email_address = "jane.doe@example.com"
+}
@@ -0,0 +1,3 @@
+data "atlassian_jira_users" "example" {
+  group_name = "jira-software-users"
+}
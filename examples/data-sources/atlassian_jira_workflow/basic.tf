@@ -0,0 +1,3 @@
+data "atlassian_jira_workflow" "example" {
+  name = "jira"
+}
@@ -0,0 +1,3 @@
+data "atlassian_jira_workflow_scheme" "example" {
+  name = "foo"
+}
@@ -0,0 +1,4 @@
+data "atlassian_jira_workflows" "example" {
+  query     = "Software"
+  is_active = true
+}
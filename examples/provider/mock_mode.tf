@@ -0,0 +1,4 @@
+provider "atlassian" {
+  mock_mode    = true
+  fixtures_dir = "${path.module}/fixtures"
+}
@@ -0,0 +1,5 @@
+resource "atlassian_jira_announcement_banner" "example" {
+  message     = "Jira will be unavailable on Saturday from 02:00 to 04:00 UTC for scheduled maintenance."
+  dismissible = true
+  visibility  = "private"
+}
@@ -0,0 +1,5 @@
+resource "atlassian_jira_automation_rule" "example" {
+  rule        = file("${path.module}/rules/close-stale-issues.json")
+  enabled     = true
+  project_ids = [atlassian_jira_project.example.id]
+}
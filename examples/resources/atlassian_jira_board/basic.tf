@@ -0,0 +1,6 @@
+resource "atlassian_jira_board" "example" {
+  name       = "FOO board"
+  type       = "scrum"
+  filter_id  = atlassian_jira_filter.example.id
+  project_id = atlassian_jira_project.example.id
+}
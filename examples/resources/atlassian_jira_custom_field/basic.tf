@@ -0,0 +1,6 @@
+resource "atlassian_jira_custom_field" "example" {
+  name         = "Customer Reference"
+  description  = "The reference number provided by the customer."
+  type         = "com.atlassian.jira.plugin.system.customfieldtypes:textfield"
+  searcher_key = "com.atlassian.jira.plugin.system.customfieldtypes:textsearcher"
+}
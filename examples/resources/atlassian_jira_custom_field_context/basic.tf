@@ -0,0 +1,10 @@
+resource "atlassian_jira_custom_field" "example" {
+  name = "Customer Reference"
+  type = "com.atlassian.jira.plugin.system.customfieldtypes:textfield"
+}
+
+resource "atlassian_jira_custom_field_context" "example" {
+  field_id    = atlassian_jira_custom_field.example.id
+  name        = "Global context"
+  description = "Applies to all projects and issue types."
+}
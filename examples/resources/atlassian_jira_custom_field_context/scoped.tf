@@ -0,0 +1,6 @@
+resource "atlassian_jira_custom_field_context" "example" {
+  field_id       = atlassian_jira_custom_field.example.id
+  name           = "Support context"
+  project_ids    = [atlassian_jira_project.support.id]
+  issue_type_ids = [atlassian_jira_issue_type.incident.id]
+}
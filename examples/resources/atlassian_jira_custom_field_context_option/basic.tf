@@ -0,0 +1,22 @@
+resource "atlassian_jira_custom_field" "example" {
+  name = "Severity"
+  type = "com.atlassian.jira.plugin.system.customfieldtypes:select"
+}
+
+resource "atlassian_jira_custom_field_context" "example" {
+  field_id = atlassian_jira_custom_field.example.id
+  name     = "Global context"
+}
+
+resource "atlassian_jira_custom_field_context_option" "low" {
+  field_id   = atlassian_jira_custom_field.example.id
+  context_id = atlassian_jira_custom_field_context.example.id
+  value      = "Low"
+}
+
+resource "atlassian_jira_custom_field_context_option" "high" {
+  field_id        = atlassian_jira_custom_field.example.id
+  context_id      = atlassian_jira_custom_field_context.example.id
+  value           = "High"
+  after_option_id = atlassian_jira_custom_field_context_option.low.id
+}
@@ -0,0 +1,12 @@
+resource "atlassian_jira_custom_field_context_option" "country" {
+  field_id   = atlassian_jira_custom_field.location.id
+  context_id = atlassian_jira_custom_field_context.location.id
+  value      = "Portugal"
+}
+
+resource "atlassian_jira_custom_field_context_option" "city" {
+  field_id         = atlassian_jira_custom_field.location.id
+  context_id       = atlassian_jira_custom_field_context.location.id
+  value            = "Lisbon"
+  parent_option_id = atlassian_jira_custom_field_context_option.country.id
+}
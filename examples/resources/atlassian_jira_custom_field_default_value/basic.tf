@@ -0,0 +1,6 @@
+resource "atlassian_jira_custom_field_default_value" "example" {
+  field_id   = atlassian_jira_custom_field.example.id
+  context_id = atlassian_jira_custom_field_context.example.id
+  type       = "textfield"
+  text       = "N/A"
+}
@@ -0,0 +1,7 @@
+resource "atlassian_jira_custom_field_default_value" "example" {
+  field_id            = atlassian_jira_custom_field.location.id
+  context_id          = atlassian_jira_custom_field_context.location.id
+  type                = "option.cascading"
+  option_id           = atlassian_jira_custom_field_context_option.country.id
+  cascading_option_id = atlassian_jira_custom_field_context_option.city.id
+}
@@ -0,0 +1,9 @@
+resource "atlassian_jira_custom_field_default_value" "example" {
+  field_id   = atlassian_jira_custom_field.platforms.id
+  context_id = atlassian_jira_custom_field_context.platforms.id
+  type       = "option.multiple"
+  option_ids = [
+    atlassian_jira_custom_field_context_option.linux.id,
+    atlassian_jira_custom_field_context_option.macos.id,
+  ]
+}
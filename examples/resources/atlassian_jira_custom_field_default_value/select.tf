@@ -0,0 +1,6 @@
+resource "atlassian_jira_custom_field_default_value" "example" {
+  field_id   = atlassian_jira_custom_field.severity.id
+  context_id = atlassian_jira_custom_field_context.severity.id
+  type       = "option.single"
+  option_id  = atlassian_jira_custom_field_context_option.low.id
+}
@@ -0,0 +1,16 @@
+resource "atlassian_jira_dashboard" "example" {
+  name        = "Team Ops"
+  description = "Operational overview of the team"
+  share_permissions = [
+    {
+      type       = "project"
+      project_id = "10000"
+    },
+  ]
+  edit_permissions = [
+    {
+      type     = "group"
+      group_id = "276f955c-63d7-42c8-9520-92d01dca0625"
+    },
+  ]
+}
@@ -0,0 +1,14 @@
+resource "atlassian_jira_dashboard_gadget" "example" {
+  dashboard_id = atlassian_jira_dashboard.example.id
+  module_key   = "com.atlassian.jira.gadgets:filter-results-gadget"
+  title        = "Open bugs"
+  color        = "blue"
+  row          = 0
+  column       = 0
+  properties = {
+    config = jsonencode({
+      filterId = atlassian_jira_filter.example.id
+      num      = 10
+    })
+  }
+}
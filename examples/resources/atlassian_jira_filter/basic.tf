@@ -0,0 +1,6 @@
+resource "atlassian_jira_filter" "example" {
+  name        = "foo"
+  jql         = "project = FOO ORDER BY Rank ASC"
+  description = "bar"
+  favourite   = true
+}
@@ -0,0 +1,5 @@
+resource "atlassian_jira_filter_permission" "example" {
+  filter_id = atlassian_jira_filter.example.id
+  type      = "group"
+  group_id  = atlassian_jira_group.example.group_id
+}
@@ -0,0 +1,4 @@
+resource "atlassian_jira_group_membership" "example" {
+  group_id   = atlassian_jira_group.example.group_id
+  account_id = "1234567890"
+}
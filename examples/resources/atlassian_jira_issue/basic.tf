@@ -0,0 +1,16 @@
+resource "atlassian_jira_issue" "example" {
+  project_id    = atlassian_jira_project.example.id
+  issue_type_id = "10000"
+  summary       = "Platform migration"
+  description = jsonencode({
+    type    = "doc"
+    version = 1
+    content = [
+      {
+        type    = "paragraph"
+        content = [{ type = "text", text = "Epic referenced by dashboards and automation rules." }]
+      }
+    ]
+  })
+  labels = ["infrastructure"]
+}
@@ -0,0 +1,10 @@
+resource "atlassian_jira_issue" "example" {
+  project_id    = atlassian_jira_project.example.id
+  issue_type_id = "10000"
+  summary       = "Platform migration"
+
+  custom_fields = {
+    (atlassian_jira_custom_field.team.id)     = jsonencode("Platform")
+    (atlassian_jira_custom_field.priority.id) = jsonencode({ value = "High" })
+  }
+}
@@ -0,0 +1,13 @@
+resource "atlassian_jira_issue_field_configuration_scheme" "example" {
+  name = "Support Field Configuration Scheme"
+  mappings = [
+    {
+      issue_type_id          = "default"
+      field_configuration_id = atlassian_jira_issue_field_configuration.default.id
+    },
+    {
+      issue_type_id          = atlassian_jira_issue_type.incident.id
+      field_configuration_id = atlassian_jira_issue_field_configuration.incident.id
+    },
+  ]
+}
@@ -0,0 +1,4 @@
+resource "atlassian_jira_issue_field_configuration_scheme_project" "example" {
+  project_id                    = atlassian_jira_project.example.id
+  field_configuration_scheme_id = atlassian_jira_issue_field_configuration_scheme.example.id
+}
@@ -0,0 +1,8 @@
+resource "atlassian_jira_issue_field_option" "example" {
+  field_key = "example-app__team-field"
+  value     = "Platform"
+  properties = jsonencode({
+    leader = "jane.doe"
+    size   = 8
+  })
+}
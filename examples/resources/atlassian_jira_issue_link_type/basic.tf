@@ -0,0 +1,5 @@
+resource "atlassian_jira_issue_link_type" "example" {
+  name    = "Blocks"
+  inward  = "is blocked by"
+  outward = "blocks"
+}
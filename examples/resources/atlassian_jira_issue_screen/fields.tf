@@ -0,0 +1,4 @@
+resource "atlassian_jira_issue_screen" "example" {
+  name   = "foo"
+  fields = ["summary", "description", "assignee"]
+}
@@ -0,0 +1,16 @@
+resource "atlassian_jira_issue_security_level" "example" {
+  scheme_id   = atlassian_jira_issue_security_scheme.example.id
+  name        = "foo"
+  description = "bar"
+  is_default  = true
+
+  members = [
+    {
+      type      = "group"
+      parameter = "276f955c-63d7-42c8-9520-92d01dca0625"
+    },
+    {
+      type = "reporter"
+    },
+  ]
+}
@@ -0,0 +1,4 @@
+resource "atlassian_jira_issue_security_scheme" "example" {
+  name        = "foo"
+  description = "bar"
+}
@@ -0,0 +1,4 @@
+resource "atlassian_jira_issue_type_avatar" "example" {
+  issue_type_id  = atlassian_jira_issue_type.example.id
+  content_base64 = filebase64("${path.module}/avatar.png")
+}
@@ -0,0 +1,4 @@
+resource "atlassian_jira_issue_type_scheme_project" "example" {
+  project_id           = atlassian_jira_project.example.id
+  issue_type_scheme_id = atlassian_jira_issue_type_scheme.example.id
+}
@@ -0,0 +1,4 @@
+resource "atlassian_jira_issue_type_screen_scheme_project" "example" {
+  issue_type_screen_scheme_id = atlassian_jira_issue_type_screen_scheme.example.id
+  project_ids                 = [atlassian_jira_project.example.id]
+}
@@ -0,0 +1,4 @@
+resource "atlassian_jira_notification_scheme" "example" {
+  name        = "Example Notification Scheme"
+  description = "Example Jira Notification Scheme"
+}
@@ -0,0 +1,24 @@
+resource "atlassian_jira_notification_scheme" "example" {
+  name = "Example Notification Scheme"
+  notification_scheme_events = [
+    {
+      # Issue created
+      event_id = "1"
+      notifications = [
+        { notification_type = "Reporter" },
+        { notification_type = "CurrentAssignee" },
+      ]
+    },
+    {
+      # Issue resolved
+      event_id = "5"
+      notifications = [
+        { notification_type = "AllWatchers" },
+        {
+          notification_type = "EmailAddress"
+          parameter         = "team@example.com"
+        },
+      ]
+    },
+  ]
+}
@@ -0,0 +1,19 @@
+resource "atlassian_jira_notification_scheme" "example" {
+  name = "Example Notification Scheme"
+}
+
+resource "atlassian_jira_group" "example" {
+  name = "example-group"
+}
+
+resource "atlassian_jira_notification_scheme_event" "issue_created" {
+  notification_scheme_id = atlassian_jira_notification_scheme.example.id
+  event_id               = "1"
+  notifications = [
+    { notification_type = "ProjectLead" },
+    {
+      notification_type = "Group"
+      parameter         = atlassian_jira_group.example.group_id
+    },
+  ]
+}
@@ -0,0 +1,6 @@
+resource "atlassian_jira_priority" "example" {
+  name         = "Critical"
+  description  = "Issues that block the release."
+  icon_url     = "/images/icons/priorities/critical.png"
+  status_color = "#ff0000"
+}
@@ -0,0 +1,7 @@
+resource "atlassian_jira_priority_scheme" "example" {
+  name                = "Software priority scheme"
+  description         = "Priorities for software projects."
+  default_priority_id = "3"
+  priority_ids        = ["1", "2", "3", "4", "5"]
+  project_ids         = ["10000"]
+}
@@ -0,0 +1,8 @@
+data "atlassian_jira_myself" "example" {}
+
+resource "atlassian_jira_project" "example" {
+  key              = "FOO"
+  name             = "foo"
+  lead_account_id  = data.atlassian_jira_myself.example.account_id
+  project_type_key = "software"
+}
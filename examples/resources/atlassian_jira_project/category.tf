@@ -0,0 +1,13 @@
+data "atlassian_jira_myself" "example" {}
+
+resource "atlassian_jira_project_category" "example" {
+  name = "Engineering"
+}
+
+resource "atlassian_jira_project" "example" {
+  key              = "FOO"
+  name             = "foo"
+  lead_account_id  = data.atlassian_jira_myself.example.account_id
+  project_type_key = "software"
+  category_id      = atlassian_jira_project_category.example.id
+}
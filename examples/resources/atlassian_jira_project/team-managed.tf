@@ -0,0 +1,9 @@
+data "atlassian_jira_myself" "example" {}
+
+resource "atlassian_jira_project" "example" {
+  key                  = "FOO"
+  name                 = "foo"
+  lead_account_id      = data.atlassian_jira_myself.example.account_id
+  project_template_key = "com.pyxis.greenhopper.jira:gh-simplified-agility-kanban"
+  style                = "next-gen"
+}
@@ -0,0 +1,4 @@
+resource "atlassian_jira_project_avatar" "example" {
+  project_key    = atlassian_jira_project.example.key
+  content_base64 = filebase64("${path.module}/avatar.png")
+}
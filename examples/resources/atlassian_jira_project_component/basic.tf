@@ -0,0 +1,9 @@
+data "atlassian_jira_myself" "example" {}
+
+resource "atlassian_jira_project_component" "example" {
+  project_key     = "FOO"
+  name            = "Backend"
+  description     = "Server-side services."
+  lead_account_id = data.atlassian_jira_myself.example.account_id
+  assignee_type   = "COMPONENT_LEAD"
+}
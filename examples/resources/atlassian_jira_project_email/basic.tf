@@ -0,0 +1,4 @@
+resource "atlassian_jira_project_email" "example" {
+  project_id    = atlassian_jira_project.example.id
+  email_address = "support@example.com"
+}
@@ -0,0 +1,5 @@
+resource "atlassian_jira_project_feature" "example" {
+  project_key = atlassian_jira_project.example.key
+  feature     = "jsw.agility.releases"
+  enabled     = false
+}
@@ -0,0 +1,4 @@
+resource "atlassian_jira_project_issue_security_scheme" "example" {
+  project_id               = atlassian_jira_project.example.id
+  issue_security_scheme_id = atlassian_jira_issue_security_scheme.example.id
+}
@@ -0,0 +1,8 @@
+resource "atlassian_jira_project_issue_security_scheme" "example" {
+  project_id               = atlassian_jira_project.example.id
+  issue_security_scheme_id = atlassian_jira_issue_security_scheme.new.id
+
+  level_mappings = {
+    (atlassian_jira_issue_security_level.old_internal.id) = atlassian_jira_issue_security_level.new_internal.id
+  }
+}
@@ -0,0 +1,4 @@
+resource "atlassian_jira_project_notification_scheme" "example" {
+  project_id             = atlassian_jira_project.example.id
+  notification_scheme_id = atlassian_jira_notification_scheme.example.id
+}
@@ -0,0 +1,4 @@
+resource "atlassian_jira_project_permission_scheme" "example" {
+  project_id           = atlassian_jira_project.example.id
+  permission_scheme_id = atlassian_jira_permission_scheme.example.id
+}
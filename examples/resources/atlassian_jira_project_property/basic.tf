@@ -0,0 +1,8 @@
+resource "atlassian_jira_project_property" "example" {
+  project_id = atlassian_jira_project.example.id
+  key        = "my-app-config"
+  value = jsonencode({
+    enabled = true
+    theme   = "dark"
+  })
+}
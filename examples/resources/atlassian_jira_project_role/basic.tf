@@ -0,0 +1,4 @@
+resource "atlassian_jira_project_role" "example" {
+  name        = "Developers"
+  description = "A project role that represents developers in a project."
+}
@@ -0,0 +1,12 @@
+data "atlassian_jira_myself" "example" {}
+
+resource "atlassian_jira_project_role" "example" {
+  name = "Developers"
+}
+
+resource "atlassian_jira_project_role_actor" "example" {
+  project_id = "10000"
+  role_id    = atlassian_jira_project_role.example.id
+  users      = [data.atlassian_jira_myself.example.account_id]
+  groups     = ["team-x"]
+}
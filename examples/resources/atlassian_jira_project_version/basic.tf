@@ -0,0 +1,7 @@
+resource "atlassian_jira_project_version" "example" {
+  project_id   = "10000"
+  name         = "v1.0.0"
+  description  = "First release train."
+  start_date   = "2024-01-08"
+  release_date = "2024-03-29"
+}
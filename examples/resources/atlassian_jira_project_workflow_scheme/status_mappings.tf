@@ -0,0 +1,12 @@
+resource "atlassian_jira_project_workflow_scheme" "example" {
+  project_id         = atlassian_jira_project.example.id
+  workflow_scheme_id = atlassian_jira_workflow_scheme.example.id
+
+  status_mappings = [
+    {
+      issue_type_id = "10001"
+      old_status_id = "10002"
+      new_status_id = "3"
+    },
+  ]
+}
@@ -0,0 +1,5 @@
+resource "atlassian_jira_resolution" "example" {
+  name                      = "Won't Fix"
+  description               = "The problem described is an issue which will never be fixed."
+  replacement_resolution_id = "10000"
+}
@@ -0,0 +1,5 @@
+resource "atlassian_jira_screen_tab" "example" {
+  screen_id = atlassian_jira_issue_screen.example.id
+  name      = "foo"
+  position  = 0
+}
@@ -0,0 +1,13 @@
+resource "atlassian_jira_screen_tab_field" "summary" {
+  screen_id = atlassian_jira_issue_screen.example.id
+  tab_id    = atlassian_jira_screen_tab.example.id
+  field_id  = "summary"
+  position  = "First"
+}
+
+resource "atlassian_jira_screen_tab_field" "description" {
+  screen_id = atlassian_jira_issue_screen.example.id
+  tab_id    = atlassian_jira_screen_tab.example.id
+  field_id  = "description"
+  after     = atlassian_jira_screen_tab_field.summary.field_id
+}
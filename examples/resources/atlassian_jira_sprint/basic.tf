@@ -0,0 +1,8 @@
+resource "atlassian_jira_sprint" "example" {
+  board_id   = atlassian_jira_board.example.id
+  name       = "Sprint 1"
+  goal       = "Ship the first increment"
+  start_date = "2024-01-08T09:00:00Z"
+  end_date   = "2024-01-22T17:00:00Z"
+  state      = "active"
+}
@@ -0,0 +1,6 @@
+resource "atlassian_jira_time_tracking_settings" "example" {
+  working_hours_per_day = 7.5
+  working_days_per_week = 5
+  time_format           = "pretty"
+  default_unit          = "hour"
+}
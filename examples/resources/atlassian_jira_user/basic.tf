@@ -0,0 +1,4 @@
+resource "atlassian_jira_user" "example" {
+  email_address = "foo@example.com"
+  products      = ["jira-software"]
+}
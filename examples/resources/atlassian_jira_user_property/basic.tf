@@ -0,0 +1,8 @@
+resource "atlassian_jira_user_property" "example" {
+  account_id = "5b10a2844c20165700ede21g"
+  key        = "my-app-config"
+  value = jsonencode({
+    enabled = true
+    theme   = "dark"
+  })
+}
@@ -0,0 +1,6 @@
+resource "atlassian_jira_webhook" "example" {
+  name       = "Example Webhook"
+  url        = "https://example.com/webhook"
+  jql_filter = "project = FOO"
+  events     = ["jira:issue_created", "jira:issue_updated"]
+}
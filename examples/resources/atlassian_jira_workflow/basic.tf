@@ -0,0 +1,42 @@
+resource "atlassian_jira_status" "todo" {
+  name            = "To Do"
+  status_category = "TODO"
+  status_scope = {
+    type = "GLOBAL"
+  }
+}
+
+resource "atlassian_jira_status" "done" {
+  name            = "Done"
+  status_category = "DONE"
+  status_scope = {
+    type = "GLOBAL"
+  }
+}
+
+resource "atlassian_jira_workflow" "example" {
+  name        = "Example Workflow"
+  description = "Example Jira Workflow"
+  statuses = [
+    { id = atlassian_jira_status.todo.id },
+    { id = atlassian_jira_status.done.id },
+  ]
+  transitions = [
+    {
+      name = "Create"
+      to   = atlassian_jira_status.todo.id
+      type = "initial"
+    },
+    {
+      name = "Done"
+      from = [atlassian_jira_status.todo.id]
+      to   = atlassian_jira_status.done.id
+      type = "directed"
+    },
+    {
+      name = "Reopen"
+      to   = atlassian_jira_status.todo.id
+      type = "global"
+    },
+  ]
+}
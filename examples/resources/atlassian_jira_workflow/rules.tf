@@ -0,0 +1,47 @@
+resource "atlassian_jira_workflow" "example" {
+  name = "Example Workflow with Rules"
+  statuses = [
+    { id = atlassian_jira_status.todo.id },
+    { id = atlassian_jira_status.done.id },
+  ]
+  transitions = [
+    {
+      name = "Create"
+      to   = atlassian_jira_status.todo.id
+      type = "initial"
+    },
+    {
+      name = "Done"
+      from = [atlassian_jira_status.todo.id]
+      to   = atlassian_jira_status.done.id
+      type = "directed"
+      rules = {
+        conditions = jsonencode({
+          operator = "AND"
+          conditions = [
+            { type = "AllowOnlyAssigneeCondition" },
+          ]
+        })
+        validators = [
+          {
+            type = "FieldRequiredValidator"
+            configuration = jsonencode({
+              ignoreContext = true
+              errorMessage  = "A resolution is required"
+              fieldIds      = ["resolution"]
+            })
+          },
+        ]
+        post_functions = [
+          {
+            type = "UpdateIssueFieldFunction"
+            configuration = jsonencode({
+              fieldId    = "assignee"
+              fieldValue = ""
+            })
+          },
+        ]
+      }
+    },
+  ]
+}
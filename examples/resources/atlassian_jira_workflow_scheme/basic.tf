@@ -0,0 +1,4 @@
+resource "atlassian_jira_workflow_scheme" "example" {
+  name        = "Example Workflow Scheme"
+  description = "Example Jira Workflow Scheme"
+}
@@ -0,0 +1,14 @@
+resource "atlassian_jira_issue_type" "example" {
+  name = "foo"
+}
+
+resource "atlassian_jira_workflow_scheme" "example" {
+  name             = "Example Workflow Scheme"
+  default_workflow = "jira"
+  issue_type_mappings = {
+    (atlassian_jira_issue_type.example.id) = "jira"
+  }
+
+  # Allows changes to be stored in a draft while the scheme is used by a project.
+  update_draft_if_needed = true
+}
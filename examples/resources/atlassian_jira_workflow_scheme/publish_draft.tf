@@ -0,0 +1,16 @@
+resource "atlassian_jira_workflow_scheme" "example" {
+  name             = "Example Workflow Scheme"
+  default_workflow = atlassian_jira_workflow.example.name
+
+  update_draft_if_needed = true
+  publish_draft          = true
+
+  # Moves the issues in the "In Review" status, which the new default workflow lacks, to "In Progress".
+  draft_status_mappings = [
+    {
+      issue_type_id = "10001"
+      status_id     = "10002"
+      new_status_id = "3"
+    },
+  ]
+}
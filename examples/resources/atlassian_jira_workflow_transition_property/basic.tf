@@ -0,0 +1,6 @@
+resource "atlassian_jira_workflow_transition_property" "example" {
+  workflow_name = "Example Workflow"
+  transition_id = "11"
+  key           = "opsbar-sequence"
+  value         = "10"
+}
@@ -0,0 +1,165 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraApplicationRoleDataSource struct {
+		p atlassianProvider
+	}
+
+	jiraApplicationRoleDataSourceModel struct {
+		ID                types.String `tfsdk:"id"`
+		Key               types.String `tfsdk:"key"`
+		Name              types.String `tfsdk:"name"`
+		Groups            types.Set    `tfsdk:"groups"`
+		DefaultGroups     types.Set    `tfsdk:"default_groups"`
+		SelectedByDefault types.Bool   `tfsdk:"selected_by_default"`
+		Defined           types.Bool   `tfsdk:"defined"`
+		NumberOfSeats     types.Int64  `tfsdk:"number_of_seats"`
+		RemainingSeats    types.Int64  `tfsdk:"remaining_seats"`
+		UserCount         types.Int64  `tfsdk:"user_count"`
+		HasUnlimitedSeats types.Bool   `tfsdk:"has_unlimited_seats"`
+		Platform          types.Bool   `tfsdk:"platform"`
+	}
+)
+
+var (
+	_ datasource.DataSource = (*jiraApplicationRoleDataSource)(nil)
+)
+
+func NewJiraApplicationRoleDataSource() datasource.DataSource {
+	return &jiraApplicationRoleDataSource{}
+}
+
+func (*jiraApplicationRoleDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_application_role"
+}
+
+func (*jiraApplicationRoleDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Jira Application Role Data Source",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the application role. Defaults to `key`.",
+				Computed:            true,
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "The key of the application role, e.g. `jira-software`, `jira-servicedesk` or `jira-product-discovery`.",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The display name of the application role.",
+				Computed:            true,
+			},
+			"groups": schema.SetAttribute{
+				MarkdownDescription: "The names of the groups associated with the application role.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"default_groups": schema.SetAttribute{
+				MarkdownDescription: "The names of the groups that new users are added to by default when granted access to the application.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"selected_by_default": schema.BoolAttribute{
+				MarkdownDescription: "Whether the application is selected by default when new users are created.",
+				Computed:            true,
+			},
+			"defined": schema.BoolAttribute{
+				MarkdownDescription: "Whether the application role is defined, i.e. the application is installed.",
+				Computed:            true,
+			},
+			"number_of_seats": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of users allowed by the license.",
+				Computed:            true,
+			},
+			"remaining_seats": schema.Int64Attribute{
+				MarkdownDescription: "The number of seats still available in the license.",
+				Computed:            true,
+			},
+			"user_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of users counting against the license.",
+				Computed:            true,
+			},
+			"has_unlimited_seats": schema.BoolAttribute{
+				MarkdownDescription: "Whether the license has unlimited seats.",
+				Computed:            true,
+			},
+			"platform": schema.BoolAttribute{
+				MarkdownDescription: "Whether the application role is the platform role, which is granted alongside every other application role.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *jiraApplicationRoleDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.p.jira = client
+}
+
+func (d *jiraApplicationRoleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Reading application role data source")
+
+	var newState jiraApplicationRoleDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &newState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded application role config", map[string]interface{}{
+		"readConfig": fmt.Sprintf("%+v", newState),
+	})
+
+	role, res, err := d.p.jira.Role.Get(ctx, newState.Key.ValueString())
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get application role, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Retrieved application role from API state", map[string]interface{}{
+		"readApiState": fmt.Sprintf("%+v", role),
+	})
+
+	newState.ID = types.StringValue(role.Key)
+	newState.Name = types.StringValue(role.Name)
+	newState.Groups, _ = types.SetValueFrom(ctx, types.StringType, role.Groups)
+	newState.DefaultGroups, _ = types.SetValueFrom(ctx, types.StringType, role.DefaultGroups)
+	newState.SelectedByDefault = types.BoolValue(role.SelectedByDefault)
+	newState.Defined = types.BoolValue(role.Defined)
+	newState.NumberOfSeats = types.Int64Value(int64(role.NumberOfSeats))
+	newState.RemainingSeats = types.Int64Value(int64(role.RemainingSeats))
+	newState.UserCount = types.Int64Value(int64(role.UserCount))
+	newState.HasUnlimitedSeats = types.BoolValue(role.HasUnlimitedSeats)
+	newState.Platform = types.BoolValue(role.Platform)
+
+	tflog.Debug(ctx, "Storing application role into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", newState),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
@@ -0,0 +1,39 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraApplicationRoleDataSource_Basic(t *testing.T) {
+	dataSourceName := "data.atlassian_jira_application_role.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccApplicationRoleDataSourceConfig_basic(dataSourceName, "jira-software"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "jira-software"),
+					resource.TestCheckResourceAttr(dataSourceName, "key", "jira-software"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "groups.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "default_groups.#"),
+					resource.TestCheckResourceAttr(dataSourceName, "defined", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccApplicationRoleDataSourceConfig_basic(dataSourceName, key string) string {
+	splits := strings.Split(dataSourceName, ".")
+	return fmt.Sprintf(`
+	  data %[1]q %[2]q {
+		key = %[3]q
+	  }
+	`, splits[1], splits[2], key)
+}
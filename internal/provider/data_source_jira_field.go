@@ -0,0 +1,182 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraFieldDataSource struct {
+		p atlassianProvider
+	}
+
+	jiraFieldDataSourceModel struct {
+		ID          types.String `tfsdk:"id"`
+		Name        types.String `tfsdk:"name"`
+		Type        types.String `tfsdk:"type"`
+		Key         types.String `tfsdk:"key"`
+		Custom      types.Bool   `tfsdk:"custom"`
+		SchemaType  types.String `tfsdk:"schema_type"`
+		ClauseNames types.List   `tfsdk:"clause_names"`
+	}
+)
+
+var (
+	_ datasource.DataSource = (*jiraFieldDataSource)(nil)
+)
+
+func NewJiraFieldDataSource() datasource.DataSource {
+	return &jiraFieldDataSource{}
+}
+
+func (*jiraFieldDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_field"
+}
+
+func (*jiraFieldDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Jira Field Data Source",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the field, e.g. `summary` or `customfield_10000`. " +
+					"At least one of `id`, `name` or `type` must be provided.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.AtLeastOneOf(path.MatchRoot("name"), path.MatchRoot("type")),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the field (case-insensitive). " +
+					"At least one of `id`, `name` or `type` must be provided.",
+				Optional: true,
+				Computed: true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the custom field, e.g. `com.atlassian.jira.plugin.system.customfieldtypes:textfield`. Empty for system fields. " +
+					"At least one of `id`, `name` or `type` must be provided.",
+				Optional: true,
+				Computed: true,
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "The key of the field.",
+				Computed:            true,
+			},
+			"custom": schema.BoolAttribute{
+				MarkdownDescription: "Whether the field is a custom field.",
+				Computed:            true,
+			},
+			"schema_type": schema.StringAttribute{
+				MarkdownDescription: "The data type of the field values, e.g. `string`, `number`, `array` or `option`.",
+				Computed:            true,
+			},
+			"clause_names": schema.ListAttribute{
+				MarkdownDescription: "The names that can be used to reference the field in an advanced search.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (d *jiraFieldDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.p.jira = client
+}
+
+func (d *jiraFieldDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Reading field data source")
+
+	var newState jiraFieldDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &newState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded field config", map[string]interface{}{
+		"readConfig": fmt.Sprintf("%+v", newState),
+	})
+
+	fields, res, err := d.p.jira.Issue.Field.Gets(ctx)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get fields, got error: %s\n%s", err, resBody))
+		return
+	}
+
+	// Field names are not unique, so all provided attributes must match and the match must be unique.
+	var matches []*models.IssueFieldScheme
+	for _, field := range fields {
+		if !newState.ID.IsNull() && field.ID != newState.ID.ValueString() {
+			continue
+		}
+		if !newState.Name.IsNull() && !strings.EqualFold(field.Name, newState.Name.ValueString()) {
+			continue
+		}
+		if !newState.Type.IsNull() && (field.Schema == nil || field.Schema.Custom != newState.Type.ValueString()) {
+			continue
+		}
+		matches = append(matches, field)
+	}
+	switch len(matches) {
+	case 0:
+		resp.Diagnostics.AddError("Unable to find field", "No field matches the provided attributes.")
+		return
+	case 1:
+	default:
+		resp.Diagnostics.AddError("Unable to find field", fmt.Sprintf("Found %d fields matching the provided attributes, expected exactly one. "+
+			"Provide the `type` of the field to narrow down the search.", len(matches)))
+		return
+	}
+	field := matches[0]
+	tflog.Debug(ctx, "Retrieved field from API state", map[string]interface{}{
+		"readApiState": fmt.Sprintf("%+v", field),
+	})
+
+	newState.ID = types.StringValue(field.ID)
+	newState.Name = types.StringValue(field.Name)
+	newState.Key = types.StringValue(field.Key)
+	newState.Custom = types.BoolValue(field.Custom)
+	newState.Type = types.StringValue("")
+	newState.SchemaType = types.StringValue("")
+	if field.Schema != nil {
+		newState.Type = types.StringValue(field.Schema.Custom)
+		newState.SchemaType = types.StringValue(field.Schema.Type)
+	}
+	clauseNames, diags := types.ListValueFrom(ctx, types.StringType, field.ClauseNames)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	newState.ClauseNames = clauseNames
+
+	tflog.Debug(ctx, "Storing field into the state")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
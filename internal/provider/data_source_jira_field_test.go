@@ -0,0 +1,69 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraFieldDataSource_Name(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-field")
+	dataSourceName := "data.atlassian_jira_field.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFieldDataSourceConfig_name(dataSourceName, randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "atlassian_jira_custom_field.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", randomName),
+					resource.TestCheckResourceAttr(dataSourceName, "type", "com.atlassian.jira.plugin.system.customfieldtypes:textfield"),
+					resource.TestCheckResourceAttr(dataSourceName, "custom", "true"),
+					resource.TestCheckResourceAttr(dataSourceName, "schema_type", "string"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJiraFieldDataSource_SystemField(t *testing.T) {
+	dataSourceName := "data.atlassian_jira_field.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFieldDataSourceConfig_id(dataSourceName, "summary"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "summary"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", "Summary"),
+					resource.TestCheckResourceAttr(dataSourceName, "custom", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "type", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccFieldDataSourceConfig_name(dataSourceName, name string) string {
+	splits := strings.Split(dataSourceName, ".")
+	return testAccCustomFieldConfig_basic("atlassian_jira_custom_field.test", name) + fmt.Sprintf(`
+	data %[1]q %[2]q {
+		name = atlassian_jira_custom_field.test.name
+		type = atlassian_jira_custom_field.test.type
+	}
+	`, splits[1], splits[2])
+}
+
+func testAccFieldDataSourceConfig_id(dataSourceName, id string) string {
+	splits := strings.Split(dataSourceName, ".")
+	return fmt.Sprintf(`
+	data %[1]q %[2]q {
+		id = %[3]q
+	}
+	`, splits[1], splits[2], id)
+}
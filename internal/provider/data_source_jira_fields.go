@@ -0,0 +1,208 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraFieldsDataSource struct {
+		p atlassianProvider
+	}
+
+	jiraFieldsDataSourceModel struct {
+		ID       types.String                 `tfsdk:"id"`
+		IsCustom types.Bool                   `tfsdk:"is_custom"`
+		Type     types.String                 `tfsdk:"type"`
+		Fields   []jiraFieldsDataSourceFields `tfsdk:"fields"`
+	}
+
+	jiraFieldsDataSourceFields struct {
+		ID            types.String `tfsdk:"id"`
+		Key           types.String `tfsdk:"key"`
+		Name          types.String `tfsdk:"name"`
+		Description   types.String `tfsdk:"description"`
+		Custom        types.Bool   `tfsdk:"custom"`
+		Type          types.String `tfsdk:"type"`
+		SchemaType    types.String `tfsdk:"schema_type"`
+		ScreensCount  types.Int64  `tfsdk:"screens_count"`
+		ContextsCount types.Int64  `tfsdk:"contexts_count"`
+	}
+)
+
+// jiraFieldSearchMaxResults is the maximum number of fields returned by a single page of the field search.
+const jiraFieldSearchMaxResults = 50
+
+var (
+	_ datasource.DataSource = (*jiraFieldsDataSource)(nil)
+)
+
+func NewJiraFieldsDataSource() datasource.DataSource {
+	return &jiraFieldsDataSource{}
+}
+
+func (*jiraFieldsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_fields"
+}
+
+func (*jiraFieldsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Jira Fields Data Source",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the data source. Defaults to the host of the Jira site.",
+				Computed:            true,
+			},
+			"is_custom": schema.BoolAttribute{
+				MarkdownDescription: "Filter the fields by whether they are custom fields (`true`) or system fields (`false`). Defaults to all fields.",
+				Optional:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Filter the fields by the type of the custom field, e.g. `com.atlassian.jira.plugin.system.customfieldtypes:textfield`.",
+				Optional:            true,
+			},
+			"fields": schema.ListNestedAttribute{
+				MarkdownDescription: "The fields matching the filters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the field, e.g. `summary` or `customfield_10000`.",
+							Computed:            true,
+						},
+						"key": schema.StringAttribute{
+							MarkdownDescription: "The key of the field.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the field.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the field.",
+							Computed:            true,
+						},
+						"custom": schema.BoolAttribute{
+							MarkdownDescription: "Whether the field is a custom field.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of the custom field. Empty for system fields.",
+							Computed:            true,
+						},
+						"schema_type": schema.StringAttribute{
+							MarkdownDescription: "The data type of the field values, e.g. `string`, `number`, `array` or `option`.",
+							Computed:            true,
+						},
+						"screens_count": schema.Int64Attribute{
+							MarkdownDescription: "The number of screens where the field is used.",
+							Computed:            true,
+						},
+						"contexts_count": schema.Int64Attribute{
+							MarkdownDescription: "The number of contexts where the field is used.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *jiraFieldsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.p.jira = client
+}
+
+func (d *jiraFieldsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Reading fields data source")
+
+	var newState jiraFieldsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &newState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded fields config", map[string]interface{}{
+		"readConfig": fmt.Sprintf("%+v", newState),
+	})
+
+	options := &models.FieldSearchOptionsScheme{
+		Expand: []string{"key", "screensCount", "contextsCount"},
+	}
+	if !newState.IsCustom.IsNull() {
+		if newState.IsCustom.ValueBool() {
+			options.Types = []string{"custom"}
+		} else {
+			options.Types = []string{"system"}
+		}
+	}
+
+	var fields []*models.IssueFieldScheme
+	for startAt := 0; ; startAt += jiraFieldSearchMaxResults {
+		page, res, err := d.p.jira.Issue.Field.Search(ctx, options, startAt, jiraFieldSearchMaxResults)
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to search fields, got error: %s\n%s", err, resBody))
+			return
+		}
+		fields = append(fields, page.Values...)
+		if page.IsLast || len(page.Values) == 0 {
+			break
+		}
+	}
+	tflog.Debug(ctx, "Retrieved fields from API state", map[string]interface{}{
+		"readApiState": fmt.Sprintf("Fields Count:%d", len(fields)),
+	})
+
+	newState.ID = types.StringValue(d.p.jira.Site.Host)
+	newState.Fields = []jiraFieldsDataSourceFields{}
+	for _, field := range fields {
+		f := jiraFieldsDataSourceFields{
+			ID:            types.StringValue(field.ID),
+			Key:           types.StringValue(field.Key),
+			Name:          types.StringValue(field.Name),
+			Description:   types.StringValue(field.Description),
+			Custom:        types.BoolValue(field.Custom),
+			Type:          types.StringValue(""),
+			SchemaType:    types.StringValue(""),
+			ScreensCount:  types.Int64Value(int64(field.ScreensCount)),
+			ContextsCount: types.Int64Value(int64(field.ContextsCount)),
+		}
+		if field.Schema != nil {
+			f.Type = types.StringValue(field.Schema.Custom)
+			f.SchemaType = types.StringValue(field.Schema.Type)
+		}
+		// The field search cannot filter by the type of custom fields.
+		if !newState.Type.IsNull() && f.Type.ValueString() != newState.Type.ValueString() {
+			continue
+		}
+		newState.Fields = append(newState.Fields, f)
+	}
+
+	tflog.Debug(ctx, "Storing fields into the state")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
@@ -0,0 +1,42 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraFieldsDataSource_Basic(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-fields")
+	dataSourceName := "data.atlassian_jira_fields.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFieldsDataSourceConfig_basic(dataSourceName, randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "fields.*", map[string]string{
+						"name":          randomName,
+						"custom":        "true",
+						"type":          "com.atlassian.jira.plugin.system.customfieldtypes:textfield",
+						"screens_count": "0",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccFieldsDataSourceConfig_basic(dataSourceName, name string) string {
+	splits := strings.Split(dataSourceName, ".")
+	return testAccCustomFieldConfig_basic("atlassian_jira_custom_field.test", name) + fmt.Sprintf(`
+	data %[1]q %[2]q {
+		is_custom = true
+		type      = atlassian_jira_custom_field.test.type
+	}
+	`, splits[1], splits[2])
+}
@@ -0,0 +1,180 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraGlobalPermissionDataSource struct {
+		p atlassianProvider
+	}
+
+	jiraGlobalPermissionDataSourceModel struct {
+		ID          types.String `tfsdk:"id"`
+		Key         types.String `tfsdk:"key"`
+		AccountID   types.String `tfsdk:"account_id"`
+		Name        types.String `tfsdk:"name"`
+		Description types.String `tfsdk:"description"`
+		Granted     types.Bool   `tfsdk:"granted"`
+	}
+
+	// The go-atlassian library panics on permissions without a description.
+	jiraPermissions struct {
+		Permissions map[string]jiraPermission `json:"permissions"`
+	}
+
+	jiraPermission struct {
+		Key         string `json:"key"`
+		Name        string `json:"name"`
+		Type        string `json:"type"`
+		Description string `json:"description"`
+	}
+)
+
+var (
+	_ datasource.DataSource = (*jiraGlobalPermissionDataSource)(nil)
+)
+
+func NewJiraGlobalPermissionDataSource() datasource.DataSource {
+	return &jiraGlobalPermissionDataSource{}
+}
+
+func (*jiraGlobalPermissionDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_global_permission"
+}
+
+func (*jiraGlobalPermissionDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Jira Global Permission Data Source",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the global permission. Defaults to `key`.",
+				Computed:            true,
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "The key of the global permission, e.g. `ADMINISTER`, `SYSTEM_ADMIN`, `USER_PICKER` or `CREATE_SHARED_OBJECTS`.",
+				Required:            true,
+			},
+			"account_id": schema.StringAttribute{
+				MarkdownDescription: "The account ID of the user to check the global permission for. " +
+					"Defaults to the user whose credentials are used to configure the provider.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the global permission.",
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the global permission.",
+				Computed:            true,
+			},
+			"granted": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user is granted the global permission.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *jiraGlobalPermissionDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.p.jira = client
+}
+
+func (d *jiraGlobalPermissionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Reading global permission data source")
+
+	var newState jiraGlobalPermissionDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &newState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded global permission config", map[string]interface{}{
+		"readConfig": fmt.Sprintf("%+v", newState),
+	})
+
+	request, err := d.p.jira.NewRequest(ctx, http.MethodGet, "rest/api/3/permissions", "", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create permissions request, got error: %s", err))
+		return
+	}
+	var permissions jiraPermissions
+	res, err := d.p.jira.Call(request, &permissions)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get permissions, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Retrieved permissions from API state")
+
+	permission, ok := permissions.Permissions[newState.Key.ValueString()]
+	if !ok || permission.Type != "GLOBAL" {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find global permission with key %q", newState.Key.ValueString()))
+		return
+	}
+
+	grants, res, err := d.p.jira.Permission.Check(ctx, &models.PermissionCheckPayload{
+		GlobalPermissions: []string{newState.Key.ValueString()},
+		AccountID:         newState.AccountID.ValueString(),
+	})
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to check global permission, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Checked global permission in API state", map[string]interface{}{
+		"readApiState": fmt.Sprintf("%+v", grants),
+	})
+
+	granted := false
+	for _, g := range grants.GlobalPermissions {
+		if g == newState.Key.ValueString() {
+			granted = true
+			break
+		}
+	}
+
+	newState.ID = types.StringValue(newState.Key.ValueString())
+	newState.Name = types.StringValue(permission.Name)
+	newState.Description = types.StringValue(permission.Description)
+	newState.Granted = types.BoolValue(granted)
+
+	tflog.Debug(ctx, "Storing global permission into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", newState),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
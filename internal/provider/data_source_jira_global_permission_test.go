@@ -0,0 +1,41 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraGlobalPermissionDataSource_Basic(t *testing.T) {
+	dataSourceName := "data.atlassian_jira_global_permission.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlobalPermissionDataSourceConfig_basic(dataSourceName, "ADMINISTER"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "ADMINISTER"),
+					resource.TestCheckResourceAttr(dataSourceName, "key", "ADMINISTER"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "description"),
+					resource.TestCheckResourceAttr(dataSourceName, "granted", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGlobalPermissionDataSourceConfig_basic(dataSourceName, key string) string {
+	splits := strings.Split(dataSourceName, ".")
+	return fmt.Sprintf(`
+	  data "atlassian_jira_myself" "test" {}
+
+	  data %[1]q %[2]q {
+		key        = %[3]q
+		account_id = data.atlassian_jira_myself.test.account_id
+	  }
+	`, splits[1], splits[2], key)
+}
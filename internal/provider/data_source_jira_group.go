@@ -6,8 +6,11 @@ import (
 
 	jira "github.com/ctreminiom/go-atlassian/jira/v3"
 	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	common "github.com/openscientia/terraform-provider-atlassian/internal/provider/models"
@@ -48,12 +51,18 @@ func (*jiraGroupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 				Computed:            true,
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the group.",
-				Required:            true,
+				MarkdownDescription: "The name of the group. Exactly one of `name` or `group_id` must be provided.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("group_id")),
+				},
 			},
 			"group_id": schema.StringAttribute{
-				MarkdownDescription: "The ID of the group, which uniquely identifies the group across all Atlassian products.",
-				Computed:            true,
+				MarkdownDescription: "The ID of the group, which uniquely identifies the group across all Atlassian products. " +
+					"Exactly one of `name` or `group_id` must be provided.",
+				Optional: true,
+				Computed: true,
 			},
 			"self": schema.StringAttribute{
 				MarkdownDescription: "The URL for these group details.",
@@ -152,10 +161,15 @@ func (d *jiraGroupDataSource) Read(ctx context.Context, req datasource.ReadReque
 		"readConfig": fmt.Sprintf("%+v", newState),
 	})
 
-	opts := &models.GroupBulkOptionsScheme{
-		GroupNames: []string{newState.Name.ValueString()},
+	opts := &models.GroupBulkOptionsScheme{}
+	lookup := fmt.Sprintf("name %q", newState.Name.ValueString())
+	if !newState.GroupID.IsNull() {
+		opts.GroupIDs = []string{newState.GroupID.ValueString()}
+		lookup = fmt.Sprintf("ID %q", newState.GroupID.ValueString())
+	} else {
+		opts.GroupNames = []string{newState.Name.ValueString()}
 	}
-	group, res, err := d.p.jira.Group.Bulk(ctx, opts, 0, 1)
+	groups, res, err := d.p.jira.Group.Bulk(ctx, opts, 0, 1)
 	if err != nil {
 		var resBody string
 		if res != nil {
@@ -164,27 +178,19 @@ func (d *jiraGroupDataSource) Read(ctx context.Context, req datasource.ReadReque
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get group, got error: %s\n%s", err, resBody))
 		return
 	}
+	if len(groups.Values) == 0 {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find group with %s", lookup))
+		return
+	}
+	group := groups.Values[0]
 	tflog.Debug(ctx, "Retrieved group from API state", map[string]interface{}{
-		"readApiState": fmt.Sprintf("%+v", group.Values[0]),
+		"readApiState": fmt.Sprintf("%+v", group),
 	})
 
-	isLast := false
-	startAt := 0
-	maxResults := 100
-	members := []*models.GroupUserDetailScheme{}
-	for !isLast {
-		groupMembers, res, err := d.p.jira.Group.Members(ctx, newState.Name.ValueString(), true, startAt, maxResults)
-		if err != nil {
-			var resBody string
-			if res != nil {
-				resBody = res.Bytes.String()
-			}
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get group members, got error: %s\n%s", err, resBody))
-			return
-		}
-		startAt += maxResults
-		isLast = groupMembers.IsLast
-		members = append(members, groupMembers.Values...)
+	members, err := getJiraGroupMembers(ctx, d.p.jira, group.Name, true)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
 	}
 	tflog.Debug(ctx, "Retrieved group members from API state")
 
@@ -208,9 +214,10 @@ func (d *jiraGroupDataSource) Read(ctx context.Context, req datasource.ReadReque
 		users = append(users, *m)
 	}
 
-	newState.ID = types.StringValue(group.Values[0].GroupID)
-	newState.GroupID = types.StringValue(group.Values[0].GroupID)
-	newState.Self = types.StringValue(fmt.Sprintf("https://%s/rest/api/3/group?groupId=%s", d.p.jira.Site.Host, group.Values[0].GroupID))
+	newState.ID = types.StringValue(group.GroupID)
+	newState.Name = types.StringValue(group.Name)
+	newState.GroupID = types.StringValue(group.GroupID)
+	newState.Self = types.StringValue(fmt.Sprintf("https://%s/rest/api/3/group?groupId=%s", d.p.jira.Site.Host, group.GroupID))
 	newState.Users, _ = types.SetValueFrom(ctx, newState.Users.ElementType(ctx), users)
 
 	tflog.Debug(ctx, "Storing group into the state", map[string]interface{}{
@@ -0,0 +1,155 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraGroupMembersDataSource struct {
+		p atlassianProvider
+	}
+
+	jiraGroupMembersDataSourceModel struct {
+		ID              types.String                        `tfsdk:"id"`
+		GroupName       types.String                        `tfsdk:"group_name"`
+		IncludeInactive types.Bool                          `tfsdk:"include_inactive"`
+		Members         []jiraGroupMembersDataSourceMembers `tfsdk:"members"`
+	}
+
+	jiraGroupMembersDataSourceMembers struct {
+		AccountID    types.String `tfsdk:"account_id"`
+		EmailAddress types.String `tfsdk:"email_address"`
+		DisplayName  types.String `tfsdk:"display_name"`
+		Active       types.Bool   `tfsdk:"active"`
+		TimeZone     types.String `tfsdk:"timezone"`
+		AccountType  types.String `tfsdk:"account_type"`
+	}
+)
+
+var (
+	_ datasource.DataSource = (*jiraGroupMembersDataSource)(nil)
+)
+
+func NewJiraGroupMembersDataSource() datasource.DataSource {
+	return &jiraGroupMembersDataSource{}
+}
+
+func (*jiraGroupMembersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_group_members"
+}
+
+func (*jiraGroupMembersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Jira Group Members Data Source",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the data source. Defaults to `group_name`.",
+				Computed:            true,
+			},
+			"group_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the group.",
+				Required:            true,
+			},
+			"include_inactive": schema.BoolAttribute{
+				MarkdownDescription: "Whether inactive users are returned. Defaults to `false`.",
+				Optional:            true,
+			},
+			"members": schema.ListNestedAttribute{
+				MarkdownDescription: "The members of the group.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"account_id": schema.StringAttribute{
+							MarkdownDescription: "The account ID of the user, which uniquely identifies the user across all Atlassian products.",
+							Computed:            true,
+						},
+						"email_address": schema.StringAttribute{
+							MarkdownDescription: "The email address of the user. Depending on the user’s privacy settings, this may be returned as null.",
+							Computed:            true,
+						},
+						"display_name": schema.StringAttribute{
+							MarkdownDescription: "The display name of the user. Depending on the user’s privacy settings, this may return an alternative value.",
+							Computed:            true,
+						},
+						"active": schema.BoolAttribute{
+							MarkdownDescription: "Whether the user is active.",
+							Computed:            true,
+						},
+						"timezone": schema.StringAttribute{
+							MarkdownDescription: "The time zone specified in the user's profile. Depending on the user’s privacy settings, this may be returned as null.",
+							Computed:            true,
+						},
+						"account_type": schema.StringAttribute{
+							MarkdownDescription: "The type of account represented by this user. This will be one of `atlassian` (normal users), `app` (application user) or `customer` (Jira Service Desk customer user)",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *jiraGroupMembersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.p.jira = client
+}
+
+func (d *jiraGroupMembersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Reading group members data source")
+
+	var newState jiraGroupMembersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &newState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded group members config", map[string]interface{}{
+		"readConfig": fmt.Sprintf("%+v", newState),
+	})
+
+	members, err := getJiraGroupMembers(ctx, d.p.jira, newState.GroupName.ValueString(), newState.IncludeInactive.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	tflog.Debug(ctx, "Retrieved group members from API state", map[string]interface{}{
+		"readApiState": fmt.Sprintf("Members Count:%d", len(members)),
+	})
+
+	newState.ID = newState.GroupName
+	newState.Members = []jiraGroupMembersDataSourceMembers{}
+	for _, u := range members {
+		newState.Members = append(newState.Members, jiraGroupMembersDataSourceMembers{
+			AccountID:    types.StringValue(u.AccountID),
+			EmailAddress: types.StringValue(u.EmailAddress),
+			DisplayName:  types.StringValue(u.DisplayName),
+			Active:       types.BoolValue(u.Active),
+			TimeZone:     types.StringValue(u.TimeZone),
+			AccountType:  types.StringValue(u.AccountType),
+		})
+	}
+
+	tflog.Debug(ctx, "Storing group members into the state")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
@@ -0,0 +1,51 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraGroupMembersDataSource_Basic(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-group-members")
+	dataSourceName := "data.atlassian_jira_group_members.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupMembersDataSourceConfig_basic(dataSourceName, randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", randomName),
+					resource.TestCheckResourceAttr(dataSourceName, "members.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "members.0.account_id", "data.atlassian_jira_myself.test", "account_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "members.0.email_address", "data.atlassian_jira_myself.test", "email_address"),
+					resource.TestCheckResourceAttr(dataSourceName, "members.0.active", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGroupMembersDataSourceConfig_basic(dataSourceName, groupName string) string {
+	splits := strings.Split(dataSourceName, ".")
+	return fmt.Sprintf(`
+	data "atlassian_jira_myself" "test" {}
+
+	resource "atlassian_jira_group" "test" {
+		name = %[3]q
+	}
+
+	resource "atlassian_jira_group_user" "test" {
+		group_name = atlassian_jira_group.test.name
+		account_id = data.atlassian_jira_myself.test.account_id
+	}
+
+	data %[1]q %[2]q {
+		group_name = atlassian_jira_group_user.test.group_name
+	}
+	`, splits[1], splits[2], groupName)
+}
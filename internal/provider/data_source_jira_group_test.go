@@ -31,6 +31,25 @@ func TestAccJiraGroupDataSource_Basic(t *testing.T) {
 	})
 }
 
+func TestAccJiraGroupDataSource_GroupId(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-group")
+	dataSourceName := "data.atlassian_jira_group.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupDataSourceConfig_groupId(dataSourceName, randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("atlassian_jira_group.test", "id", dataSourceName, "id"),
+					resource.TestCheckResourceAttrPair("atlassian_jira_group.test", "name", dataSourceName, "name"),
+					resource.TestCheckResourceAttrPair("atlassian_jira_group.test", "group_id", dataSourceName, "group_id"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccJiraGroupDataSource_Users(t *testing.T) {
 	dataSourceName := "data.atlassian_jira_group.test"
 	resource.ParallelTest(t, resource.TestCase{
@@ -68,6 +87,19 @@ func testAccGroupDataSourceConfig_basic(dataSourceName, name string) string {
 	`, splits[1], splits[2], name)
 }
 
+func testAccGroupDataSourceConfig_groupId(dataSourceName, name string) string {
+	splits := strings.Split(dataSourceName, ".")
+	return fmt.Sprintf(`
+	  resource %[1]q %[2]q {
+		name = %[3]q
+	  }
+
+	  data %[1]q %[2]q {
+		group_id = %[1]s.%[2]s.group_id
+	  }
+	`, splits[1], splits[2], name)
+}
+
 func testAccGroupDataSourceConfig_users(dataSourceName, name string) string {
 	splits := strings.Split(dataSourceName, ".")
 	return fmt.Sprintf(`
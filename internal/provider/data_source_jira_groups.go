@@ -0,0 +1,165 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraGroupsDataSource struct {
+		p atlassianProvider
+	}
+
+	jiraGroupsDataSourceModel struct {
+		ID         types.String                 `tfsdk:"id"`
+		Query      types.String                 `tfsdk:"query"`
+		NamePrefix types.String                 `tfsdk:"name_prefix"`
+		Groups     []jiraGroupsDataSourceGroups `tfsdk:"groups"`
+	}
+
+	jiraGroupsDataSourceGroups struct {
+		Name    types.String `tfsdk:"name"`
+		GroupID types.String `tfsdk:"group_id"`
+		Self    types.String `tfsdk:"self"`
+	}
+)
+
+// jiraGroupBulkMaxResults is the maximum number of groups returned by a single page of the bulk groups API.
+const jiraGroupBulkMaxResults = 50
+
+var (
+	_ datasource.DataSource = (*jiraGroupsDataSource)(nil)
+)
+
+func NewJiraGroupsDataSource() datasource.DataSource {
+	return &jiraGroupsDataSource{}
+}
+
+func (*jiraGroupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_groups"
+}
+
+func (*jiraGroupsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Jira Groups Data Source",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the data source. Defaults to the host of the Jira site.",
+				Computed:            true,
+			},
+			"query": schema.StringAttribute{
+				MarkdownDescription: "Filter the groups by a literal string, which is matched against the group name (case-insensitive).",
+				Optional:            true,
+			},
+			"name_prefix": schema.StringAttribute{
+				MarkdownDescription: "Filter the groups by the beginning of their name (case-insensitive), e.g. `team-`.",
+				Optional:            true,
+			},
+			"groups": schema.ListNestedAttribute{
+				MarkdownDescription: "The groups matching the filters, sorted by name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the group.",
+							Computed:            true,
+						},
+						"group_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the group, which uniquely identifies the group across all Atlassian products.",
+							Computed:            true,
+						},
+						"self": schema.StringAttribute{
+							MarkdownDescription: "The URL for these group details.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *jiraGroupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.p.jira = client
+}
+
+func (d *jiraGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Reading groups data source")
+
+	var newState jiraGroupsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &newState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded groups config", map[string]interface{}{
+		"readConfig": fmt.Sprintf("%+v", newState),
+	})
+
+	var groups []*models.GroupDetailScheme
+	for startAt := 0; ; startAt += jiraGroupBulkMaxResults {
+		page, res, err := d.p.jira.Group.Bulk(ctx, nil, startAt, jiraGroupBulkMaxResults)
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get groups, got error: %s\n%s", err, resBody))
+			return
+		}
+		groups = append(groups, page.Values...)
+		if page.IsLast || len(page.Values) == 0 {
+			break
+		}
+	}
+	tflog.Debug(ctx, "Retrieved groups from API state", map[string]interface{}{
+		"readApiState": fmt.Sprintf("Groups Count:%d", len(groups)),
+	})
+
+	// The bulk groups API cannot filter by name, so the filters are applied to all groups of the site.
+	query := strings.ToLower(newState.Query.ValueString())
+	prefix := strings.ToLower(newState.NamePrefix.ValueString())
+
+	newState.ID = types.StringValue(d.p.jira.Site.Host)
+	newState.Groups = []jiraGroupsDataSourceGroups{}
+	for _, group := range groups {
+		name := strings.ToLower(group.Name)
+		if !strings.Contains(name, query) || !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		newState.Groups = append(newState.Groups, jiraGroupsDataSourceGroups{
+			Name:    types.StringValue(group.Name),
+			GroupID: types.StringValue(group.GroupID),
+			Self:    types.StringValue(fmt.Sprintf("https://%s/rest/api/3/group?groupId=%s", d.p.jira.Site.Host, group.GroupID)),
+		})
+	}
+	sort.Slice(newState.Groups, func(i, j int) bool {
+		return newState.Groups[i].Name.ValueString() < newState.Groups[j].Name.ValueString()
+	})
+
+	tflog.Debug(ctx, "Storing groups into the state")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
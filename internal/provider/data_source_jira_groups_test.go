@@ -0,0 +1,50 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraGroupsDataSource_NamePrefix(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-groups")
+	dataSourceName := "data.atlassian_jira_groups.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupsDataSourceConfig_namePrefix(dataSourceName, randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "groups.#", "2"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "groups.0.name", "atlassian_jira_group.test_a", "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "groups.0.group_id", "atlassian_jira_group.test_a", "group_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "groups.1.name", "atlassian_jira_group.test_b", "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "groups.1.group_id", "atlassian_jira_group.test_b", "group_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGroupsDataSourceConfig_namePrefix(dataSourceName, prefix string) string {
+	splits := strings.Split(dataSourceName, ".")
+	return fmt.Sprintf(`
+	resource "atlassian_jira_group" "test_a" {
+		name = "%[3]s-a"
+	}
+
+	resource "atlassian_jira_group" "test_b" {
+		name = "%[3]s-b"
+	}
+
+	data %[1]q %[2]q {
+		name_prefix = %[3]q
+
+		depends_on = [atlassian_jira_group.test_a, atlassian_jira_group.test_b]
+	}
+	`, splits[1], splits[2], prefix)
+}
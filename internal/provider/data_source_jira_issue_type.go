@@ -3,10 +3,16 @@ package atlassian
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"net/url"
 
 	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -19,8 +25,10 @@ type (
 	jiraIssueTypeDataSourceModel struct {
 		ID             types.String `tfsdk:"id"`
 		Name           types.String `tfsdk:"name"`
+		ProjectId      types.String `tfsdk:"project_id"`
 		Description    types.String `tfsdk:"description"`
 		HierarchyLevel types.Int64  `tfsdk:"hierarchy_level"`
+		Subtask        types.Bool   `tfsdk:"subtask"`
 		IconURL        types.String `tfsdk:"icon_url"`
 		AvatarID       types.Int64  `tfsdk:"avatar_id"`
 	}
@@ -43,13 +51,26 @@ func (*jiraIssueTypeDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 		MarkdownDescription: "Jira Issue Type Data Source",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				MarkdownDescription: "The ID of the issue type.",
-				Required:            true,
+				MarkdownDescription: "The ID of the issue type. Exactly one of `id` or `name` must be provided.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+				},
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the issue type.",
+				MarkdownDescription: "The name of the issue type. Exactly one of `id` or `name` must be provided.",
+				Optional:            true,
 				Computed:            true,
 			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project in which the issue type is looked up by `name`. " +
+					"Required to find issue types of team-managed projects, whose names are not unique. Cannot be provided with `id`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("id")),
+				},
+			},
 			"description": schema.StringAttribute{
 				MarkdownDescription: "The description of the issue type.",
 				Computed:            true,
@@ -58,6 +79,10 @@ func (*jiraIssueTypeDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 				MarkdownDescription: "The hierarchy level of the issue type.",
 				Computed:            true,
 			},
+			"subtask": schema.BoolAttribute{
+				MarkdownDescription: "Whether the issue type is used to create subtasks.",
+				Computed:            true,
+			},
 			"icon_url": schema.StringAttribute{
 				MarkdownDescription: "The URL of the issue type's avatar.",
 				Computed:            true,
@@ -98,25 +123,93 @@ func (d *jiraIssueTypeDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
-	issueType, res, err := d.p.jira.Issue.Type.Get(ctx, newstate.ID.ValueString())
-	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
+	var issueType *models.IssueTypeScheme
+	if !newstate.ID.IsNull() {
+		it, res, err := d.p.jira.Issue.Type.Get(ctx, newstate.ID.ValueString())
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get issue type, got error: %s\n%s", err, resBody))
+			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get issue type, got error: %s\n%s", err, resBody))
-		return
+		issueType = it
+	} else {
+		it, err := d.findIssueTypeByName(ctx, newstate.Name.ValueString(), newstate.ProjectId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name"), "Unable to find issue type", err.Error())
+			return
+		}
+		issueType = it
 	}
 	tflog.Debug(ctx, "Retrieved issue type from API state", map[string]interface{}{
 		"readApiState": fmt.Sprintf("%+v", issueType),
 	})
 
+	newstate.ID = types.StringValue(issueType.ID)
 	newstate.Name = types.StringValue(issueType.Name)
 	newstate.Description = types.StringValue(issueType.Description)
 	newstate.HierarchyLevel = types.Int64Value(int64(issueType.HierarchyLevel))
+	newstate.Subtask = types.BoolValue(issueType.Subtask)
 	newstate.IconURL = types.StringValue(issueType.IconURL)
 	newstate.AvatarID = types.Int64Value(int64(issueType.AvatarID))
 
 	tflog.Debug(ctx, "Storing issue type into the state")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newstate)...)
 }
+
+// findIssueTypeByName returns the issue type with the given name. If projectId is empty, only issue types
+// which are not scoped to a team-managed project are considered, otherwise only the issue types of the project.
+func (d *jiraIssueTypeDataSource) findIssueTypeByName(ctx context.Context, name, projectId string) (*models.IssueTypeScheme, error) {
+	var issueTypes []*models.IssueTypeScheme
+	if projectId == "" {
+		its, res, err := d.p.jira.Issue.Type.Gets(ctx)
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			return nil, fmt.Errorf("Unable to get issue types, got error: %s\n%s", err, resBody)
+		}
+		for _, it := range its {
+			if it.Scope == nil {
+				issueTypes = append(issueTypes, it)
+			}
+		}
+	} else {
+		// The go-atlassian library does not support getting the issue types of a project.
+		params := url.Values{}
+		params.Add("projectId", projectId)
+		request, err := d.p.jira.NewRequest(ctx, http.MethodGet, fmt.Sprintf("rest/api/3/issuetype/project?%s", params.Encode()), "", nil)
+		if err != nil {
+			return nil, fmt.Errorf("Unable to create issue types request, got error: %s", err)
+		}
+		res, err := d.p.jira.Call(request, &issueTypes)
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			return nil, fmt.Errorf("Unable to get issue types for project, got error: %s\n%s", err, resBody)
+		}
+	}
+
+	var matches []*models.IssueTypeScheme
+	for _, it := range issueTypes {
+		if it.Name == name {
+			matches = append(matches, it)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		if projectId != "" {
+			return nil, fmt.Errorf("Unable to find an issue type with name %q in project %q", name, projectId)
+		}
+		return nil, fmt.Errorf("Unable to find an issue type with name %q", name)
+	case 1:
+		return matches[0], nil
+	default:
+		return nil, fmt.Errorf("Found %d issue types with name %q, expected exactly one", len(matches), name)
+	}
+}
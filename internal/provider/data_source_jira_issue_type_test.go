@@ -1,8 +1,11 @@
 package atlassian
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
@@ -23,8 +26,70 @@ func TestAccJiraIssueTypeDataSource_Basic(t *testing.T) {
 	})
 }
 
+func TestAccJiraIssueTypeDataSource_Name(t *testing.T) {
+	dataSourceName := "data.atlassian_jira_issue_type.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraIssueTypeDataSourceConfig_name,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "10000"),
+					resource.TestCheckResourceAttr(dataSourceName, "hierarchy_level", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "subtask", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJiraIssueTypeDataSource_ProjectId(t *testing.T) {
+	randomKey := strings.ToUpper(acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
+	randomName := acctest.RandomWithPrefix("tf-test-project")
+	dataSourceName := "data.atlassian_jira_issue_type.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraIssueTypeDataSourceConfig_projectId(dataSourceName, randomKey, randomName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", "10000"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", "Epic"),
+				),
+			},
+		},
+	})
+}
+
 const testAccJiraIssueTypeDataSourceConfig_basic = `
 data "atlassian_jira_issue_type" "test" {
   id = "10000" // default id of epic issue type
 }
 `
+
+const testAccJiraIssueTypeDataSourceConfig_name = `
+data "atlassian_jira_issue_type" "test" {
+  name = "Epic"
+}
+`
+
+func testAccJiraIssueTypeDataSourceConfig_projectId(dataSourceName, key, name string) string {
+	splits := strings.Split(dataSourceName, ".")
+	return fmt.Sprintf(`
+	data "atlassian_jira_myself" "test" {}
+
+	resource "atlassian_jira_project" "test" {
+		key              = %[3]q
+		name             = %[4]q
+		lead_account_id  = data.atlassian_jira_myself.test.account_id
+		project_type_key = "software"
+	}
+
+	data %[1]q %[2]q {
+		name       = "Epic"
+		project_id = atlassian_jira_project.test.id
+	}
+	`, splits[1], splits[2], key, name)
+}
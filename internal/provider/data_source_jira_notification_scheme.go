@@ -0,0 +1,190 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraNotificationSchemeDataSource struct {
+		p atlassianProvider
+	}
+
+	jiraNotificationSchemeDataSourceModel struct {
+		ID                       types.String                        `tfsdk:"id"`
+		Name                     types.String                        `tfsdk:"name"`
+		Description              types.String                        `tfsdk:"description"`
+		NotificationSchemeEvents []jiraNotificationSchemeEventsModel `tfsdk:"notification_scheme_events"`
+	}
+)
+
+// jiraNotificationSchemeSearchMaxResults is the maximum number of notification schemes returned by a single page of the search.
+const jiraNotificationSchemeSearchMaxResults = 50
+
+var (
+	_ datasource.DataSource = (*jiraNotificationSchemeDataSource)(nil)
+)
+
+func NewJiraNotificationSchemeDataSource() datasource.DataSource {
+	return &jiraNotificationSchemeDataSource{}
+}
+
+func (*jiraNotificationSchemeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_notification_scheme"
+}
+
+func (*jiraNotificationSchemeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Jira Notification Scheme Data Source",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the notification scheme. Exactly one of `id` or `name` must be provided.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the notification scheme. Exactly one of `id` or `name` must be provided.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the notification scheme.",
+				Computed:            true,
+			},
+			"notification_scheme_events": schema.ListNestedAttribute{
+				MarkdownDescription: "The notification events and their recipients.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"event_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the event, e.g. `1` for \"Issue created\".",
+							Computed:            true,
+						},
+						"notifications": schema.ListNestedAttribute{
+							MarkdownDescription: "The notification recipients of the event.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"notification_type": schema.StringAttribute{
+										MarkdownDescription: "The notification type, e.g. `CurrentAssignee`, `User`, `Group` or `ProjectRole`.",
+										Computed:            true,
+									},
+									"parameter": schema.StringAttribute{
+										MarkdownDescription: "The value corresponding to the notification type, " +
+											"i.e. the account ID for `User`, the group ID for `Group`, the project role ID for `ProjectRole`, " +
+											"the email address for `EmailAddress` and the custom field ID for `UserCustomField` and `GroupCustomField`.",
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *jiraNotificationSchemeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.p.jira = client
+}
+
+func (d *jiraNotificationSchemeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Reading notification scheme data source")
+
+	var newState jiraNotificationSchemeDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &newState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded notification scheme config", map[string]interface{}{
+		"readConfig": fmt.Sprintf("%+v", newState),
+	})
+
+	schemeId := newState.ID.ValueString()
+	if newState.ID.IsNull() {
+		scheme, err := d.findNotificationSchemeByName(ctx, newState.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+		if scheme == nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name"), "Unable to find notification scheme", fmt.Sprintf("Unable to find a notification scheme with name %q", newState.Name.ValueString()))
+			return
+		}
+		schemeId = strconv.Itoa(scheme.ID)
+	}
+
+	notificationScheme, res, err := d.p.jira.NotificationScheme.Get(ctx, schemeId, []string{"all"})
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get notification scheme, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Retrieved notification scheme from API state", map[string]interface{}{
+		"readApiState": fmt.Sprintf("%+v", notificationScheme),
+	})
+
+	newState.ID = types.StringValue(strconv.Itoa(notificationScheme.ID))
+	newState.Name = types.StringValue(notificationScheme.Name)
+	newState.Description = types.StringValue(notificationScheme.Description)
+	newState.NotificationSchemeEvents = flattenNotificationSchemeEvents(flattenNotifications(notificationScheme))
+
+	tflog.Debug(ctx, "Storing notification scheme into the state")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+// findNotificationSchemeByName returns the notification scheme with the given name, or nil if there is none.
+// Notification schemes cannot be searched by name, so all pages of notification schemes are scanned.
+func (d *jiraNotificationSchemeDataSource) findNotificationSchemeByName(ctx context.Context, name string) (*models.NotificationSchemeScheme, error) {
+	for startAt := 0; ; startAt += jiraNotificationSchemeSearchMaxResults {
+		page, res, err := d.p.jira.NotificationScheme.Search(ctx, nil, startAt, jiraNotificationSchemeSearchMaxResults)
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			return nil, fmt.Errorf("Unable to search notification schemes, got error: %s\n%s", err, resBody)
+		}
+		for _, scheme := range page.Values {
+			if scheme.Name == name {
+				return scheme, nil
+			}
+		}
+		if page.IsLast || len(page.Values) == 0 {
+			return nil, nil
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraNotificationSchemeDataSource_Basic(t *testing.T) {
+	resourceName := acctest.RandomWithPrefix("tf-test-notification-scheme")
+	dataSourceName := "data.atlassian_jira_notification_scheme.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNotificationSchemeDataSourceConfig_id(dataSourceName, resourceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "atlassian_jira_notification_scheme.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", resourceName),
+					resource.TestCheckResourceAttr(dataSourceName, "description", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "notification_scheme_events.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "notification_scheme_events.0.event_id", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "notification_scheme_events.0.notifications.#", "2"),
+				),
+			},
+			{
+				Config: testAccNotificationSchemeDataSourceConfig_name(dataSourceName, resourceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "atlassian_jira_notification_scheme.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", resourceName),
+					resource.TestCheckResourceAttr(dataSourceName, "notification_scheme_events.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccNotificationSchemeDataSourceConfig_id(dataSourceName, name string) string {
+	splits := strings.Split(dataSourceName, ".")
+	return fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		name = %[3]q
+		notification_scheme_events = [
+			{
+				event_id = "1"
+				notifications = [
+					{ notification_type = "CurrentAssignee" },
+					{ notification_type = "Reporter" },
+				]
+			},
+		]
+	}
+
+	data %[1]q %[2]q {
+		id = %[1]s.%[2]s.id
+	}
+	`, splits[1], splits[2], name)
+}
+
+func testAccNotificationSchemeDataSourceConfig_name(dataSourceName, name string) string {
+	splits := strings.Split(dataSourceName, ".")
+	return fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		name = %[3]q
+		notification_scheme_events = [
+			{
+				event_id = "1"
+				notifications = [
+					{ notification_type = "CurrentAssignee" },
+					{ notification_type = "Reporter" },
+				]
+			},
+		]
+	}
+
+	data %[1]q %[2]q {
+		name = %[1]s.%[2]s.name
+	}
+	`, splits[1], splits[2], name)
+}
@@ -6,9 +6,11 @@ import (
 	"strconv"
 
 	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -19,10 +21,17 @@ type (
 	}
 
 	jiraPermissionSchemeDataSourceModel struct {
-		ID          types.String `tfsdk:"id"`
-		Self        types.String `tfsdk:"self"`
-		Name        types.String `tfsdk:"name"`
-		Description types.String `tfsdk:"description"`
+		ID          types.String                               `tfsdk:"id"`
+		Self        types.String                               `tfsdk:"self"`
+		Name        types.String                               `tfsdk:"name"`
+		Description types.String                               `tfsdk:"description"`
+		Grants      []jiraPermissionSchemeDataSourceGrantModel `tfsdk:"grants"`
+	}
+
+	jiraPermissionSchemeDataSourceGrantModel struct {
+		ID         types.String                    `tfsdk:"id"`
+		Permission types.String                    `tfsdk:"permission"`
+		Holder     *jiraPermissionGrantHolderModel `tfsdk:"holder"`
 	}
 )
 
@@ -43,21 +52,56 @@ func (*jiraPermissionSchemeDataSource) Schema(_ context.Context, _ datasource.Sc
 		MarkdownDescription: "Jira Permission Scheme Data Source",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				MarkdownDescription: "The ID of the permission scheme.",
-				Required:            true,
+				MarkdownDescription: "The ID of the permission scheme. Exactly one of `id` or `name` must be provided.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+				},
 			},
 			"self": schema.StringAttribute{
 				MarkdownDescription: "The URL of the permission scheme.",
 				Computed:            true,
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the permission scheme.",
+				MarkdownDescription: "The name of the permission scheme. Exactly one of `id` or `name` must be provided.",
+				Optional:            true,
 				Computed:            true,
 			},
 			"description": schema.StringAttribute{
 				MarkdownDescription: "The description of the permission scheme.",
 				Computed:            true,
 			},
+			"grants": schema.ListNestedAttribute{
+				MarkdownDescription: "The permission grants of the permission scheme.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the permission grant.",
+							Computed:            true,
+						},
+						"permission": schema.StringAttribute{
+							MarkdownDescription: "The permission granted, e.g. `BROWSE_PROJECTS`.",
+							Computed:            true,
+						},
+						"holder": schema.SingleNestedAttribute{
+							MarkdownDescription: "The user, group, field or role being granted the permission.",
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"type": schema.StringAttribute{
+									MarkdownDescription: "The type of permission holder.",
+									Computed:            true,
+								},
+								"parameter": schema.StringAttribute{
+									MarkdownDescription: "The identifier associated with the `type` value that defines the holder of the permission.",
+									Computed:            true,
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -93,10 +137,35 @@ func (d *jiraPermissionSchemeDataSource) Read(ctx context.Context, req datasourc
 		"readConfig": fmt.Sprintf("%+v", newState),
 	})
 
-	schemeId, err := strconv.Atoi(newState.ID.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddAttributeError(path.Root("id"), "Unable to parse value of \"id\" attribute.", "Value of \"id\" attribute can only be a numeric string.")
-		return
+	var schemeId int
+	if !newState.ID.IsNull() {
+		id, err := strconv.Atoi(newState.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("id"), "Unable to parse value of \"id\" attribute.", "Value of \"id\" attribute can only be a numeric string.")
+			return
+		}
+		schemeId = id
+	} else {
+		// Permission schemes cannot be searched by name, so the ID is resolved from the list of all permission schemes.
+		permissionSchemes, res, err := d.p.jira.Permission.Scheme.Gets(ctx)
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get permission schemes, got error: %s\n%s", err, resBody))
+			return
+		}
+		for _, scheme := range permissionSchemes.PermissionSchemes {
+			if scheme.Name == newState.Name.ValueString() {
+				schemeId = scheme.ID
+				break
+			}
+		}
+		if schemeId == 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("name"), "Unable to find permission scheme", fmt.Sprintf("Unable to find a permission scheme with name %q", newState.Name.ValueString()))
+			return
+		}
 	}
 
 	permissionScheme, res, err := d.p.jira.Permission.Scheme.Get(ctx, schemeId, []string{"all"})
@@ -112,9 +181,26 @@ func (d *jiraPermissionSchemeDataSource) Read(ctx context.Context, req datasourc
 		"readApiState": fmt.Sprintf("%+v", permissionScheme),
 	})
 
+	newState.ID = types.StringValue(strconv.Itoa(permissionScheme.ID))
 	newState.Self = types.StringValue(permissionScheme.Self)
 	newState.Name = types.StringValue(permissionScheme.Name)
 	newState.Description = types.StringValue(permissionScheme.Description)
+	newState.Grants = []jiraPermissionSchemeDataSourceGrantModel{}
+	for _, grant := range permissionScheme.Permissions {
+		g := jiraPermissionSchemeDataSourceGrantModel{
+			ID:         types.StringValue(strconv.Itoa(grant.ID)),
+			Permission: types.StringValue(grant.Permission),
+			Holder: &jiraPermissionGrantHolderModel{
+				Type:      types.StringValue(""),
+				Parameter: types.StringValue(""),
+			},
+		}
+		if grant.Holder != nil {
+			g.Holder.Type = types.StringValue(grant.Holder.Type)
+			g.Holder.Parameter = types.StringValue(grant.Holder.Parameter)
+		}
+		newState.Grants = append(newState.Grants, g)
+	}
 
 	tflog.Debug(ctx, "Storing permission scheme into the state")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
@@ -28,6 +28,28 @@ func TestAccJiraPermissionSchemeDataSource_Basic(t *testing.T) {
 	})
 }
 
+func TestAccJiraPermissionSchemeDataSource_Name(t *testing.T) {
+	resourceName := acctest.RandomWithPrefix("tf-test-permission-scheme")
+	dataSourceName := "data.atlassian_jira_permission_scheme.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionSchemeDataSourceConfig_name(dataSourceName, resourceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "atlassian_jira_permission_scheme.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", resourceName),
+					resource.TestCheckResourceAttr(dataSourceName, "grants.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "grants.0.id", "atlassian_jira_permission_grant.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "grants.0.permission", "BROWSE_PROJECTS"),
+					resource.TestCheckResourceAttr(dataSourceName, "grants.0.holder.type", "assignee"),
+				),
+			},
+		},
+	})
+}
+
 func testAccPermissionSchemeDataSourceConfig_basic(dataSourceName, name string) string {
 	splits := strings.Split(dataSourceName, ".")
 	return fmt.Sprintf(`
@@ -40,3 +62,26 @@ func testAccPermissionSchemeDataSourceConfig_basic(dataSourceName, name string)
 	  }
 	`, splits[1], splits[2], name)
 }
+
+func testAccPermissionSchemeDataSourceConfig_name(dataSourceName, name string) string {
+	splits := strings.Split(dataSourceName, ".")
+	return fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		name = %[3]q
+	}
+
+	resource "atlassian_jira_permission_grant" "test" {
+		permission_scheme_id = %[1]s.%[2]s.id
+		holder = {
+			type = "assignee"
+		}
+		permission = "BROWSE_PROJECTS"
+	}
+
+	data %[1]q %[2]q {
+		name = %[1]s.%[2]s.name
+
+		depends_on = [atlassian_jira_permission_grant.test]
+	}
+	`, splits[1], splits[2], name)
+}
@@ -0,0 +1,144 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraPermissionSchemesDataSource struct {
+		p atlassianProvider
+	}
+
+	jiraPermissionSchemesDataSourceModel struct {
+		ID                types.String                                       `tfsdk:"id"`
+		PermissionSchemes []jiraPermissionSchemesDataSourcePermissionSchemes `tfsdk:"permission_schemes"`
+	}
+
+	jiraPermissionSchemesDataSourcePermissionSchemes struct {
+		ID          types.String `tfsdk:"id"`
+		Name        types.String `tfsdk:"name"`
+		Description types.String `tfsdk:"description"`
+		GrantsCount types.Int64  `tfsdk:"grants_count"`
+	}
+)
+
+var (
+	_ datasource.DataSource = (*jiraPermissionSchemesDataSource)(nil)
+)
+
+func NewJiraPermissionSchemesDataSource() datasource.DataSource {
+	return &jiraPermissionSchemesDataSource{}
+}
+
+func (*jiraPermissionSchemesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_permission_schemes"
+}
+
+func (*jiraPermissionSchemesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Jira Permission Schemes Data Source",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the data source. Defaults to the host of the Jira site.",
+				Computed:            true,
+			},
+			"permission_schemes": schema.ListNestedAttribute{
+				MarkdownDescription: "All permission schemes of the Jira site.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the permission scheme.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the permission scheme.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the permission scheme.",
+							Computed:            true,
+						},
+						"grants_count": schema.Int64Attribute{
+							MarkdownDescription: "The number of permission grants of the permission scheme.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *jiraPermissionSchemesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.p.jira = client
+}
+
+func (d *jiraPermissionSchemesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Reading permission schemes data source")
+
+	var newState jiraPermissionSchemesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &newState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The go-atlassian library does not support expanding the permissions of all permission schemes.
+	request, err := d.p.jira.NewRequest(ctx, http.MethodGet, "rest/api/3/permissionscheme?expand=permissions", "", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create permission schemes request, got error: %s", err))
+		return
+	}
+	var permissionSchemes models.PermissionSchemePageScheme
+	res, err := d.p.jira.Call(request, &permissionSchemes)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get permission schemes, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Retrieved permission schemes from API state", map[string]interface{}{
+		"readApiState": fmt.Sprintf("Permission Schemes Count:%d", len(permissionSchemes.PermissionSchemes)),
+	})
+
+	newState.ID = types.StringValue(d.p.jira.Site.Host)
+	newState.PermissionSchemes = []jiraPermissionSchemesDataSourcePermissionSchemes{}
+	for _, scheme := range permissionSchemes.PermissionSchemes {
+		newState.PermissionSchemes = append(newState.PermissionSchemes, jiraPermissionSchemesDataSourcePermissionSchemes{
+			ID:          types.StringValue(strconv.Itoa(scheme.ID)),
+			Name:        types.StringValue(scheme.Name),
+			Description: types.StringValue(scheme.Description),
+			GrantsCount: types.Int64Value(int64(len(scheme.Permissions))),
+		})
+	}
+
+	tflog.Debug(ctx, "Storing permission schemes into the state")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
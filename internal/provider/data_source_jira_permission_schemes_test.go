@@ -0,0 +1,52 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraPermissionSchemesDataSource_Basic(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-permission-schemes")
+	dataSourceName := "data.atlassian_jira_permission_schemes.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionSchemesDataSourceConfig_basic(dataSourceName, randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "permission_schemes.*", map[string]string{
+						"name":         randomName,
+						"description":  "",
+						"grants_count": "1",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccPermissionSchemesDataSourceConfig_basic(dataSourceName, name string) string {
+	splits := strings.Split(dataSourceName, ".")
+	return fmt.Sprintf(`
+	resource "atlassian_jira_permission_scheme" "test" {
+		name = %[3]q
+	}
+
+	resource "atlassian_jira_permission_grant" "test" {
+		permission_scheme_id = atlassian_jira_permission_scheme.test.id
+		holder = {
+			type = "assignee"
+		}
+		permission = "BROWSE_PROJECTS"
+	}
+
+	data %[1]q %[2]q {
+		depends_on = [atlassian_jira_permission_grant.test]
+	}
+	`, splits[1], splits[2], name)
+}
@@ -0,0 +1,173 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraPrioritiesDataSource struct {
+		p atlassianProvider
+	}
+
+	jiraPrioritiesDataSourceModel struct {
+		ID         types.String                            `tfsdk:"id"`
+		Priorities []jiraPrioritiesDataSourcePriorityModel `tfsdk:"priorities"`
+	}
+
+	jiraPrioritiesDataSourcePriorityModel struct {
+		ID          types.String `tfsdk:"id"`
+		Name        types.String `tfsdk:"name"`
+		Description types.String `tfsdk:"description"`
+		IconUrl     types.String `tfsdk:"icon_url"`
+		StatusColor types.String `tfsdk:"status_color"`
+		IsDefault   types.Bool   `tfsdk:"is_default"`
+	}
+
+	// The go-atlassian library does not return the default flag of priorities,
+	// so the following type is used to call the "Search priorities" endpoint.
+	jiraPrioritiesSearchPage struct {
+		StartAt    int                   `json:"startAt"`
+		MaxResults int                   `json:"maxResults"`
+		Total      int                   `json:"total"`
+		IsLast     bool                  `json:"isLast"`
+		Values     []jiraPriorityDetails `json:"values"`
+	}
+)
+
+var (
+	_ datasource.DataSource = (*jiraPrioritiesDataSource)(nil)
+)
+
+func NewJiraPrioritiesDataSource() datasource.DataSource {
+	return &jiraPrioritiesDataSource{}
+}
+
+func (*jiraPrioritiesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_priorities"
+}
+
+func (*jiraPrioritiesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Jira Priorities Data Source",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the data source. Defaults to the host of the Jira site.",
+				Computed:            true,
+			},
+			"priorities": schema.ListNestedAttribute{
+				MarkdownDescription: "All priorities of the Jira site.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the priority.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the priority.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the priority.",
+							Computed:            true,
+						},
+						"icon_url": schema.StringAttribute{
+							MarkdownDescription: "The URL of the icon of the priority.",
+							Computed:            true,
+						},
+						"status_color": schema.StringAttribute{
+							MarkdownDescription: "The status color of the priority in hexadecimal format.",
+							Computed:            true,
+						},
+						"is_default": schema.BoolAttribute{
+							MarkdownDescription: "Whether the priority is the default priority.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *jiraPrioritiesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.p.jira = client
+}
+
+func (d *jiraPrioritiesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Reading priorities data source")
+
+	var newState jiraPrioritiesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &newState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var priorities []jiraPriorityDetails
+	for startAt := 0; ; {
+		request, err := d.p.jira.NewRequest(ctx, http.MethodGet, fmt.Sprintf("rest/api/3/priority/search?startAt=%d", startAt), "", nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create priorities request, got error: %s", err))
+			return
+		}
+
+		page := new(jiraPrioritiesSearchPage)
+		res, err := d.p.jira.Call(request, page)
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to search priorities, got error: %s\n%s", err, resBody))
+			return
+		}
+
+		priorities = append(priorities, page.Values...)
+		if page.IsLast || len(page.Values) == 0 {
+			break
+		}
+		startAt += len(page.Values)
+	}
+	tflog.Debug(ctx, "Retrieved priorities from API state", map[string]interface{}{
+		"readApiState": fmt.Sprintf("Priorities Count:%d", len(priorities)),
+	})
+
+	newState.ID = types.StringValue(d.p.jira.Site.Host)
+	newState.Priorities = []jiraPrioritiesDataSourcePriorityModel{}
+	for _, priority := range priorities {
+		newState.Priorities = append(newState.Priorities, jiraPrioritiesDataSourcePriorityModel{
+			ID:          types.StringValue(priority.ID),
+			Name:        types.StringValue(priority.Name),
+			Description: types.StringValue(priority.Description),
+			IconUrl:     types.StringValue(priority.IconUrl),
+			StatusColor: types.StringValue(priority.StatusColor),
+			IsDefault:   types.BoolValue(priority.IsDefault),
+		})
+	}
+
+	tflog.Debug(ctx, "Storing priorities into the state")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
@@ -0,0 +1,47 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraPrioritiesDataSource_Basic(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-priority")
+	dataSourceName := "data.atlassian_jira_priorities.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrioritiesDataSourceConfig_basic(dataSourceName, randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "priorities.*", map[string]string{
+						"name":         randomName,
+						"description":  "",
+						"status_color": "#009900",
+						"is_default":   "false",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccPrioritiesDataSourceConfig_basic(dataSourceName, name string) string {
+	splits := strings.Split(dataSourceName, ".")
+	return fmt.Sprintf(`
+	resource "atlassian_jira_priority" "test" {
+		name         = %[3]q
+		status_color = "#009900"
+	}
+
+	data %[1]q %[2]q {
+		depends_on = [atlassian_jira_priority.test]
+	}
+	`, splits[1], splits[2], name)
+}
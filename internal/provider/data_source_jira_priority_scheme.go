@@ -0,0 +1,159 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraPrioritySchemeDataSource struct {
+		p atlassianProvider
+	}
+
+	jiraPrioritySchemeDataSourceModel struct {
+		ID                types.String `tfsdk:"id"`
+		Name              types.String `tfsdk:"name"`
+		Description       types.String `tfsdk:"description"`
+		DefaultPriorityId types.String `tfsdk:"default_priority_id"`
+		PriorityIds       types.List   `tfsdk:"priority_ids"`
+		ProjectIds        types.Set    `tfsdk:"project_ids"`
+		IsDefault         types.Bool   `tfsdk:"is_default"`
+	}
+)
+
+var (
+	_ datasource.DataSource = (*jiraPrioritySchemeDataSource)(nil)
+)
+
+func NewJiraPrioritySchemeDataSource() datasource.DataSource {
+	return &jiraPrioritySchemeDataSource{}
+}
+
+func (*jiraPrioritySchemeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_priority_scheme"
+}
+
+func (*jiraPrioritySchemeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Jira Priority Scheme Data Source",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the priority scheme.",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the priority scheme.",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the priority scheme.",
+				Computed:            true,
+			},
+			"default_priority_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the default priority of the priority scheme.",
+				Computed:            true,
+			},
+			"priority_ids": schema.ListAttribute{
+				MarkdownDescription: "The IDs of the priorities in the priority scheme.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"project_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the projects associated with the priority scheme.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"is_default": schema.BoolAttribute{
+				MarkdownDescription: "Whether the priority scheme is the default priority scheme.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *jiraPrioritySchemeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.p.jira = client
+}
+
+func (d *jiraPrioritySchemeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Reading priority scheme data source")
+
+	var newState jiraPrioritySchemeDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &newState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded priority scheme config", map[string]interface{}{
+		"readConfig": fmt.Sprintf("%+v", newState),
+	})
+
+	// The "schemeName" query parameter performs a partial match, so look for an exact match.
+	params := url.Values{}
+	params.Add("schemeName", newState.Name.ValueString())
+	prioritySchemes, err := searchJiraPrioritySchemes(ctx, d.p.jira, params)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	var priorityScheme *jiraPrioritySchemeDetails
+	for i, s := range prioritySchemes {
+		if s.Name == newState.Name.ValueString() {
+			priorityScheme = &prioritySchemes[i]
+			break
+		}
+	}
+	if priorityScheme == nil {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "Unable to find priority scheme.", fmt.Sprintf("No priority scheme found with name: %q", newState.Name.ValueString()))
+		return
+	}
+	tflog.Debug(ctx, "Retrieved priority scheme from API state", map[string]interface{}{
+		"readApiState": fmt.Sprintf("%+v", priorityScheme),
+	})
+
+	priorityIds, defaultPriorityId, err := getJiraPrioritySchemePriorities(ctx, d.p.jira, priorityScheme.ID.String())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	projectIds, err := getJiraPrioritySchemeProjects(ctx, d.p.jira, priorityScheme.ID.String())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	newState.ID = types.StringValue(priorityScheme.ID.String())
+	newState.Description = types.StringValue(priorityScheme.Description)
+	newState.DefaultPriorityId = types.StringValue(defaultPriorityId)
+	newState.IsDefault = types.BoolValue(priorityScheme.IsDefault)
+
+	newState.PriorityIds, _ = types.ListValueFrom(ctx, types.StringType, priorityIds)
+	newState.ProjectIds, _ = types.SetValueFrom(ctx, types.StringType, projectIds)
+
+	tflog.Debug(ctx, "Storing priority scheme into the state")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
@@ -0,0 +1,48 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraPrioritySchemeDataSource_Basic(t *testing.T) {
+	resourceName := acctest.RandomWithPrefix("tf-test-priority-scheme")
+	dataSourceName := "data.atlassian_jira_priority_scheme.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrioritySchemeDataSourceConfig_basic(dataSourceName, resourceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "atlassian_jira_priority_scheme.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", resourceName),
+					resource.TestCheckResourceAttr(dataSourceName, "description", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "default_priority_id", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "priority_ids.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "project_ids.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "is_default", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPrioritySchemeDataSourceConfig_basic(dataSourceName, name string) string {
+	splits := strings.Split(dataSourceName, ".")
+	return fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		name                = %[3]q
+		default_priority_id = "3"
+		priority_ids        = ["2", "3"]
+	}
+
+	data %[1]q %[2]q {
+		name = %[1]s.%[2]s.name
+	}
+	`, splits[1], splits[2], name)
+}
@@ -0,0 +1,226 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraProjectDataSource struct {
+		p atlassianProvider
+	}
+
+	jiraProjectDataSourceModel struct {
+		ID                       types.String `tfsdk:"id"`
+		Key                      types.String `tfsdk:"key"`
+		Name                     types.String `tfsdk:"name"`
+		Description              types.String `tfsdk:"description"`
+		CategoryId               types.Int64  `tfsdk:"category_id"`
+		LeadAccountId            types.String `tfsdk:"lead_account_id"`
+		AssigneeType             types.String `tfsdk:"assignee_type"`
+		ProjectTypeKey           types.String `tfsdk:"project_type_key"`
+		Style                    types.String `tfsdk:"style"`
+		URL                      types.String `tfsdk:"url"`
+		FieldConfigurationScheme types.Int64  `tfsdk:"field_configuration_scheme"`
+		IssueSecurityScheme      types.Int64  `tfsdk:"issue_security_scheme"`
+		IssueTypeScheme          types.Int64  `tfsdk:"issue_type_scheme"`
+		IssueTypeScreenScheme    types.Int64  `tfsdk:"issue_type_screen_scheme"`
+		NotificationScheme       types.Int64  `tfsdk:"notification_scheme"`
+		PermissionScheme         types.Int64  `tfsdk:"permission_scheme"`
+		WorkflowScheme           types.Int64  `tfsdk:"workflow_scheme"`
+	}
+)
+
+var (
+	_ datasource.DataSource = (*jiraProjectDataSource)(nil)
+)
+
+func NewJiraProjectDataSource() datasource.DataSource {
+	return &jiraProjectDataSource{}
+}
+
+func (*jiraProjectDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_project"
+}
+
+func (*jiraProjectDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Jira Project Data Source",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project. Cannot be provided with `key`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("key")),
+				},
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "The key of the project. Cannot be provided with `id`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the project.",
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the project.",
+				Computed:            true,
+			},
+			"category_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the project category of the project.",
+				Computed:            true,
+			},
+			"lead_account_id": schema.StringAttribute{
+				MarkdownDescription: "The account ID of the project lead.",
+				Computed:            true,
+			},
+			"assignee_type": schema.StringAttribute{
+				MarkdownDescription: "The default assignee when creating issues for this project.",
+				Computed:            true,
+			},
+			"project_type_key": schema.StringAttribute{
+				MarkdownDescription: "The project type, which defines the application-specific feature set.",
+				Computed:            true,
+			},
+			"style": schema.StringAttribute{
+				MarkdownDescription: "The style of the project, either `classic` (company-managed) or `next-gen` (team-managed).",
+				Computed:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "A link to information about this project, such as project documentation.",
+				Computed:            true,
+			},
+			"field_configuration_scheme": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the field configuration scheme of the project. Not set for team-managed projects and projects using the default field configuration scheme.",
+				Computed:            true,
+			},
+			"issue_security_scheme": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the issue security scheme of the project. Not set for team-managed projects and projects without an issue security scheme.",
+				Computed:            true,
+			},
+			"issue_type_scheme": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the issue type scheme of the project. Not set for team-managed projects.",
+				Computed:            true,
+			},
+			"issue_type_screen_scheme": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the issue type screen scheme of the project. Not set for team-managed projects.",
+				Computed:            true,
+			},
+			"notification_scheme": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the notification scheme of the project. Not set for team-managed projects.",
+				Computed:            true,
+			},
+			"permission_scheme": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the permission scheme of the project. Not set for team-managed projects.",
+				Computed:            true,
+			},
+			"workflow_scheme": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the workflow scheme of the project. Not set for team-managed projects and projects using the default workflow scheme.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *jiraProjectDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.p.jira = client
+}
+
+func (d *jiraProjectDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Reading project data source")
+
+	var newState jiraProjectDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &newState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded project config", map[string]interface{}{
+		"readConfig": fmt.Sprintf("%+v", newState),
+	})
+
+	// Projects can be retrieved by either ID or key
+	projectIdOrKey := newState.ID.ValueString()
+	if newState.ID.IsNull() {
+		projectIdOrKey = newState.Key.ValueString()
+	}
+
+	project, res, err := d.p.jira.Project.Get(ctx, projectIdOrKey, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get project, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Retrieved project from API state", map[string]interface{}{
+		"readApiState": fmt.Sprintf("%+v", project),
+	})
+
+	newState.ID = types.StringValue(project.ID)
+	newState.Key = types.StringValue(project.Key)
+	newState.Name = types.StringValue(project.Name)
+	newState.Description = types.StringValue(project.Description)
+	newState.CategoryId = types.Int64Null()
+	if project.Category != nil {
+		categoryID, _ := strconv.Atoi(project.Category.ID)
+		newState.CategoryId = types.Int64Value(int64(categoryID))
+	}
+	newState.LeadAccountId = types.StringNull()
+	if project.Lead != nil {
+		newState.LeadAccountId = types.StringValue(project.Lead.AccountID)
+	}
+	newState.AssigneeType = types.StringValue(project.AssigneeType)
+	newState.ProjectTypeKey = types.StringValue(project.ProjectTypeKey)
+	newState.Style = types.StringValue(project.Style)
+	newState.URL = types.StringValue(project.URL)
+
+	// Team-managed projects do not use shared schemes
+	var schemes jiraProjectSchemes
+	if project.Style != projectStyleNextGen {
+		var diags diag.Diagnostics
+		schemes, diags = readJiraProjectSchemes(ctx, d.p.jira, project.ID)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	newState.FieldConfigurationScheme = schemes.FieldConfigurationScheme
+	newState.IssueSecurityScheme = schemes.IssueSecurityScheme
+	newState.IssueTypeScheme = schemes.IssueTypeScheme
+	newState.IssueTypeScreenScheme = schemes.IssueTypeScreenScheme
+	newState.NotificationScheme = schemes.NotificationScheme
+	newState.PermissionScheme = schemes.PermissionScheme
+	newState.WorkflowScheme = schemes.WorkflowScheme
+
+	tflog.Debug(ctx, "Storing project into the state")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
@@ -0,0 +1,145 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraProjectRolesDataSource struct {
+		p atlassianProvider
+	}
+
+	jiraProjectRolesDataSourceModel struct {
+		ID           types.String                                 `tfsdk:"id"`
+		Name         types.String                                 `tfsdk:"name"`
+		ProjectRoles []jiraProjectRolesDataSourceProjectRoleModel `tfsdk:"project_roles"`
+	}
+
+	jiraProjectRolesDataSourceProjectRoleModel struct {
+		ID          types.String `tfsdk:"id"`
+		Name        types.String `tfsdk:"name"`
+		Description types.String `tfsdk:"description"`
+	}
+)
+
+var (
+	_ datasource.DataSource = (*jiraProjectRolesDataSource)(nil)
+)
+
+func NewJiraProjectRolesDataSource() datasource.DataSource {
+	return &jiraProjectRolesDataSource{}
+}
+
+func (*jiraProjectRolesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_project_roles"
+}
+
+func (*jiraProjectRolesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Jira Project Roles Data Source",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the data source. Defaults to the host of the Jira site.",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Filter the project roles by name. If provided, a project role with this name must exist.",
+				Optional:            true,
+			},
+			"project_roles": schema.ListNestedAttribute{
+				MarkdownDescription: "The project roles matching the filters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the project role.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the project role.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the project role.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *jiraProjectRolesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.p.jira = client
+}
+
+func (d *jiraProjectRolesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Reading project roles data source")
+
+	var newState jiraProjectRolesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &newState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded project roles config", map[string]interface{}{
+		"readConfig": fmt.Sprintf("%+v", newState),
+	})
+
+	projectRoles, res, err := d.p.jira.Project.Role.Global(ctx)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get project roles, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Retrieved project roles from API state", map[string]interface{}{
+		"readApiState": fmt.Sprintf("Project Roles Count:%d", len(projectRoles)),
+	})
+
+	newState.ID = types.StringValue(d.p.jira.Site.Host)
+	newState.ProjectRoles = []jiraProjectRolesDataSourceProjectRoleModel{}
+	for _, role := range projectRoles {
+		if !newState.Name.IsNull() && role.Name != newState.Name.ValueString() {
+			continue
+		}
+		newState.ProjectRoles = append(newState.ProjectRoles, jiraProjectRolesDataSourceProjectRoleModel{
+			ID:          types.StringValue(strconv.Itoa(role.ID)),
+			Name:        types.StringValue(role.Name),
+			Description: types.StringValue(role.Description),
+		})
+	}
+	if !newState.Name.IsNull() && len(newState.ProjectRoles) == 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "Unable to find project role", fmt.Sprintf("Unable to find a project role with name %q", newState.Name.ValueString()))
+		return
+	}
+
+	tflog.Debug(ctx, "Storing project roles into the state")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
@@ -0,0 +1,76 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraProjectRolesDataSource_Basic(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-project-role")
+	dataSourceName := "data.atlassian_jira_project_roles.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectRolesDataSourceConfig_basic(dataSourceName, randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "project_roles.*", map[string]string{
+						"name":        randomName,
+						"description": "",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJiraProjectRolesDataSource_Name(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-project-role")
+	dataSourceName := "data.atlassian_jira_project_roles.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectRolesDataSourceConfig_name(dataSourceName, randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "project_roles.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "project_roles.0.id", "atlassian_jira_project_role.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "project_roles.0.name", randomName),
+				),
+			},
+		},
+	})
+}
+
+func testAccProjectRolesDataSourceConfig_basic(dataSourceName, name string) string {
+	splits := strings.Split(dataSourceName, ".")
+	return fmt.Sprintf(`
+	resource "atlassian_jira_project_role" "test" {
+		name = %[3]q
+	}
+
+	data %[1]q %[2]q {
+		depends_on = [atlassian_jira_project_role.test]
+	}
+	`, splits[1], splits[2], name)
+}
+
+func testAccProjectRolesDataSourceConfig_name(dataSourceName, name string) string {
+	splits := strings.Split(dataSourceName, ".")
+	return fmt.Sprintf(`
+	resource "atlassian_jira_project_role" "test" {
+		name = %[3]q
+	}
+
+	data %[1]q %[2]q {
+		name = atlassian_jira_project_role.test.name
+	}
+	`, splits[1], splits[2], name)
+}
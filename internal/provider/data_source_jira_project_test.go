@@ -0,0 +1,79 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraProjectDataSource_Basic(t *testing.T) {
+	randomKey := strings.ToUpper(acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
+	randomName := acctest.RandomWithPrefix("tf-test-project")
+	dataSourceName := "data.atlassian_jira_project.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectDataSourceConfig_key(dataSourceName, randomKey, randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "atlassian_jira_project.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "key", randomKey),
+					resource.TestCheckResourceAttr(dataSourceName, "name", randomName),
+					resource.TestCheckResourceAttrPair(dataSourceName, "lead_account_id", "atlassian_jira_project.test", "lead_account_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "project_type_key", "software"),
+					resource.TestCheckResourceAttr(dataSourceName, "style", "classic"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "permission_scheme", "atlassian_jira_project.test", "permission_scheme"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "notification_scheme", "atlassian_jira_project.test", "notification_scheme"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "issue_type_scheme", "atlassian_jira_project.test", "issue_type_scheme"),
+				),
+			},
+			{
+				Config: testAccProjectDataSourceConfig_id(dataSourceName, randomKey, randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "atlassian_jira_project.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "key", randomKey),
+				),
+			},
+		},
+	})
+}
+
+func testAccProjectDataSourceConfig_key(dataSourceName, key, name string) string {
+	splits := strings.Split(dataSourceName, ".")
+	return fmt.Sprintf(`
+	data "atlassian_jira_myself" "test" {}
+
+	resource %[1]q %[2]q {
+		key              = %[3]q
+		name             = %[4]q
+		lead_account_id  = data.atlassian_jira_myself.test.account_id
+		project_type_key = "software"
+	}
+
+	data %[1]q %[2]q {
+		key = %[1]s.%[2]s.key
+	}
+	`, splits[1], splits[2], key, name)
+}
+
+func testAccProjectDataSourceConfig_id(dataSourceName, key, name string) string {
+	splits := strings.Split(dataSourceName, ".")
+	return fmt.Sprintf(`
+	data "atlassian_jira_myself" "test" {}
+
+	resource %[1]q %[2]q {
+		key              = %[3]q
+		name             = %[4]q
+		lead_account_id  = data.atlassian_jira_myself.test.account_id
+		project_type_key = "software"
+	}
+
+	data %[1]q %[2]q {
+		id = %[1]s.%[2]s.id
+	}
+	`, splits[1], splits[2], key, name)
+}
@@ -0,0 +1,236 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraProjectsDataSource struct {
+		p atlassianProvider
+	}
+
+	jiraProjectsDataSourceModel struct {
+		ID         types.String                     `tfsdk:"id"`
+		Query      types.String                     `tfsdk:"query"`
+		TypeKeys   types.List                       `tfsdk:"type_keys"`
+		CategoryId types.Int64                      `tfsdk:"category_id"`
+		Status     types.List                       `tfsdk:"status"`
+		OrderBy    types.String                     `tfsdk:"order_by"`
+		Projects   []jiraProjectsDataSourceProjects `tfsdk:"projects"`
+	}
+
+	jiraProjectsDataSourceProjects struct {
+		ID             types.String `tfsdk:"id"`
+		Key            types.String `tfsdk:"key"`
+		Name           types.String `tfsdk:"name"`
+		Description    types.String `tfsdk:"description"`
+		CategoryId     types.Int64  `tfsdk:"category_id"`
+		LeadAccountId  types.String `tfsdk:"lead_account_id"`
+		ProjectTypeKey types.String `tfsdk:"project_type_key"`
+		Style          types.String `tfsdk:"style"`
+		URL            types.String `tfsdk:"url"`
+	}
+)
+
+// jiraProjectSearchMaxResults is the maximum number of projects returned by a single page of the project search.
+const jiraProjectSearchMaxResults = 50
+
+var (
+	_ datasource.DataSource = (*jiraProjectsDataSource)(nil)
+)
+
+func NewJiraProjectsDataSource() datasource.DataSource {
+	return &jiraProjectsDataSource{}
+}
+
+func (*jiraProjectsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_projects"
+}
+
+func (*jiraProjectsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Jira Projects Data Source",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the data source. Defaults to the host of the Jira site.",
+				Computed:            true,
+			},
+			"query": schema.StringAttribute{
+				MarkdownDescription: "Filter the projects by a literal string, which is matched against the project key and name (case-insensitive).",
+				Optional:            true,
+			},
+			"type_keys": schema.ListAttribute{
+				MarkdownDescription: "Filter the projects by project type. Valid values: `business`, `service_desk`, `software`.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.OneOf("business", "service_desk", "software")),
+				},
+			},
+			"category_id": schema.Int64Attribute{
+				MarkdownDescription: "Filter the projects by the ID of their project category.",
+				Optional:            true,
+			},
+			"status": schema.ListAttribute{
+				MarkdownDescription: "Filter the projects by status. Valid values: `live`, `archived`, `deleted` (projects in the trash). Defaults to `live` projects.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.OneOf("live", "archived", "deleted")),
+				},
+			},
+			"order_by": schema.StringAttribute{
+				MarkdownDescription: "Order the projects by a field, e.g. `key`, `name`, `category`, `owner`, `issueCount` or `lastIssueUpdatedTime`. " +
+					"Prefix the field with `-` to sort in descending order. Defaults to `key`.",
+				Optional: true,
+			},
+			"projects": schema.ListNestedAttribute{
+				MarkdownDescription: "The projects matching the filters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the project.",
+							Computed:            true,
+						},
+						"key": schema.StringAttribute{
+							MarkdownDescription: "The key of the project.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the project.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the project.",
+							Computed:            true,
+						},
+						"category_id": schema.Int64Attribute{
+							MarkdownDescription: "The ID of the project category of the project.",
+							Computed:            true,
+						},
+						"lead_account_id": schema.StringAttribute{
+							MarkdownDescription: "The account ID of the project lead.",
+							Computed:            true,
+						},
+						"project_type_key": schema.StringAttribute{
+							MarkdownDescription: "The project type, which defines the application-specific feature set.",
+							Computed:            true,
+						},
+						"style": schema.StringAttribute{
+							MarkdownDescription: "The style of the project, either `classic` (company-managed) or `next-gen` (team-managed).",
+							Computed:            true,
+						},
+						"url": schema.StringAttribute{
+							MarkdownDescription: "A link to information about this project, such as project documentation.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *jiraProjectsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.p.jira = client
+}
+
+func (d *jiraProjectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Reading projects data source")
+
+	var newState jiraProjectsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &newState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded projects config", map[string]interface{}{
+		"readConfig": fmt.Sprintf("%+v", newState),
+	})
+
+	options := &models.ProjectSearchOptionsScheme{
+		Query:      newState.Query.ValueString(),
+		CategoryID: int(newState.CategoryId.ValueInt64()),
+		OrderBy:    newState.OrderBy.ValueString(),
+		Expand:     []string{"description", "lead", "url"},
+	}
+	resp.Diagnostics.Append(newState.TypeKeys.ElementsAs(ctx, &options.TypeKeys, false)...)
+	resp.Diagnostics.Append(newState.Status.ElementsAs(ctx, &options.Status, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var projects []*models.ProjectScheme
+	for startAt := 0; ; startAt += jiraProjectSearchMaxResults {
+		page, res, err := d.p.jira.Project.Search(ctx, options, startAt, jiraProjectSearchMaxResults)
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to search projects, got error: %s\n%s", err, resBody))
+			return
+		}
+		projects = append(projects, page.Values...)
+		if page.IsLast || len(page.Values) == 0 {
+			break
+		}
+	}
+	tflog.Debug(ctx, "Retrieved projects from API state", map[string]interface{}{
+		"readApiState": fmt.Sprintf("Projects Count:%d", len(projects)),
+	})
+
+	newState.ID = types.StringValue(d.p.jira.Site.Host)
+	newState.Projects = []jiraProjectsDataSourceProjects{}
+	for _, project := range projects {
+		p := jiraProjectsDataSourceProjects{
+			ID:             types.StringValue(project.ID),
+			Key:            types.StringValue(project.Key),
+			Name:           types.StringValue(project.Name),
+			Description:    types.StringValue(project.Description),
+			CategoryId:     types.Int64Null(),
+			LeadAccountId:  types.StringNull(),
+			ProjectTypeKey: types.StringValue(project.ProjectTypeKey),
+			Style:          types.StringValue(project.Style),
+			URL:            types.StringValue(project.URL),
+		}
+		if project.Category != nil {
+			categoryID, _ := strconv.Atoi(project.Category.ID)
+			p.CategoryId = types.Int64Value(int64(categoryID))
+		}
+		if project.Lead != nil {
+			p.LeadAccountId = types.StringValue(project.Lead.AccountID)
+		}
+		newState.Projects = append(newState.Projects, p)
+	}
+
+	tflog.Debug(ctx, "Storing projects into the state")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
@@ -0,0 +1,53 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraProjectsDataSource_Query(t *testing.T) {
+	randomKey := strings.ToUpper(acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
+	randomName := acctest.RandomWithPrefix("tf-test-project")
+	dataSourceName := "data.atlassian_jira_projects.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectsDataSourceConfig_query(dataSourceName, randomKey, randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "projects.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "projects.0.id", "atlassian_jira_project.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "projects.0.key", randomKey),
+					resource.TestCheckResourceAttr(dataSourceName, "projects.0.name", randomName),
+					resource.TestCheckResourceAttr(dataSourceName, "projects.0.project_type_key", "software"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "projects.0.lead_account_id", "atlassian_jira_project.test", "lead_account_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProjectsDataSourceConfig_query(dataSourceName, key, name string) string {
+	splits := strings.Split(dataSourceName, ".")
+	return fmt.Sprintf(`
+	data "atlassian_jira_myself" "test" {}
+
+	resource "atlassian_jira_project" "test" {
+		key              = %[3]q
+		name             = %[4]q
+		lead_account_id  = data.atlassian_jira_myself.test.account_id
+		project_type_key = "software"
+	}
+
+	data %[1]q %[2]q {
+		query     = atlassian_jira_project.test.key
+		type_keys = ["software"]
+	}
+	`, splits[1], splits[2], key, name)
+}
@@ -0,0 +1,152 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraResolutionsDataSource struct {
+		p atlassianProvider
+	}
+
+	jiraResolutionsDataSourceModel struct {
+		ID          types.String                               `tfsdk:"id"`
+		Resolutions []jiraResolutionsDataSourceResolutionModel `tfsdk:"resolutions"`
+	}
+
+	jiraResolutionsDataSourceResolutionModel struct {
+		ID          types.String `tfsdk:"id"`
+		Name        types.String `tfsdk:"name"`
+		Description types.String `tfsdk:"description"`
+		IsDefault   types.Bool   `tfsdk:"is_default"`
+	}
+)
+
+var (
+	_ datasource.DataSource = (*jiraResolutionsDataSource)(nil)
+)
+
+func NewJiraResolutionsDataSource() datasource.DataSource {
+	return &jiraResolutionsDataSource{}
+}
+
+func (*jiraResolutionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_resolutions"
+}
+
+func (*jiraResolutionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Jira Resolutions Data Source",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the data source. Defaults to the host of the Jira site.",
+				Computed:            true,
+			},
+			"resolutions": schema.ListNestedAttribute{
+				MarkdownDescription: "All resolutions of the Jira site.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the resolution.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the resolution.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the resolution.",
+							Computed:            true,
+						},
+						"is_default": schema.BoolAttribute{
+							MarkdownDescription: "Whether the resolution is the default resolution.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *jiraResolutionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.p.jira = client
+}
+
+func (d *jiraResolutionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Reading resolutions data source")
+
+	var newState jiraResolutionsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &newState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The go-atlassian library does not return the default flag of resolutions, so the "Search resolutions" endpoint is used.
+	var resolutions []jiraResolutionDetails
+	for startAt := 0; ; {
+		request, err := d.p.jira.NewRequest(ctx, http.MethodGet, fmt.Sprintf("rest/api/3/resolution/search?startAt=%d", startAt), "", nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create resolutions request, got error: %s", err))
+			return
+		}
+
+		page := new(jiraResolutionPage)
+		res, err := d.p.jira.Call(request, page)
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to search resolutions, got error: %s\n%s", err, resBody))
+			return
+		}
+
+		resolutions = append(resolutions, page.Values...)
+		if page.IsLast || len(page.Values) == 0 {
+			break
+		}
+		startAt += len(page.Values)
+	}
+	tflog.Debug(ctx, "Retrieved resolutions from API state", map[string]interface{}{
+		"readApiState": fmt.Sprintf("Resolutions Count:%d", len(resolutions)),
+	})
+
+	newState.ID = types.StringValue(d.p.jira.Site.Host)
+	newState.Resolutions = []jiraResolutionsDataSourceResolutionModel{}
+	for _, resolution := range resolutions {
+		newState.Resolutions = append(newState.Resolutions, jiraResolutionsDataSourceResolutionModel{
+			ID:          types.StringValue(resolution.ID),
+			Name:        types.StringValue(resolution.Name),
+			Description: types.StringValue(resolution.Description),
+			IsDefault:   types.BoolValue(resolution.IsDefault),
+		})
+	}
+
+	tflog.Debug(ctx, "Storing resolutions into the state")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
@@ -0,0 +1,46 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraResolutionsDataSource_Basic(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-resolution")
+	dataSourceName := "data.atlassian_jira_resolutions.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResolutionsDataSourceConfig_basic(dataSourceName, randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "resolutions.*", map[string]string{
+						"name":        randomName,
+						"description": "",
+						"is_default":  "false",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccResolutionsDataSourceConfig_basic(dataSourceName, name string) string {
+	splits := strings.Split(dataSourceName, ".")
+	return fmt.Sprintf(`
+	resource "atlassian_jira_resolution" "test" {
+		name                      = %[3]q
+		replacement_resolution_id = "10000"
+	}
+
+	data %[1]q %[2]q {
+		depends_on = [atlassian_jira_resolution.test]
+	}
+	`, splits[1], splits[2], name)
+}
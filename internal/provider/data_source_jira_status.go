@@ -3,11 +3,15 @@ package atlassian
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -41,13 +45,17 @@ func (*jiraStatusDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 		MarkdownDescription: "Jira Status Data Source",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				MarkdownDescription: "The ID of the status.",
-				Required:            true,
+				MarkdownDescription: "The ID of the status. Exactly one of `id` or `name` must be provided.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+				},
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the status." +
-					"The name must be unique." +
-					"The maximum length is 255 characters.",
+				MarkdownDescription: "The name of the status (case-insensitive). The name must match exactly one status. " +
+					"Exactly one of `id` or `name` must be provided.",
+				Optional: true,
 				Computed: true,
 			},
 			"description": schema.StringAttribute{
@@ -94,29 +102,69 @@ func (d *jiraStatusDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		"readConfig": fmt.Sprintf("%+v", newState),
 	})
 
-	statusId := newState.ID.ValueString()
-	if statusId == "" {
-		resp.Diagnostics.AddAttributeError(path.Root("id"), "Unable to parse value of \"id\" attribute.", "Value of \"id\" attribute can only be a numeric string.")
-		return
-	}
+	var status *models.WorkflowStatusDetailScheme
+	if !newState.ID.IsNull() {
+		statusId := newState.ID.ValueString()
+		if statusId == "" {
+			resp.Diagnostics.AddAttributeError(path.Root("id"), "Unable to parse value of \"id\" attribute.", "Value of \"id\" attribute can only be a numeric string.")
+			return
+		}
 
-	status, res, err := d.p.jira.Workflow.Status.Gets(ctx, []string{statusId}, nil)
-	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
+		statuses, res, err := d.p.jira.Workflow.Status.Gets(ctx, []string{statusId}, nil)
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get Jira status, got error: %s\n%s", err.Error(), resBody))
+			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get Jira status, got error: %s\n%s", err.Error(), resBody))
-		return
+		if len(statuses) == 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("id"), "Unable to find status", fmt.Sprintf("Unable to find a status with ID %q", statusId))
+			return
+		}
+		status = statuses[0]
+	} else {
+		s, err := d.findStatusByName(ctx, newState.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name"), "Unable to find status", err.Error())
+			return
+		}
+		status = s
 	}
 	tflog.Debug(ctx, "Retrieve status from API state", map[string]interface{}{
 		"readApiState": fmt.Sprintf("%+v", status),
 	})
 
-	newState.Name = types.StringValue(status[0].Name)
-	newState.Description = types.StringValue(status[0].Description)
-	newState.Category = types.StringValue(status[0].StatusCategory)
+	newState.ID = types.StringValue(status.ID)
+	newState.Name = types.StringValue(status.Name)
+	newState.Description = types.StringValue(status.Description)
+	newState.Category = types.StringValue(status.StatusCategory)
 
 	tflog.Debug(ctx, "Storing status info into the state")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
 }
+
+// findStatusByName returns the only status whose name equals the given name.
+// The status search matches partial names, so only exact matches are considered.
+func (d *jiraStatusDataSource) findStatusByName(ctx context.Context, name string) (*models.WorkflowStatusDetailScheme, error) {
+	statuses, err := searchJiraStatuses(ctx, d.p.jira, &models.WorkflowStatusSearchParams{SearchString: name})
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []*models.WorkflowStatusDetailScheme
+	for _, status := range statuses {
+		if strings.EqualFold(status.Name, name) {
+			matches = append(matches, status)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("Unable to find a status with name %q", name)
+	case 1:
+		return matches[0], nil
+	default:
+		return nil, fmt.Errorf("Found %d statuses with name %q, expected exactly one", len(matches), name)
+	}
+}
@@ -0,0 +1,53 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraStatusDataSource_Basic(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-status")
+	dataSourceName := "data.atlassian_jira_status.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStatusDataSourceConfig_lookup(dataSourceName, randomName, "id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "atlassian_jira_status.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", randomName),
+					resource.TestCheckResourceAttr(dataSourceName, "category", "TODO"),
+				),
+			},
+			{
+				Config: testAccStatusDataSourceConfig_lookup(dataSourceName, randomName, "name"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "atlassian_jira_status.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", randomName),
+				),
+			},
+		},
+	})
+}
+
+func testAccStatusDataSourceConfig_lookup(dataSourceName, name, attribute string) string {
+	splits := strings.Split(dataSourceName, ".")
+	return fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		name = %[3]q
+		status_category = "TODO"
+		status_scope = {
+			type = "GLOBAL"
+		}
+	}
+
+	data %[1]q %[2]q {
+		%[4]s = %[1]s.%[2]s.%[4]s
+	}
+	`, splits[1], splits[2], name, attribute)
+}
@@ -0,0 +1,177 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraStatusesDataSource struct {
+		p atlassianProvider
+	}
+
+	jiraStatusesDataSourceModel struct {
+		ID             types.String                     `tfsdk:"id"`
+		ProjectID      types.String                     `tfsdk:"project_id"`
+		Query          types.String                     `tfsdk:"query"`
+		StatusCategory types.String                     `tfsdk:"status_category"`
+		Statuses       []jiraStatusesDataSourceStatuses `tfsdk:"statuses"`
+	}
+
+	jiraStatusesDataSourceStatuses struct {
+		ID             types.String `tfsdk:"id"`
+		Name           types.String `tfsdk:"name"`
+		Description    types.String `tfsdk:"description"`
+		StatusCategory types.String `tfsdk:"status_category"`
+		ScopeType      types.String `tfsdk:"scope_type"`
+		ProjectID      types.String `tfsdk:"project_id"`
+	}
+)
+
+var (
+	_ datasource.DataSource = (*jiraStatusesDataSource)(nil)
+)
+
+func NewJiraStatusesDataSource() datasource.DataSource {
+	return &jiraStatusesDataSource{}
+}
+
+func (*jiraStatusesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_statuses"
+}
+
+func (*jiraStatusesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Jira Statuses Data Source",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the data source. Defaults to the host of the Jira site.",
+				Computed:            true,
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "Filter the statuses by the ID of the project they are used in. Statuses of team-managed projects are only returned when their project is provided.",
+				Optional:            true,
+			},
+			"query": schema.StringAttribute{
+				MarkdownDescription: "Filter the statuses by a literal string, which is matched against the status name (case-insensitive).",
+				Optional:            true,
+			},
+			"status_category": schema.StringAttribute{
+				MarkdownDescription: "Filter the statuses by category. Can be one of: `TODO`, `IN_PROGRESS`, `DONE`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("TODO", "IN_PROGRESS", "DONE"),
+				},
+			},
+			"statuses": schema.ListNestedAttribute{
+				MarkdownDescription: "The statuses matching the filters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the status.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the status.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the status.",
+							Computed:            true,
+						},
+						"status_category": schema.StringAttribute{
+							MarkdownDescription: "The category of the status, one of `TODO`, `IN_PROGRESS` or `DONE`.",
+							Computed:            true,
+						},
+						"scope_type": schema.StringAttribute{
+							MarkdownDescription: "The scope of the status. `GLOBAL` for company-managed projects and `PROJECT` for team-managed projects.",
+							Computed:            true,
+						},
+						"project_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the team-managed project of the status. Empty when `scope_type` is `GLOBAL`.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *jiraStatusesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.p.jira = client
+}
+
+func (d *jiraStatusesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Reading statuses data source")
+
+	var newState jiraStatusesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &newState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded statuses config", map[string]interface{}{
+		"readConfig": fmt.Sprintf("%+v", newState),
+	})
+
+	statuses, err := searchJiraStatuses(ctx, d.p.jira, &models.WorkflowStatusSearchParams{
+		ProjectID:      newState.ProjectID.ValueString(),
+		SearchString:   newState.Query.ValueString(),
+		StatusCategory: newState.StatusCategory.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	tflog.Debug(ctx, "Retrieved statuses from API state", map[string]interface{}{
+		"readApiState": fmt.Sprintf("Statuses Count:%d", len(statuses)),
+	})
+
+	newState.ID = types.StringValue(d.p.jira.Site.Host)
+	newState.Statuses = []jiraStatusesDataSourceStatuses{}
+	for _, status := range statuses {
+		s := jiraStatusesDataSourceStatuses{
+			ID:             types.StringValue(status.ID),
+			Name:           types.StringValue(status.Name),
+			Description:    types.StringValue(status.Description),
+			StatusCategory: types.StringValue(status.StatusCategory),
+			ScopeType:      types.StringValue(""),
+			ProjectID:      types.StringValue(""),
+		}
+		if status.Scope != nil {
+			s.ScopeType = types.StringValue(status.Scope.Type)
+			if status.Scope.Project != nil {
+				s.ProjectID = types.StringValue(status.Scope.Project.ID)
+			}
+		}
+		newState.Statuses = append(newState.Statuses, s)
+	}
+
+	tflog.Debug(ctx, "Storing statuses into the state")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
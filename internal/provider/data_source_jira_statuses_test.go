@@ -0,0 +1,49 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraStatusesDataSource_Query(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-statuses")
+	dataSourceName := "data.atlassian_jira_statuses.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStatusesDataSourceConfig_query(dataSourceName, randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "statuses.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "statuses.0.id", "atlassian_jira_status.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "statuses.0.name", randomName),
+					resource.TestCheckResourceAttr(dataSourceName, "statuses.0.status_category", "IN_PROGRESS"),
+					resource.TestCheckResourceAttr(dataSourceName, "statuses.0.scope_type", "GLOBAL"),
+				),
+			},
+		},
+	})
+}
+
+func testAccStatusesDataSourceConfig_query(dataSourceName, name string) string {
+	splits := strings.Split(dataSourceName, ".")
+	return fmt.Sprintf(`
+	resource "atlassian_jira_status" "test" {
+		name = %[3]q
+		status_category = "IN_PROGRESS"
+		status_scope = {
+			type = "GLOBAL"
+		}
+	}
+
+	data %[1]q %[2]q {
+		query           = atlassian_jira_status.test.name
+		status_category = atlassian_jira_status.test.status_category
+	}
+	`, splits[1], splits[2], name)
+}
@@ -0,0 +1,199 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraUserDataSource struct {
+		p atlassianProvider
+	}
+
+	jiraUserDataSourceModel struct {
+		ID           types.String `tfsdk:"id"`
+		AccountID    types.String `tfsdk:"account_id"`
+		EmailAddress types.String `tfsdk:"email_address"`
+		Query        types.String `tfsdk:"query"`
+		DisplayName  types.String `tfsdk:"display_name"`
+		Active       types.Bool   `tfsdk:"active"`
+		TimeZone     types.String `tfsdk:"timezone"`
+		AccountType  types.String `tfsdk:"account_type"`
+	}
+)
+
+var (
+	_ datasource.DataSource = (*jiraUserDataSource)(nil)
+)
+
+func NewJiraUserDataSource() datasource.DataSource {
+	return &jiraUserDataSource{}
+}
+
+func (*jiraUserDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_user"
+}
+
+func (*jiraUserDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Jira User Data Source",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the user. Defaults to `account_id`.",
+				Computed:            true,
+			},
+			"account_id": schema.StringAttribute{
+				MarkdownDescription: "The account ID of the user, which uniquely identifies the user across all Atlassian products. " +
+					"Exactly one of `account_id`, `email_address` or `query` must be provided.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("email_address"), path.MatchRoot("query")),
+				},
+			},
+			"email_address": schema.StringAttribute{
+				MarkdownDescription: "The email address of the user. Depending on the user’s privacy settings, this may be returned as null. " +
+					"Exactly one of `account_id`, `email_address` or `query` must be provided.",
+				Optional: true,
+				Computed: true,
+			},
+			"query": schema.StringAttribute{
+				MarkdownDescription: "A query string matched against the display name and email address of the users. " +
+					"The query must match exactly one user, or the display name of exactly one user. " +
+					"Exactly one of `account_id`, `email_address` or `query` must be provided.",
+				Optional: true,
+			},
+			"display_name": schema.StringAttribute{
+				MarkdownDescription: "The display name of the user. Depending on the user’s privacy settings, this may return an alternative value.",
+				Computed:            true,
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user is active.",
+				Computed:            true,
+			},
+			"timezone": schema.StringAttribute{
+				MarkdownDescription: "The time zone specified in the user's profile. Depending on the user’s privacy settings, this may be returned as null.",
+				Computed:            true,
+			},
+			"account_type": schema.StringAttribute{
+				MarkdownDescription: "The type of account represented by this user. This will be one of `atlassian` (normal users), `app` (application user) or `customer` (Jira Service Desk customer user)",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *jiraUserDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.p.jira = client
+}
+
+func (d *jiraUserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Reading user data source")
+
+	var newState jiraUserDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &newState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded user config", map[string]interface{}{
+		"readConfig": fmt.Sprintf("%+v", newState),
+	})
+
+	var user *models.UserScheme
+	switch {
+	case !newState.AccountID.IsNull():
+		u, res, err := d.p.jira.User.Get(ctx, newState.AccountID.ValueString(), nil)
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get user, got error: %s\n%s", err, resBody))
+			return
+		}
+		user = u
+	case !newState.EmailAddress.IsNull():
+		u, err := findJiraUserByEmail(ctx, d.p.jira, newState.EmailAddress.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("email_address"), "Unable to find user", err.Error())
+			return
+		}
+		user = u
+	default:
+		u, err := d.findUserByQuery(ctx, newState.Query.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("query"), "Unable to find user", err.Error())
+			return
+		}
+		user = u
+	}
+	tflog.Debug(ctx, "Retrieved user from API state", map[string]interface{}{
+		"readApiState": fmt.Sprintf("%+v", user),
+	})
+
+	newState.ID = types.StringValue(user.AccountID)
+	newState.AccountID = types.StringValue(user.AccountID)
+	// The email address is hidden by users with restrictive privacy settings, so a configured value is kept.
+	if newState.EmailAddress.IsNull() {
+		newState.EmailAddress = types.StringValue(user.EmailAddress)
+	}
+	newState.DisplayName = types.StringValue(user.DisplayName)
+	newState.Active = types.BoolValue(user.Active)
+	newState.TimeZone = types.StringValue(user.TimeZone)
+	newState.AccountType = types.StringValue(user.AccountType)
+
+	tflog.Debug(ctx, "Storing user into the state")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
+
+// findUserByQuery returns the only user matching the query, or the only user whose display name equals the query.
+func (d *jiraUserDataSource) findUserByQuery(ctx context.Context, query string) (*models.UserScheme, error) {
+	users, err := searchJiraUsers(ctx, d.p.jira, query)
+	if err != nil {
+		return nil, err
+	}
+	if len(users) == 1 {
+		return users[0], nil
+	}
+
+	var matches []*models.UserScheme
+	for _, user := range users {
+		if strings.EqualFold(user.DisplayName, query) {
+			matches = append(matches, user)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("Found %d users matching query %q, expected exactly one", len(users), query)
+	case 1:
+		return matches[0], nil
+	default:
+		return nil, fmt.Errorf("Found %d users with display name %q, expected exactly one", len(matches), query)
+	}
+}
@@ -0,0 +1,56 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraUserDataSource_AccountId(t *testing.T) {
+	dataSourceName := "data.atlassian_jira_user.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserDataSourceConfig_lookup(dataSourceName, "account_id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "data.atlassian_jira_myself.test", "account_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "account_id", "data.atlassian_jira_myself.test", "account_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "display_name", "data.atlassian_jira_myself.test", "display_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "active", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJiraUserDataSource_EmailAddress(t *testing.T) {
+	dataSourceName := "data.atlassian_jira_user.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserDataSourceConfig_lookup(dataSourceName, "email_address"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "account_id", "data.atlassian_jira_myself.test", "account_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "email_address", "data.atlassian_jira_myself.test", "email_address"),
+				),
+			},
+		},
+	})
+}
+
+func testAccUserDataSourceConfig_lookup(dataSourceName, attribute string) string {
+	splits := strings.Split(dataSourceName, ".")
+	return fmt.Sprintf(`
+	data "atlassian_jira_myself" "test" {}
+
+	data %[1]q %[2]q {
+		%[3]s = data.atlassian_jira_myself.test.%[3]s
+	}
+	`, splits[1], splits[2], attribute)
+}
@@ -0,0 +1,185 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraUsersDataSource struct {
+		p atlassianProvider
+	}
+
+	jiraUsersDataSourceModel struct {
+		ID              types.String                   `tfsdk:"id"`
+		Query           types.String                   `tfsdk:"query"`
+		GroupName       types.String                   `tfsdk:"group_name"`
+		IncludeInactive types.Bool                     `tfsdk:"include_inactive"`
+		Users           []jiraUsersDataSourceUserModel `tfsdk:"users"`
+	}
+
+	jiraUsersDataSourceUserModel struct {
+		AccountID    types.String `tfsdk:"account_id"`
+		EmailAddress types.String `tfsdk:"email_address"`
+		DisplayName  types.String `tfsdk:"display_name"`
+		Active       types.Bool   `tfsdk:"active"`
+		AccountType  types.String `tfsdk:"account_type"`
+	}
+)
+
+var (
+	_ datasource.DataSource = (*jiraUsersDataSource)(nil)
+)
+
+func NewJiraUsersDataSource() datasource.DataSource {
+	return &jiraUsersDataSource{}
+}
+
+func (*jiraUsersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_users"
+}
+
+func (*jiraUsersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Jira Users Data Source",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the data source. Defaults to `query` or `group_name`.",
+				Computed:            true,
+			},
+			"query": schema.StringAttribute{
+				MarkdownDescription: "A query string matched against the display name and email address of the users. Cannot be provided with `group_name`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("group_name")),
+				},
+			},
+			"group_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the group whose members are returned. Cannot be provided with `query`.",
+				Optional:            true,
+			},
+			"include_inactive": schema.BoolAttribute{
+				MarkdownDescription: "Whether inactive users are returned. Defaults to `false`.",
+				Optional:            true,
+			},
+			"users": schema.ListNestedAttribute{
+				MarkdownDescription: "The users matching the query or in the group.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"account_id": schema.StringAttribute{
+							MarkdownDescription: "The account ID of the user, which uniquely identifies the user across all Atlassian products.",
+							Computed:            true,
+						},
+						"email_address": schema.StringAttribute{
+							MarkdownDescription: "The email address of the user. Depending on the user’s privacy settings, this may be returned as null.",
+							Computed:            true,
+						},
+						"display_name": schema.StringAttribute{
+							MarkdownDescription: "The display name of the user. Depending on the user’s privacy settings, this may return an alternative value.",
+							Computed:            true,
+						},
+						"active": schema.BoolAttribute{
+							MarkdownDescription: "Whether the user is active.",
+							Computed:            true,
+						},
+						"account_type": schema.StringAttribute{
+							MarkdownDescription: "The type of account represented by this user. This will be one of `atlassian` (normal users), `app` (application user) or `customer` (Jira Service Desk customer user)",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *jiraUsersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.p.jira = client
+}
+
+func (d *jiraUsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Reading users data source")
+
+	var newState jiraUsersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &newState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded users config", map[string]interface{}{
+		"readConfig": fmt.Sprintf("%+v", newState),
+	})
+
+	includeInactive := newState.IncludeInactive.ValueBool()
+	users := []jiraUsersDataSourceUserModel{}
+
+	if !newState.GroupName.IsNull() {
+		members, err := getJiraGroupMembers(ctx, d.p.jira, newState.GroupName.ValueString(), includeInactive)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+		for _, u := range members {
+			users = append(users, jiraUsersDataSourceUserModel{
+				AccountID:    types.StringValue(u.AccountID),
+				EmailAddress: types.StringValue(u.EmailAddress),
+				DisplayName:  types.StringValue(u.DisplayName),
+				Active:       types.BoolValue(u.Active),
+				AccountType:  types.StringValue(u.AccountType),
+			})
+		}
+		newState.ID = newState.GroupName
+	} else {
+		found, err := searchAllJiraUsers(ctx, d.p.jira, newState.Query.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+		for _, u := range found {
+			// The user search returns both active and inactive users
+			if !u.Active && !includeInactive {
+				continue
+			}
+			users = append(users, jiraUsersDataSourceUserModel{
+				AccountID:    types.StringValue(u.AccountID),
+				EmailAddress: types.StringValue(u.EmailAddress),
+				DisplayName:  types.StringValue(u.DisplayName),
+				Active:       types.BoolValue(u.Active),
+				AccountType:  types.StringValue(u.AccountType),
+			})
+		}
+		newState.ID = newState.Query
+	}
+	tflog.Debug(ctx, "Retrieved users from API state", map[string]interface{}{
+		"readApiState": fmt.Sprintf("Users Count:%d", len(users)),
+	})
+
+	newState.Users = users
+
+	tflog.Debug(ctx, "Storing users into the state")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
@@ -0,0 +1,80 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraUsersDataSource_GroupName(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-users")
+	dataSourceName := "data.atlassian_jira_users.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsersDataSourceConfig_groupName(dataSourceName, randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", randomName),
+					resource.TestCheckResourceAttr(dataSourceName, "users.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "users.0.account_id", "data.atlassian_jira_myself.test", "account_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "users.0.display_name", "data.atlassian_jira_myself.test", "display_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "users.0.active", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJiraUsersDataSource_Query(t *testing.T) {
+	dataSourceName := "data.atlassian_jira_users.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsersDataSourceConfig_query(dataSourceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "data.atlassian_jira_myself.test", "email_address"),
+					resource.TestCheckResourceAttr(dataSourceName, "users.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "users.0.account_id", "data.atlassian_jira_myself.test", "account_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccUsersDataSourceConfig_groupName(dataSourceName, groupName string) string {
+	splits := strings.Split(dataSourceName, ".")
+	return fmt.Sprintf(`
+	data "atlassian_jira_myself" "test" {}
+
+	resource "atlassian_jira_group" "test" {
+		name = %[3]q
+	}
+
+	resource "atlassian_jira_group_user" "test" {
+		group_name = atlassian_jira_group.test.name
+		account_id = data.atlassian_jira_myself.test.account_id
+	}
+
+	data %[1]q %[2]q {
+		group_name = atlassian_jira_group_user.test.group_name
+	}
+	`, splits[1], splits[2], groupName)
+}
+
+func testAccUsersDataSourceConfig_query(dataSourceName string) string {
+	splits := strings.Split(dataSourceName, ".")
+	return fmt.Sprintf(`
+	data "atlassian_jira_myself" "test" {}
+
+	data %[1]q %[2]q {
+		query = data.atlassian_jira_myself.test.email_address
+	}
+	`, splits[1], splits[2])
+}
@@ -0,0 +1,247 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraWorkflowDataSource struct {
+		p atlassianProvider
+	}
+
+	jiraWorkflowDataSourceModel struct {
+		ID          types.String                            `tfsdk:"id"`
+		Name        types.String                            `tfsdk:"name"`
+		Description types.String                            `tfsdk:"description"`
+		IsDefault   types.Bool                              `tfsdk:"is_default"`
+		Statuses    []jiraWorkflowDataSourceStatusModel     `tfsdk:"statuses"`
+		Transitions []jiraWorkflowDataSourceTransitionModel `tfsdk:"transitions"`
+	}
+
+	jiraWorkflowDataSourceStatusModel struct {
+		ID   types.String `tfsdk:"id"`
+		Name types.String `tfsdk:"name"`
+	}
+
+	jiraWorkflowDataSourceTransitionModel struct {
+		ID          types.String `tfsdk:"id"`
+		Name        types.String `tfsdk:"name"`
+		Description types.String `tfsdk:"description"`
+		From        types.List   `tfsdk:"from"`
+		To          types.String `tfsdk:"to"`
+		Type        types.String `tfsdk:"type"`
+		ScreenId    types.String `tfsdk:"screen_id"`
+	}
+)
+
+var (
+	_ datasource.DataSource = (*jiraWorkflowDataSource)(nil)
+)
+
+func NewJiraWorkflowDataSource() datasource.DataSource {
+	return &jiraWorkflowDataSource{}
+}
+
+func (*jiraWorkflowDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_workflow"
+}
+
+func (*jiraWorkflowDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Jira Workflow Data Source",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The entity ID of the workflow. Exactly one of `id` or `name` must be provided.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the workflow. Exactly one of `id` or `name` must be provided.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the workflow.",
+				Computed:            true,
+			},
+			"is_default": schema.BoolAttribute{
+				MarkdownDescription: "Whether this is the default workflow.",
+				Computed:            true,
+			},
+			"statuses": schema.ListNestedAttribute{
+				MarkdownDescription: "The statuses of the workflow.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the status.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the status in the workflow.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"transitions": schema.ListNestedAttribute{
+				MarkdownDescription: "The transitions of the workflow.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the transition.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the transition.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the transition.",
+							Computed:            true,
+						},
+						"from": schema.ListAttribute{
+							MarkdownDescription: "The IDs of the statuses the transition can start from. Null for `initial` and `global` transitions.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"to": schema.StringAttribute{
+							MarkdownDescription: "The ID of the status the transition goes to.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of the transition, one of `initial`, `directed` or `global`.",
+							Computed:            true,
+						},
+						"screen_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the screen shown for the transition.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *jiraWorkflowDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.p.jira = client
+}
+
+func (d *jiraWorkflowDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Reading workflow data source")
+
+	var newState jiraWorkflowDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &newState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded workflow config", map[string]interface{}{
+		"readConfig": fmt.Sprintf("%+v", newState),
+	})
+
+	options := &models.WorkflowSearchOptions{
+		Expand: []string{"transitions", "statuses"},
+	}
+	// The workflow search cannot filter by entity ID, so all workflows are searched when looking up by ID.
+	lookup := fmt.Sprintf("ID %q", newState.ID.ValueString())
+	if !newState.Name.IsNull() {
+		options.WorkflowName = []string{newState.Name.ValueString()}
+		lookup = fmt.Sprintf("name %q", newState.Name.ValueString())
+	}
+	workflows, err := searchJiraWorkflows(ctx, d.p.jira, options)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	var workflow *models.WorkflowScheme
+	for _, w := range workflows {
+		if w.ID == nil {
+			continue
+		}
+		if (!newState.ID.IsNull() && w.ID.EntityID == newState.ID.ValueString()) ||
+			(!newState.Name.IsNull() && w.ID.Name == newState.Name.ValueString()) {
+			workflow = w
+			break
+		}
+	}
+	if workflow == nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find workflow with %s", lookup))
+		return
+	}
+	tflog.Debug(ctx, "Retrieved workflow from API state", map[string]interface{}{
+		"readApiState": fmt.Sprintf("%+v", workflow.ID),
+	})
+
+	newState.ID = types.StringValue(workflow.ID.EntityID)
+	newState.Name = types.StringValue(workflow.ID.Name)
+	newState.Description = types.StringValue(workflow.Description)
+	newState.IsDefault = types.BoolValue(workflow.IsDefault)
+
+	newState.Statuses = []jiraWorkflowDataSourceStatusModel{}
+	for _, s := range workflow.Statuses {
+		newState.Statuses = append(newState.Statuses, jiraWorkflowDataSourceStatusModel{
+			ID:   types.StringValue(s.ID),
+			Name: types.StringValue(s.Name),
+		})
+	}
+
+	newState.Transitions = []jiraWorkflowDataSourceTransitionModel{}
+	for _, t := range workflow.Transitions {
+		transition := jiraWorkflowDataSourceTransitionModel{
+			ID:          types.StringValue(t.ID),
+			Name:        types.StringValue(t.Name),
+			Description: types.StringValue(t.Description),
+			From:        types.ListNull(types.StringType),
+			To:          types.StringValue(t.To),
+			Type:        types.StringValue(t.Type),
+			ScreenId:    types.StringValue(""),
+		}
+		if len(t.From) > 0 {
+			from, diags := types.ListValueFrom(ctx, types.StringType, t.From)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			transition.From = from
+		}
+		if t.Screen != nil {
+			transition.ScreenId = types.StringValue(t.Screen.ID)
+		}
+		newState.Transitions = append(newState.Transitions, transition)
+	}
+
+	tflog.Debug(ctx, "Storing workflow into the state")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
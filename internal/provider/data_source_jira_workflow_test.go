@@ -0,0 +1,49 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraWorkflowDataSource_Basic(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-workflow")
+	dataSourceName := "data.atlassian_jira_workflow.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkflowDataSourceConfig_lookup(dataSourceName, randomName, "name"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "atlassian_jira_workflow.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", randomName),
+					resource.TestCheckResourceAttr(dataSourceName, "is_default", "false"),
+					resource.TestCheckResourceAttr(dataSourceName, "statuses.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "transitions.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "transitions.1.name", "Done"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "transitions.1.to", "atlassian_jira_status.done", "id"),
+				),
+			},
+			{
+				Config: testAccWorkflowDataSourceConfig_lookup(dataSourceName, randomName, "id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "atlassian_jira_workflow.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", randomName),
+				),
+			},
+		},
+	})
+}
+
+func testAccWorkflowDataSourceConfig_lookup(dataSourceName, name, attribute string) string {
+	splits := strings.Split(dataSourceName, ".")
+	return testAccWorkflowConfig_basic("atlassian_jira_workflow.test", name) + fmt.Sprintf(`
+	data %[1]q %[2]q {
+		%[3]s = atlassian_jira_workflow.test.%[3]s
+	}
+	`, splits[1], splits[2], attribute)
+}
@@ -0,0 +1,204 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraWorkflowsDataSource struct {
+		p atlassianProvider
+	}
+
+	jiraWorkflowsDataSourceModel struct {
+		ID        types.String                       `tfsdk:"id"`
+		Query     types.String                       `tfsdk:"query"`
+		IsActive  types.Bool                         `tfsdk:"is_active"`
+		Workflows []jiraWorkflowsDataSourceWorkflows `tfsdk:"workflows"`
+	}
+
+	jiraWorkflowsDataSourceWorkflows struct {
+		ID          types.String `tfsdk:"id"`
+		Name        types.String `tfsdk:"name"`
+		Description types.String `tfsdk:"description"`
+		ScopeType   types.String `tfsdk:"scope_type"`
+		ProjectID   types.String `tfsdk:"project_id"`
+	}
+
+	// The go-atlassian library does not support the workflows search, which returns the scope of the workflows.
+	jiraWorkflowsSearchPage struct {
+		IsLast bool                        `json:"isLast"`
+		Values []jiraWorkflowsSearchResult `json:"values"`
+	}
+
+	jiraWorkflowsSearchResult struct {
+		ID          string `json:"id"`
+		Name        string `json:"name"`
+		Description string `json:"description"`
+		Scope       *struct {
+			Type    string `json:"type"`
+			Project *struct {
+				ID string `json:"id"`
+			} `json:"project"`
+		} `json:"scope"`
+	}
+)
+
+var (
+	_ datasource.DataSource = (*jiraWorkflowsDataSource)(nil)
+)
+
+func NewJiraWorkflowsDataSource() datasource.DataSource {
+	return &jiraWorkflowsDataSource{}
+}
+
+func (*jiraWorkflowsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_workflows"
+}
+
+func (*jiraWorkflowsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Jira Workflows Data Source",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the data source. Defaults to the host of the Jira site.",
+				Computed:            true,
+			},
+			"query": schema.StringAttribute{
+				MarkdownDescription: "Filter the workflows by a literal string, which is matched against the workflow name (case-insensitive).",
+				Optional:            true,
+			},
+			"is_active": schema.BoolAttribute{
+				MarkdownDescription: "Filter the workflows by whether they are used by a workflow scheme. Defaults to both active and inactive workflows.",
+				Optional:            true,
+			},
+			"workflows": schema.ListNestedAttribute{
+				MarkdownDescription: "The workflows matching the filters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The entity ID of the workflow.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the workflow.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the workflow.",
+							Computed:            true,
+						},
+						"scope_type": schema.StringAttribute{
+							MarkdownDescription: "The scope of the workflow. `GLOBAL` for company-managed projects and `PROJECT` for team-managed projects.",
+							Computed:            true,
+						},
+						"project_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the team-managed project of the workflow. Empty when `scope_type` is `GLOBAL`.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *jiraWorkflowsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.p.jira = client
+}
+
+func (d *jiraWorkflowsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Debug(ctx, "Reading workflows data source")
+
+	var newState jiraWorkflowsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &newState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded workflows config", map[string]interface{}{
+		"readConfig": fmt.Sprintf("%+v", newState),
+	})
+
+	params := url.Values{}
+	params.Add("maxResults", strconv.Itoa(jiraWorkflowSearchMaxResults))
+	if !newState.Query.IsNull() {
+		params.Add("queryString", newState.Query.ValueString())
+	}
+	if !newState.IsActive.IsNull() {
+		params.Add("isActive", strconv.FormatBool(newState.IsActive.ValueBool()))
+	}
+
+	var workflows []jiraWorkflowsSearchResult
+	for startAt := 0; ; startAt += jiraWorkflowSearchMaxResults {
+		params.Set("startAt", strconv.Itoa(startAt))
+		request, err := d.p.jira.NewRequest(ctx, http.MethodGet, fmt.Sprintf("rest/api/3/workflows/search?%s", params.Encode()), "", nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create workflows search request, got error: %s", err))
+			return
+		}
+		var page jiraWorkflowsSearchPage
+		res, err := d.p.jira.Call(request, &page)
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to search workflows, got error: %s\n%s", err, resBody))
+			return
+		}
+		workflows = append(workflows, page.Values...)
+		if page.IsLast || len(page.Values) == 0 {
+			break
+		}
+	}
+	tflog.Debug(ctx, "Retrieved workflows from API state", map[string]interface{}{
+		"readApiState": fmt.Sprintf("Workflows Count:%d", len(workflows)),
+	})
+
+	newState.ID = types.StringValue(d.p.jira.Site.Host)
+	newState.Workflows = []jiraWorkflowsDataSourceWorkflows{}
+	for _, workflow := range workflows {
+		w := jiraWorkflowsDataSourceWorkflows{
+			ID:          types.StringValue(workflow.ID),
+			Name:        types.StringValue(workflow.Name),
+			Description: types.StringValue(workflow.Description),
+			ScopeType:   types.StringValue(""),
+			ProjectID:   types.StringValue(""),
+		}
+		if workflow.Scope != nil {
+			w.ScopeType = types.StringValue(workflow.Scope.Type)
+			if workflow.Scope.Project != nil {
+				w.ProjectID = types.StringValue(workflow.Scope.Project.ID)
+			}
+		}
+		newState.Workflows = append(newState.Workflows, w)
+	}
+
+	tflog.Debug(ctx, "Storing workflows into the state")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
+}
@@ -0,0 +1,40 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraWorkflowsDataSource_Query(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-workflows")
+	dataSourceName := "data.atlassian_jira_workflows.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkflowsDataSourceConfig_query(dataSourceName, randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "workflows.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "workflows.0.id", "atlassian_jira_workflow.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "workflows.0.name", randomName),
+					resource.TestCheckResourceAttr(dataSourceName, "workflows.0.scope_type", "GLOBAL"),
+				),
+			},
+		},
+	})
+}
+
+func testAccWorkflowsDataSourceConfig_query(dataSourceName, name string) string {
+	splits := strings.Split(dataSourceName, ".")
+	return testAccWorkflowConfig_basic("atlassian_jira_workflow.test", name) + fmt.Sprintf(`
+	data %[1]q %[2]q {
+		query     = atlassian_jira_workflow.test.name
+		is_active = false
+	}
+	`, splits[1], splits[2])
+}
@@ -6,9 +6,12 @@ import (
 	"strconv"
 
 	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -24,6 +27,9 @@ type (
 	}
 )
 
+// jiraWorkflowSchemeSearchMaxResults is the maximum number of workflow schemes returned by a single page of workflow schemes.
+const jiraWorkflowSchemeSearchMaxResults = 50
+
 var (
 	_ datasource.DataSource = (*jiraWorkflowSchemeDataSource)(nil)
 )
@@ -41,11 +47,16 @@ func (*jiraWorkflowSchemeDataSource) Schema(_ context.Context, _ datasource.Sche
 		MarkdownDescription: "Jira Workflow Scheme Data Source",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				MarkdownDescription: "The ID of the workflow scheme.",
-				Required:            true,
+				MarkdownDescription: "The ID of the workflow scheme. Exactly one of `id` or `name` must be provided.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+				},
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the workflow scheme.",
+				MarkdownDescription: "The name of the workflow scheme. Exactly one of `id` or `name` must be provided.",
+				Optional:            true,
 				Computed:            true,
 			},
 			"description": schema.StringAttribute{
@@ -87,28 +98,67 @@ func (d *jiraWorkflowSchemeDataSource) Read(ctx context.Context, req datasource.
 		"readConfig": fmt.Sprintf("%+v", newState),
 	})
 
-	workflowSchemeId, err := strconv.Atoi(newState.ID.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddAttributeError(path.Root("id"), "Unable to parse value of \"id\" attribute.", "Value of \"id\" attribute can only be a numeric string.")
-		return
-	}
+	var workflowScheme *models.WorkflowSchemeScheme
+	if !newState.ID.IsNull() {
+		workflowSchemeId, err := strconv.Atoi(newState.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("id"), "Unable to parse value of \"id\" attribute.", "Value of \"id\" attribute can only be a numeric string.")
+			return
+		}
 
-	workflowScheme, res, err := d.p.jira.Workflow.Scheme.Get(ctx, workflowSchemeId, false)
-	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
+		scheme, res, err := d.p.jira.Workflow.Scheme.Get(ctx, workflowSchemeId, false)
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get Jira workflow scheme, got error: %s\n%s", err.Error(), resBody))
+			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get Jira workflow scheme, got error: %s\n%s", err.Error(), resBody))
-		return
+		workflowScheme = scheme
+	} else {
+		scheme, err := d.findWorkflowSchemeByName(ctx, newState.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+		if scheme == nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name"), "Unable to find workflow scheme", fmt.Sprintf("Unable to find a workflow scheme with name %q", newState.Name.ValueString()))
+			return
+		}
+		workflowScheme = scheme
 	}
 	tflog.Debug(ctx, "Retrieve status from API state", map[string]interface{}{
 		"readApiState": fmt.Sprintf("%+v", workflowScheme),
 	})
 
+	newState.ID = types.StringValue(strconv.Itoa(workflowScheme.ID))
 	newState.Name = types.StringValue(workflowScheme.Name)
 	newState.Description = types.StringValue(workflowScheme.Description)
 
 	tflog.Debug(ctx, "Storing workflow scheme info into the state")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
 }
+
+// findWorkflowSchemeByName returns the workflow scheme with the given name, or nil if there is none.
+// Workflow schemes cannot be searched by name, so all pages of workflow schemes are scanned.
+func (d *jiraWorkflowSchemeDataSource) findWorkflowSchemeByName(ctx context.Context, name string) (*models.WorkflowSchemeScheme, error) {
+	for startAt := 0; ; startAt += jiraWorkflowSchemeSearchMaxResults {
+		page, res, err := d.p.jira.Workflow.Scheme.Gets(ctx, startAt, jiraWorkflowSchemeSearchMaxResults)
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			return nil, fmt.Errorf("Unable to get Jira workflow schemes, got error: %s\n%s", err.Error(), resBody)
+		}
+		for _, scheme := range page.Values {
+			if scheme.Name == name {
+				return scheme, nil
+			}
+		}
+		if page.IsLast || len(page.Values) == 0 {
+			return nil, nil
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraWorkflowSchemeDataSource_Basic(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-workflow-scheme")
+	dataSourceName := "data.atlassian_jira_workflow_scheme.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkflowSchemeDataSourceConfig_lookup(dataSourceName, randomName, "id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "atlassian_jira_workflow_scheme.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", randomName),
+				),
+			},
+			{
+				Config: testAccWorkflowSchemeDataSourceConfig_lookup(dataSourceName, randomName, "name"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", "atlassian_jira_workflow_scheme.test", "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", randomName),
+				),
+			},
+		},
+	})
+}
+
+func testAccWorkflowSchemeDataSourceConfig_lookup(dataSourceName, name, attribute string) string {
+	splits := strings.Split(dataSourceName, ".")
+	return fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		name = %[3]q
+	}
+
+	data %[1]q %[2]q {
+		%[4]s = %[1]s.%[2]s.%[4]s
+	}
+	`, splits[1], splits[2], name, attribute)
+}
@@ -0,0 +1,126 @@
+package fixtures
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// Client is an HTTP client which serves recorded Jira REST API responses from
+// a local directory instead of sending requests over the network.
+//
+// A request for `GET /rest/api/3/project/10000` is answered with the contents of
+// `<dir>/rest/api/3/project/10000.json`. A request with a query string is first
+// looked up under `<path>@<query>.json`, where `<query>` is the query string with
+// its keys sorted and its values escaped, e.g. `GET /rest/api/3/project/search?startAt=50`
+// is answered from `<dir>/rest/api/3/project/search@startAt=50.json`. If no such
+// fixture exists the path-only fixture is used instead, except for later pages
+// (i.e. a `startAt` other than `0`) which are answered with an empty page so that
+// paginated reads terminate. Requests for which no fixture exists are answered
+// with a `404 Not Found` response. Any request which is not a read (i.e. not
+// `GET`, `HEAD` or `OPTIONS`) is rejected with an error.
+type Client struct {
+	dir string
+}
+
+// NewClient returns a new Client which serves fixtures from dir.
+func NewClient(dir string) (*Client, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, fmt.Errorf("unable to access fixtures directory %q: %w", dir, err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("fixtures path %q is not a directory", dir)
+	}
+
+	return &Client{dir: dir}, nil
+}
+
+// ErrWriteNotAllowed is returned for any request which would modify remote state.
+var ErrWriteNotAllowed = errors.New("write operations are not allowed in mock mode")
+
+// Do implements the HTTP client interface expected by the go-atlassian library.
+func (c *Client) Do(req *http.Request) (*http.Response, error) {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+	default:
+		return nil, fmt.Errorf("%w: %s %s", ErrWriteNotAllowed, req.Method, req.URL.Path)
+	}
+
+	body, err := c.readFixture(req)
+	if errors.Is(err, os.ErrNotExist) {
+		return newResponse(req, http.StatusNotFound,
+			fmt.Sprintf(`{"errorMessages":["No fixture found for %s %s"],"errors":{}}`, req.Method, req.URL.Path)), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read fixture for %s %s: %w", req.Method, req.URL.Path, err)
+	}
+
+	return newResponse(req, http.StatusOK, string(body)), nil
+}
+
+// readFixture returns the recorded response for req, preferring a fixture
+// recorded for the exact query string over the path-only fixture.
+func (c *Client) readFixture(req *http.Request) ([]byte, error) {
+	query := req.URL.Query()
+	if len(query) > 0 {
+		body, err := os.ReadFile(c.fixturePath(req, query.Encode()))
+		if !errors.Is(err, os.ErrNotExist) {
+			return body, err
+		}
+	}
+
+	body, err := os.ReadFile(c.fixturePath(req, ""))
+	if err != nil {
+		return nil, err
+	}
+
+	// The path-only fixture represents the first page of results. Serving it again
+	// for every later page would make paginated reads loop forever.
+	if startAt := query.Get("startAt"); startAt != "" && startAt != "0" {
+		return emptyPage(body), nil
+	}
+
+	return body, nil
+}
+
+// fixturePath maps the URL path of req, and optionally its encoded query string,
+// to a file path inside the fixtures directory.
+func (c *Client) fixturePath(req *http.Request, query string) string {
+	// Cleaning the rooted path prevents ".." elements from escaping the fixtures directory.
+	p := strings.TrimPrefix(path.Clean("/"+req.URL.Path), "/")
+	if query != "" {
+		// The encoded query string cannot contain a path separator, since
+		// url.Values.Encode escapes "/".
+		p += "@" + query
+	}
+	return filepath.Join(c.dir, filepath.FromSlash(p)+".json")
+}
+
+// emptyPage returns an empty page of results shaped like the first page in body.
+func emptyPage(body []byte) []byte {
+	if bytes.HasPrefix(bytes.TrimSpace(body), []byte("[")) {
+		return []byte(`[]`)
+	}
+	return []byte(`{"isLast":true,"values":[]}`)
+}
+
+func newResponse(req *http.Request, statusCode int, body string) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+		StatusCode:    statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(bytes.NewBufferString(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
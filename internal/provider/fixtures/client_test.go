@@ -0,0 +1,144 @@
+package fixtures
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestClient_Do(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "rest", "api", "3", "project"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	fixtures := map[string]string{
+		"10000.json":                         `{"id":"10000"}`,
+		"search.json":                        `{"isLast":false,"values":[{"id":"10000"}]}`,
+		"search@query=TEST.json":             `{"isLast":true,"values":[{"id":"10001"}]}`,
+		"search@startAt=50.json":             `{"isLast":true,"values":[{"id":"10002"}]}`,
+		"recent.json":                        `[{"id":"10000"}]`,
+		"search@orderBy=key&query=TEST.json": `{"isLast":true,"values":[{"id":"10003"}]}`,
+	}
+	for name, body := range fixtures {
+		if err := os.WriteFile(filepath.Join(dir, "rest", "api", "3", "project", name), []byte(body), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	c, err := NewClient(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		method       string
+		url          string
+		expectedCode int
+		expectedBody string
+		expectedErr  error
+	}{
+		"existing fixture": {
+			method:       http.MethodGet,
+			url:          "https://mock.atlassian.net/rest/api/3/project/10000?expand=all",
+			expectedCode: http.StatusOK,
+			expectedBody: `{"id":"10000"}`,
+		},
+		"query fixture": {
+			method:       http.MethodGet,
+			url:          "https://mock.atlassian.net/rest/api/3/project/search?query=TEST",
+			expectedCode: http.StatusOK,
+			expectedBody: `{"isLast":true,"values":[{"id":"10001"}]}`,
+		},
+		"query fixture with unordered keys": {
+			method:       http.MethodGet,
+			url:          "https://mock.atlassian.net/rest/api/3/project/search?query=TEST&orderBy=key",
+			expectedCode: http.StatusOK,
+			expectedBody: `{"isLast":true,"values":[{"id":"10003"}]}`,
+		},
+		"query fixture for later page": {
+			method:       http.MethodGet,
+			url:          "https://mock.atlassian.net/rest/api/3/project/search?startAt=50",
+			expectedCode: http.StatusOK,
+			expectedBody: `{"isLast":true,"values":[{"id":"10002"}]}`,
+		},
+		"path fallback for first page": {
+			method:       http.MethodGet,
+			url:          "https://mock.atlassian.net/rest/api/3/project/search?startAt=0&maxResults=50",
+			expectedCode: http.StatusOK,
+			expectedBody: `{"isLast":false,"values":[{"id":"10000"}]}`,
+		},
+		"path fallback for later page": {
+			method:       http.MethodGet,
+			url:          "https://mock.atlassian.net/rest/api/3/project/search?startAt=100&maxResults=50",
+			expectedCode: http.StatusOK,
+			expectedBody: `{"isLast":true,"values":[]}`,
+		},
+		"path fallback for later page of array": {
+			method:       http.MethodGet,
+			url:          "https://mock.atlassian.net/rest/api/3/project/recent?startAt=100",
+			expectedCode: http.StatusOK,
+			expectedBody: `[]`,
+		},
+		"missing fixture": {
+			method:       http.MethodGet,
+			url:          "https://mock.atlassian.net/rest/api/3/project/20000",
+			expectedCode: http.StatusNotFound,
+		},
+		"path traversal": {
+			method:       http.MethodGet,
+			url:          "https://mock.atlassian.net/../../etc/passwd",
+			expectedCode: http.StatusNotFound,
+		},
+		"write request": {
+			method:      http.MethodPost,
+			url:         "https://mock.atlassian.net/rest/api/3/project",
+			expectedErr: ErrWriteNotAllowed,
+		},
+		"delete request": {
+			method:      http.MethodDelete,
+			url:         "https://mock.atlassian.net/rest/api/3/project/10000",
+			expectedErr: ErrWriteNotAllowed,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			req, err := http.NewRequest(test.method, test.url, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			res, err := c.Do(req)
+			if test.expectedErr != nil {
+				if !errors.Is(err, test.expectedErr) {
+					t.Fatalf("expected error %q, got %v", test.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			defer res.Body.Close()
+
+			if res.StatusCode != test.expectedCode {
+				t.Errorf("expected status code %d, got %d", test.expectedCode, res.StatusCode)
+			}
+			if test.expectedBody != "" {
+				body, _ := io.ReadAll(res.Body)
+				if string(body) != test.expectedBody {
+					t.Errorf("expected body %q, got %q", test.expectedBody, string(body))
+				}
+			}
+		})
+	}
+}
+
+func TestNewClient_InvalidDirectory(t *testing.T) {
+	if _, err := NewClient(filepath.Join(t.TempDir(), "missing")); err == nil {
+		t.Fatal("expected error for missing fixtures directory")
+	}
+}
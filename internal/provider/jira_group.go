@@ -0,0 +1,31 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+)
+
+// jiraGroupMembersMaxResults is the maximum number of members returned by a single page of group members.
+const jiraGroupMembersMaxResults = 100
+
+// getJiraGroupMembers returns all members of the group, following the pagination of the API.
+func getJiraGroupMembers(ctx context.Context, client *jira.Client, groupName string, includeInactive bool) ([]*models.GroupUserDetailScheme, error) {
+	members := []*models.GroupUserDetailScheme{}
+	for startAt := 0; ; startAt += jiraGroupMembersMaxResults {
+		page, res, err := client.Group.Members(ctx, groupName, includeInactive, startAt, jiraGroupMembersMaxResults)
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			return nil, fmt.Errorf("Unable to get group members, got error: %s\n%s", err, resBody)
+		}
+		members = append(members, page.Values...)
+		if page.IsLast || len(page.Values) == 0 {
+			return members, nil
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+)
+
+// jiraStatusSearchMaxResults is the maximum number of statuses returned by a single page of the status search.
+const jiraStatusSearchMaxResults = 200
+
+// searchJiraStatuses returns all statuses matching the options, following the pagination of the API.
+func searchJiraStatuses(ctx context.Context, client *jira.Client, options *models.WorkflowStatusSearchParams) ([]*models.WorkflowStatusDetailScheme, error) {
+	statuses := []*models.WorkflowStatusDetailScheme{}
+	for startAt := 0; ; startAt += jiraStatusSearchMaxResults {
+		page, res, err := client.Workflow.Status.Search(ctx, options, startAt, jiraStatusSearchMaxResults)
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			return nil, fmt.Errorf("Unable to search statuses, got error: %s\n%s", err, resBody)
+		}
+		statuses = append(statuses, page.Values...)
+		if page.IsLast || len(page.Values) == 0 {
+			return statuses, nil
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// jiraTaskPollInterval is the time to wait between two checks of the status of a long-running task.
+var jiraTaskPollInterval = 2 * time.Second
+
+// taskIdFromResponse returns the ID of the long-running task a response was redirected to, if any.
+// Asynchronous operations answer with "303 See Other" pointing at "rest/api/3/task/{taskId}".
+func taskIdFromResponse(res *models.ResponseScheme) string {
+	if res == nil || !strings.Contains(res.Endpoint, "/task/") {
+		return ""
+	}
+	return path.Base(strings.SplitN(res.Endpoint, "?", 2)[0])
+}
+
+// waitForJiraTask blocks until the long-running task has finished, returning an error if it did not complete successfully.
+func waitForJiraTask(ctx context.Context, client *jira.Client, taskId string) error {
+	for {
+		task, res, err := client.Task.Get(ctx, taskId)
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			return fmt.Errorf("Unable to get task %s, got error: %s\n%s", taskId, err, resBody)
+		}
+		tflog.Debug(ctx, "Retrieved task from API state", map[string]interface{}{
+			"taskId":   task.ID,
+			"status":   task.Status,
+			"progress": task.Progress,
+		})
+
+		switch task.Status {
+		case "COMPLETE":
+			return nil
+		case "FAILED", "CANCELLED", "DEAD":
+			return fmt.Errorf("Task %s finished with status %s: %s", taskId, task.Status, task.Result)
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("Unable to wait for task %s to finish, got error: %s", taskId, ctx.Err())
+		case <-time.After(jiraTaskPollInterval):
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+)
+
+// jiraUserSearchMaxResults is the maximum number of users returned by a single user search.
+const jiraUserSearchMaxResults = 50
+
+// searchJiraUsers returns the users matching the query, which is matched against display names and email addresses.
+func searchJiraUsers(ctx context.Context, client *jira.Client, query string) ([]*models.UserScheme, error) {
+	users, res, err := client.User.Search.Do(ctx, "", query, 0, jiraUserSearchMaxResults)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		return nil, fmt.Errorf("Unable to search users, got error: %s\n%s", err, resBody)
+	}
+	return users, nil
+}
+
+// searchAllJiraUsers returns all users matching the query, following the pagination of the API.
+func searchAllJiraUsers(ctx context.Context, client *jira.Client, query string) ([]*models.UserScheme, error) {
+	var users []*models.UserScheme
+	for startAt := 0; ; startAt += jiraUserSearchMaxResults {
+		page, res, err := client.User.Search.Do(ctx, "", query, startAt, jiraUserSearchMaxResults)
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			return nil, fmt.Errorf("Unable to search users, got error: %s\n%s", err, resBody)
+		}
+		users = append(users, page...)
+		// The user search does not report the total, so a partial page is the last one
+		if len(page) < jiraUserSearchMaxResults {
+			return users, nil
+		}
+	}
+}
+
+// findJiraUserByEmail returns the user with the given email address.
+// The user search also matches display names and partial email addresses, so only exact matches are considered.
+// Users may hide their email address, in which case they cannot be found by email address.
+func findJiraUserByEmail(ctx context.Context, client *jira.Client, email string) (*models.UserScheme, error) {
+	users, err := searchJiraUsers(ctx, client, email)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []*models.UserScheme
+	hidden := 0
+	for _, user := range users {
+		if strings.EqualFold(user.EmailAddress, email) {
+			matches = append(matches, user)
+		}
+		if user.EmailAddress == "" {
+			hidden++
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		if hidden > 0 {
+			return nil, fmt.Errorf("Unable to find a user with email address %q, %d users matching the search hide their email address", email, hidden)
+		}
+		return nil, fmt.Errorf("Unable to find a user with email address %q", email)
+	case 1:
+		return matches[0], nil
+	default:
+		return nil, fmt.Errorf("Found %d users with email address %q, expected exactly one", len(matches), email)
+	}
+}
+
+// findJiraUserAccountIdByEmail returns the account ID of the user with the given email address.
+func findJiraUserAccountIdByEmail(ctx context.Context, client *jira.Client, email string) (string, error) {
+	user, err := findJiraUserByEmail(ctx, client, email)
+	if err != nil {
+		return "", err
+	}
+	return user.AccountID, nil
+}
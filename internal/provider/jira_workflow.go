@@ -0,0 +1,47 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+)
+
+// jiraWorkflowSearchMaxResults is the maximum number of workflows returned by a single page of the workflow search.
+const jiraWorkflowSearchMaxResults = 50
+
+// searchJiraWorkflows returns all active and inactive workflows matching the options.
+// The client always filters the workflow search by the options.IsActive flag, so both values are searched.
+func searchJiraWorkflows(ctx context.Context, client *jira.Client, options *models.WorkflowSearchOptions) ([]*models.WorkflowScheme, error) {
+	workflows := []*models.WorkflowScheme{}
+	for _, isActive := range []bool{true, false} {
+		opts := *options
+		opts.IsActive = isActive
+		page, err := searchJiraWorkflowsPages(ctx, client, &opts)
+		if err != nil {
+			return nil, err
+		}
+		workflows = append(workflows, page...)
+	}
+	return workflows, nil
+}
+
+// searchJiraWorkflowsPages returns all workflows matching the options, following the pagination of the API.
+func searchJiraWorkflowsPages(ctx context.Context, client *jira.Client, options *models.WorkflowSearchOptions) ([]*models.WorkflowScheme, error) {
+	workflows := []*models.WorkflowScheme{}
+	for startAt := 0; ; startAt += jiraWorkflowSearchMaxResults {
+		page, res, err := client.Workflow.Gets(ctx, options, startAt, jiraWorkflowSearchMaxResults)
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			return nil, fmt.Errorf("Unable to search workflows, got error: %s\n%s", err, resBody)
+		}
+		workflows = append(workflows, page.Values...)
+		if page.IsLast || len(page.Values) == 0 {
+			return workflows, nil
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package boolmodifiers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ planmodifier.Bool = (*defaultValuePlanModifier)(nil)
+
+type defaultValuePlanModifier struct {
+	DefaultValue bool
+}
+
+func (m *defaultValuePlanModifier) Description(ctx context.Context) string {
+	return m.MarkdownDescription(ctx)
+}
+
+func (m *defaultValuePlanModifier) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("If value is not configured, defaults to %t (%s)", m.DefaultValue, types.BoolType)
+}
+
+func (m *defaultValuePlanModifier) PlanModifyBool(_ context.Context, req planmodifier.BoolRequest, res *planmodifier.BoolResponse) {
+	// If the value is configured, skip validator
+	if !req.ConfigValue.IsNull() && !req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	// If the plan contains a value for the attribute, no need to proceed.
+	// Do not override changes by a previous plan modifier.
+	if !req.PlanValue.IsNull() && !req.PlanValue.IsUnknown() {
+		return
+	}
+
+	res.PlanValue = types.BoolValue(m.DefaultValue)
+}
+
+func DefaultValue(defaultValue bool) planmodifier.Bool {
+	return &defaultValuePlanModifier{
+		DefaultValue: defaultValue,
+	}
+}
@@ -0,0 +1,44 @@
+package float64modifiers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ planmodifier.Float64 = (*defaultValuePlanModifier)(nil)
+
+type defaultValuePlanModifier struct {
+	DefaultValue float64
+}
+
+func (m *defaultValuePlanModifier) Description(ctx context.Context) string {
+	return m.MarkdownDescription(ctx)
+}
+
+func (m *defaultValuePlanModifier) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("If value is not configured, defaults to %v (%s)", m.DefaultValue, types.Float64Type)
+}
+
+func (m *defaultValuePlanModifier) PlanModifyFloat64(_ context.Context, req planmodifier.Float64Request, res *planmodifier.Float64Response) {
+	// If the value is configured, skip validator
+	if !req.ConfigValue.IsNull() && !req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	// If the plan contains a value for the attribute, no need to proceed.
+	// Do not override changes by a previous plan modifier.
+	if !req.PlanValue.IsNull() && !req.PlanValue.IsUnknown() {
+		return
+	}
+
+	res.PlanValue = types.Float64Value(m.DefaultValue)
+}
+
+func DefaultValue(defaultValue float64) planmodifier.Float64 {
+	return &defaultValuePlanModifier{
+		DefaultValue: defaultValue,
+	}
+}
@@ -3,14 +3,17 @@ package atlassian
 import (
 	"context"
 	"os"
+	"strconv"
 
 	jira "github.com/ctreminiom/go-atlassian/jira/v3"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/openscientia/terraform-provider-atlassian/internal/provider/fixtures"
 	"github.com/openscientia/terraform-provider-atlassian/internal/provider/validators"
 )
 
@@ -22,9 +25,11 @@ type (
 	}
 
 	atlassianProviderModel struct {
-		Url      types.String `tfsdk:"url"`
-		Username types.String `tfsdk:"username"`
-		ApiToken types.String `tfsdk:"apitoken"`
+		Url         types.String `tfsdk:"url"`
+		Username    types.String `tfsdk:"username"`
+		ApiToken    types.String `tfsdk:"apitoken"`
+		MockMode    types.Bool   `tfsdk:"mock_mode"`
+		FixturesDir types.String `tfsdk:"fixtures_dir"`
 	}
 )
 
@@ -32,6 +37,9 @@ var (
 	_ provider.Provider = (*atlassianProvider)(nil)
 )
 
+// mockUrl is the host used to build request URLs in mock mode when no url is configured.
+const mockUrl = "https://mock.atlassian.net"
+
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
 		return &atlassianProvider{
@@ -64,6 +72,19 @@ func (*atlassianProvider) Schema(_ context.Context, _ provider.SchemaRequest, re
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"mock_mode": schema.BoolAttribute{
+				MarkdownDescription: "Serve all API reads from recorded JSON responses in `fixtures_dir` and reject any write. " +
+					"Credentials are not required in mock mode. Intended for running `terraform plan` in CI without network access. " +
+					"Can also be set with the `ATLASSIAN_MOCK_MODE` environment variable.",
+				Optional: true,
+			},
+			"fixtures_dir": schema.StringAttribute{
+				MarkdownDescription: "Path to the directory of recorded API responses used when `mock_mode` is enabled. " +
+					"A request for `/rest/api/3/project/10000` is served from `<fixtures_dir>/rest/api/3/project/10000.json`, " +
+					"and a request with a query string is served from `<path>@<query>.json` if it exists. " +
+					"Can also be set with the `ATLASSIAN_FIXTURES_DIR` environment variable.",
+				Optional: true,
+			},
 		},
 	}
 }
@@ -76,6 +97,57 @@ func (p *atlassianProvider) Configure(ctx context.Context, req provider.Configur
 		return
 	}
 
+	// Mock mode serves reads from local fixtures, so credentials are optional
+	var mockMode bool
+	if data.MockMode.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Unable to create client.",
+			"Cannot use unknown value as MockMode.",
+		)
+		return
+	}
+	if data.MockMode.IsNull() {
+		mockMode, _ = strconv.ParseBool(os.Getenv("ATLASSIAN_MOCK_MODE"))
+	} else {
+		mockMode = data.MockMode.ValueBool()
+	}
+
+	var fixturesClient *fixtures.Client
+	if mockMode {
+		var fixturesDir string
+		if data.FixturesDir.IsUnknown() {
+			resp.Diagnostics.AddError(
+				"Unable to create client.",
+				"Cannot use unknown value as FixturesDir.",
+			)
+			return
+		}
+		if data.FixturesDir.IsNull() {
+			fixturesDir = os.Getenv("ATLASSIAN_FIXTURES_DIR")
+		} else {
+			fixturesDir = data.FixturesDir.ValueString()
+		}
+		if fixturesDir == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("fixtures_dir"),
+				"Unable to find FixturesDir.",
+				"FixturesDir cannot be an empty string when mock mode is enabled.",
+			)
+			return
+		}
+
+		var err error
+		fixturesClient, err = fixtures.NewClient(fixturesDir)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("fixtures_dir"),
+				"Unable to load fixtures.",
+				err.Error(),
+			)
+			return
+		}
+	}
+
 	// User must provide a user to the provider
 	var username string
 	if data.Username.IsUnknown() {
@@ -91,7 +163,7 @@ func (p *atlassianProvider) Configure(ctx context.Context, req provider.Configur
 	} else {
 		username = data.Username.ValueString()
 	}
-	if username == "" {
+	if username == "" && !mockMode {
 		resp.Diagnostics.AddError(
 			"Unable to find Username value.",
 			"Username cannot be an empty string.",
@@ -116,7 +188,7 @@ func (p *atlassianProvider) Configure(ctx context.Context, req provider.Configur
 		apitoken = data.ApiToken.ValueString()
 	}
 
-	if apitoken == "" {
+	if apitoken == "" && !mockMode {
 		resp.Diagnostics.AddError(
 			"Unable to find ApiToken.",
 			"ApiToken cannot be an empty string.",
@@ -141,6 +213,10 @@ func (p *atlassianProvider) Configure(ctx context.Context, req provider.Configur
 		url = data.Url.ValueString()
 	}
 
+	if url == "" && mockMode {
+		url = mockUrl
+	}
+
 	if url == "" {
 		resp.Diagnostics.AddError(
 			"Unable to find Url.",
@@ -157,6 +233,9 @@ func (p *atlassianProvider) Configure(ctx context.Context, req provider.Configur
 		)
 		return
 	}
+	if mockMode {
+		c.HTTP = fixturesClient
+	}
 	c.Auth.SetBasicAuth(username, apitoken)
 
 	p.jira = c
@@ -167,28 +246,82 @@ func (p *atlassianProvider) Configure(ctx context.Context, req provider.Configur
 
 func (*atlassianProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewJiraAutomationRuleResource,
+		NewJiraAnnouncementBannerResource,
+		NewJiraBoardResource,
+		NewJiraCustomFieldContextOptionResource,
+		NewJiraCustomFieldContextResource,
+		NewJiraCustomFieldDefaultValueResource,
+		NewJiraCustomFieldResource,
+		NewJiraDashboardGadgetResource,
+		NewJiraDashboardResource,
+		NewJiraFilterResource,
+		NewJiraFilterPermissionResource,
+		NewJiraGroupMembershipResource,
 		NewJiraGroupResource,
 		NewJiraGroupUserResource,
+		NewJiraIssueResource,
 		NewJiraIssueFieldConfigurationItemResource,
 		NewJiraIssueFieldConfigurationResource,
 		NewJiraIssueFieldConfigurationSchemeMappingResource,
+		NewJiraIssueFieldConfigurationSchemeProjectResource,
 		NewJiraIssueFieldConfigurationSchemeResource,
+		NewJiraIssueFieldOptionResource,
+		NewJiraIssueLinkTypeResource,
 		NewJiraIssueScreenResource,
+		NewJiraIssueSecurityLevelResource,
+		NewJiraIssueSecuritySchemeResource,
+		NewJiraIssueTypeAvatarResource,
 		NewJiraIssueTypeResource,
+		NewJiraIssueTypeSchemeProjectResource,
 		NewJiraIssueTypeSchemeResource,
+		NewJiraIssueTypeScreenSchemeProjectResource,
 		NewJiraIssueTypeScreenSchemeResource,
+		NewJiraNotificationSchemeEventResource,
+		NewJiraNotificationSchemeResource,
 		NewJiraPermissionGrantResource,
 		NewJiraPermissionSchemeResource,
+		NewJiraPriorityResource,
+		NewJiraPrioritySchemeResource,
 		NewJiraProjectCategoryResource,
+		NewJiraProjectAvatarResource,
+		NewJiraProjectComponentResource,
+		NewJiraProjectEmailResource,
+		NewJiraProjectFeatureResource,
+		NewJiraProjectIssueSecuritySchemeResource,
+		NewJiraProjectNotificationSchemeResource,
+		NewJiraProjectPermissionSchemeResource,
+		NewJiraProjectPropertyResource,
+		NewJiraProjectRoleActorResource,
+		NewJiraProjectRoleResource,
+		NewJiraProjectVersionResource,
+		NewJiraProjectWorkflowSchemeResource,
+		NewJiraResolutionResource,
 		NewJiraScreenSchemeResource,
+		NewJiraScreenTabResource,
+		NewJiraScreenTabFieldResource,
+		NewJiraSprintResource,
 		NewJiraStatusResource,
+		NewJiraTimeTrackingSettingsResource,
+		NewJiraUserPropertyResource,
+		NewJiraUserResource,
+		NewJiraWebhookResource,
+		NewJiraWorkflowResource,
+		NewJiraWorkflowSchemeResource,
+		NewJiraWorkflowTransitionPropertyResource,
 		NewJiraProjectResource,
 	}
 }
 
 func (*atlassianProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewJiraApplicationRoleDataSource,
+		NewJiraFieldDataSource,
+		NewJiraFieldsDataSource,
+		NewJiraGlobalPermissionDataSource,
 		NewJiraGroupDataSource,
+		NewJiraGroupMembersDataSource,
+		NewJiraGroupsDataSource,
 		NewJiraIssueFieldConfigurationDataSource,
 		NewJiraIssueFieldConfigurationSchemeDataSource,
 		NewJiraIssueScreenDataSource,
@@ -196,12 +329,25 @@ func (*atlassianProvider) DataSources(ctx context.Context) []func() datasource.D
 		NewJiraIssueTypeSchemeDataSource,
 		NewJiraIssueTypeScreenSchemeDataSource,
 		NewJiraMyselfDataSource,
+		NewJiraNotificationSchemeDataSource,
 		NewJiraPermissionGrantDataSource,
 		NewJiraPermissionSchemeDataSource,
+		NewJiraPermissionSchemesDataSource,
+		NewJiraPrioritiesDataSource,
+		NewJiraPrioritySchemeDataSource,
+		NewJiraProjectDataSource,
 		NewJiraProjectCategoryDataSource,
+		NewJiraProjectRolesDataSource,
+		NewJiraProjectsDataSource,
+		NewJiraResolutionsDataSource,
 		NewJiraScreenSchemeDataSource,
 		NewJiraServerInfoDataSource,
 		NewJiraStatusDataSource,
+		NewJiraStatusesDataSource,
+		NewJiraUserDataSource,
+		NewJiraUsersDataSource,
+		NewJiraWorkflowDataSource,
+		NewJiraWorkflowsDataSource,
 		NewJiraWorkflowSchemeDataSource,
 	}
 }
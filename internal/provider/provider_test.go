@@ -2,6 +2,7 @@ package atlassian
 
 import (
 	"os"
+	"path/filepath"
 	"regexp"
 	"testing"
 
@@ -76,3 +77,41 @@ func TestProvider_InvalidUrlAttribute(t *testing.T) {
 		},
 	})
 }
+
+func TestProvider_MockMode(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "rest", "api", "3", "resolution"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	// The fixture reports more pages, which are served as empty pages by the fixtures client.
+	fixture := `{"startAt":0,"maxResults":2,"total":3,"isLast":false,"values":[` +
+		`{"id":"10000","name":"Done","description":"Work has been completed.","isDefault":true},` +
+		`{"id":"10001","name":"Won't Do","description":"This issue won't be actioned.","isDefault":false}]}`
+	if err := os.WriteFile(filepath.Join(dir, "rest", "api", "3", "resolution", "search.json"), []byte(fixture), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					provider "atlassian" {
+						mock_mode    = true
+						fixtures_dir = "` + filepath.ToSlash(dir) + `"
+					}
+
+					data "atlassian_jira_resolutions" "test" {}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.atlassian_jira_resolutions.test", "id", "mock.atlassian.net"),
+					resource.TestCheckResourceAttr("data.atlassian_jira_resolutions.test", "resolutions.#", "2"),
+					resource.TestCheckResourceAttr("data.atlassian_jira_resolutions.test", "resolutions.0.id", "10000"),
+					resource.TestCheckResourceAttr("data.atlassian_jira_resolutions.test", "resolutions.0.is_default", "true"),
+					resource.TestCheckResourceAttr("data.atlassian_jira_resolutions.test", "resolutions.1.name", "Won't Do"),
+				),
+			},
+		},
+	})
+}
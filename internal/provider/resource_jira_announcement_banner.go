@@ -0,0 +1,299 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openscientia/terraform-provider-atlassian/internal/provider/planmodifiers/boolmodifiers"
+	"github.com/openscientia/terraform-provider-atlassian/internal/provider/planmodifiers/stringmodifiers"
+)
+
+type (
+	jiraAnnouncementBannerResource struct {
+		p atlassianProvider
+	}
+
+	jiraAnnouncementBannerResourceModel struct {
+		ID          types.String `tfsdk:"id"`
+		Message     types.String `tfsdk:"message"`
+		Enabled     types.Bool   `tfsdk:"enabled"`
+		Dismissible types.Bool   `tfsdk:"dismissible"`
+		Visibility  types.String `tfsdk:"visibility"`
+		HashID      types.String `tfsdk:"hash_id"`
+	}
+
+	// The go-atlassian library omits false values from the announcement banner payload.
+	jiraAnnouncementBanner struct {
+		IsDismissible bool   `json:"isDismissible"`
+		IsEnabled     bool   `json:"isEnabled"`
+		Message       string `json:"message"`
+		Visibility    string `json:"visibility"`
+	}
+)
+
+var (
+	_ resource.Resource                = (*jiraAnnouncementBannerResource)(nil)
+	_ resource.ResourceWithImportState = (*jiraAnnouncementBannerResource)(nil)
+)
+
+func NewJiraAnnouncementBannerResource() resource.Resource {
+	return &jiraAnnouncementBannerResource{}
+}
+
+func (*jiraAnnouncementBannerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_announcement_banner"
+}
+
+func (*jiraAnnouncementBannerResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Announcement Banner Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the announcement banner. Defaults to the host of the Jira site, as there is a single banner per site.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"message": schema.StringAttribute{
+				MarkdownDescription: "The text on the announcement banner. Supports a limited set of HTML tags, e.g. `<a>`, `<b>` and `<i>`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the announcement banner is displayed. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolmodifiers.DefaultValue(true),
+				},
+			},
+			"dismissible": schema.BoolAttribute{
+				MarkdownDescription: "Whether users can dismiss the announcement banner. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolmodifiers.DefaultValue(true),
+				},
+			},
+			"visibility": schema.StringAttribute{
+				MarkdownDescription: "The visibility of the announcement banner. Can be one of: `public` (visible to all users, including anonymous users) " +
+					"or `private` (visible to logged-in users only). Defaults to `private`.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("public", "private"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringmodifiers.DefaultValue("private"),
+				},
+			},
+			"hash_id": schema.StringAttribute{
+				MarkdownDescription: "The hash of the announcement banner, which changes whenever the message changes so that dismissed banners are shown again.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *jiraAnnouncementBannerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.p.jira = client
+}
+
+func (*jiraAnnouncementBannerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *jiraAnnouncementBannerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating announcement banner resource")
+
+	var plan jiraAnnouncementBannerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded announcement banner plan", map[string]interface{}{
+		"createPlan": fmt.Sprintf("%+v", plan),
+	})
+
+	if err := r.updateBanner(ctx, announcementBannerPayload(&plan)); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create announcement banner, got error: %s", err))
+		return
+	}
+	tflog.Debug(ctx, "Created announcement banner")
+
+	banner, res, err := r.p.jira.Banner.Get(ctx)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get announcement banner, got error: %s\n%s", err, resBody))
+		return
+	}
+
+	plan.ID = types.StringValue(r.p.jira.Site.Host)
+	plan.HashID = types.StringValue(banner.HashId)
+
+	tflog.Debug(ctx, "Storing announcement banner into the state", map[string]interface{}{
+		"createNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraAnnouncementBannerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading announcement banner resource")
+
+	var state jiraAnnouncementBannerResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded announcement banner from state", map[string]interface{}{
+		"readState": fmt.Sprintf("%+v", state),
+	})
+
+	banner, res, err := r.p.jira.Banner.Get(ctx)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get announcement banner, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Retrieved announcement banner from API state")
+
+	state.ID = types.StringValue(r.p.jira.Site.Host)
+	state.Message = types.StringValue(banner.Message)
+	state.Enabled = types.BoolValue(banner.IsEnabled)
+	state.Dismissible = types.BoolValue(banner.IsDismissible)
+	state.Visibility = types.StringValue(banner.Visibility)
+	state.HashID = types.StringValue(banner.HashId)
+
+	tflog.Debug(ctx, "Storing announcement banner into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", state),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraAnnouncementBannerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating announcement banner resource")
+
+	var plan jiraAnnouncementBannerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded announcement banner plan", map[string]interface{}{
+		"updatePlan": fmt.Sprintf("%+v", plan),
+	})
+
+	var state jiraAnnouncementBannerResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded announcement banner from state", map[string]interface{}{
+		"updateState": fmt.Sprintf("%+v", state),
+	})
+
+	if err := r.updateBanner(ctx, announcementBannerPayload(&plan)); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update announcement banner, got error: %s", err))
+		return
+	}
+	tflog.Debug(ctx, "Updated announcement banner in API state")
+
+	banner, res, err := r.p.jira.Banner.Get(ctx)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get announcement banner, got error: %s\n%s", err, resBody))
+		return
+	}
+
+	plan.ID = state.ID
+	plan.HashID = types.StringValue(banner.HashId)
+
+	tflog.Debug(ctx, "Storing announcement banner into the state", map[string]interface{}{
+		"updateNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraAnnouncementBannerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting announcement banner resource")
+
+	var state jiraAnnouncementBannerResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded announcement banner from state")
+
+	// The announcement banner cannot be deleted, so it is disabled instead.
+	payload := announcementBannerPayload(&state)
+	payload.IsEnabled = false
+	if err := r.updateBanner(ctx, payload); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to disable announcement banner, got error: %s", err))
+		return
+	}
+	tflog.Debug(ctx, "Disabled announcement banner in API state")
+
+	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
+}
+
+func (r *jiraAnnouncementBannerResource) updateBanner(ctx context.Context, payload *jiraAnnouncementBanner) error {
+	request, err := r.p.jira.NewRequest(ctx, http.MethodPut, "rest/api/3/announcementBanner", "", payload)
+	if err != nil {
+		return err
+	}
+	res, err := r.p.jira.Call(request, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		return fmt.Errorf("%s\n%s", err, resBody)
+	}
+	return nil
+}
+
+func announcementBannerPayload(m *jiraAnnouncementBannerResourceModel) *jiraAnnouncementBanner {
+	return &jiraAnnouncementBanner{
+		IsDismissible: m.Dismissible.ValueBool(),
+		IsEnabled:     m.Enabled.ValueBool(),
+		Message:       m.Message.ValueString(),
+		Visibility:    m.Visibility.ValueString(),
+	}
+}
@@ -0,0 +1,68 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraAnnouncementBanner_Basic(t *testing.T) {
+	randomMessage := acctest.RandomWithPrefix("tf-test-announcement-banner")
+	resourceName := "atlassian_jira_announcement_banner.test"
+	// There is a single announcement banner per site, so the test must not run in parallel.
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnnouncementBannerConfig_basic(resourceName, randomMessage),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "message", randomMessage),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "dismissible", "true"),
+					resource.TestCheckResourceAttr(resourceName, "visibility", "private"),
+					resource.TestCheckResourceAttrSet(resourceName, "hash_id"),
+				),
+			},
+			{
+				Config: testAccAnnouncementBannerConfig_update(resourceName, randomMessage+"-updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "message", randomMessage+"-updated"),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "dismissible", "false"),
+					resource.TestCheckResourceAttr(resourceName, "visibility", "public"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAnnouncementBannerConfig_basic(resourceName, message string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		message = %[3]q
+	}
+	`, splits[0], splits[1], message)
+}
+
+func testAccAnnouncementBannerConfig_update(resourceName, message string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		message     = %[3]q
+		enabled     = false
+		dismissible = false
+		visibility  = "public"
+	}
+	`, splits[0], splits[1], message)
+}
@@ -0,0 +1,417 @@
+package atlassian
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openscientia/terraform-provider-atlassian/internal/provider/planmodifiers/boolmodifiers"
+	"github.com/openscientia/terraform-provider-atlassian/internal/provider/validators"
+)
+
+type (
+	jiraAutomationRuleResource struct {
+		p atlassianProvider
+	}
+
+	jiraAutomationRuleResourceModel struct {
+		ID         types.String `tfsdk:"id"`
+		Name       types.String `tfsdk:"name"`
+		Rule       types.String `tfsdk:"rule"`
+		Enabled    types.Bool   `tfsdk:"enabled"`
+		ProjectIds types.Set    `tfsdk:"project_ids"`
+	}
+
+	// The go-atlassian library does not support the Jira Automation API.
+	jiraAutomationRule struct {
+		Rule json.RawMessage `json:"rule"`
+	}
+
+	jiraAutomationRuleSummary struct {
+		UUID          string   `json:"uuid"`
+		Name          string   `json:"name"`
+		State         string   `json:"state"`
+		RuleScopeARIs []string `json:"ruleScopeARIs"`
+	}
+)
+
+var (
+	_ resource.Resource                = (*jiraAutomationRuleResource)(nil)
+	_ resource.ResourceWithImportState = (*jiraAutomationRuleResource)(nil)
+)
+
+func NewJiraAutomationRuleResource() resource.Resource {
+	return &jiraAutomationRuleResource{}
+}
+
+func (*jiraAutomationRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_automation_rule"
+}
+
+func (*jiraAutomationRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Automation Rule Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the automation rule.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the automation rule, as set in `rule`.",
+				Computed:            true,
+			},
+			"rule": schema.StringAttribute{
+				MarkdownDescription: "The definition of the automation rule, as a JSON document in the format exported by the Jira Automation API. " +
+					"The `state` and `ruleScopeARIs` of the definition are managed by `enabled` and `project_ids`.",
+				Required: true,
+				Validators: []validator.String{
+					validators.Json(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the automation rule is enabled. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolmodifiers.DefaultValue(true),
+				},
+			},
+			"project_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the projects the automation rule runs in. If not set, the automation rule is global and runs in all projects.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (r *jiraAutomationRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.p.jira = client
+}
+
+func (*jiraAutomationRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *jiraAutomationRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating automation rule resource")
+
+	var plan jiraAutomationRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded automation rule plan", map[string]interface{}{
+		"createPlan": fmt.Sprintf("%+v", plan),
+	})
+
+	cloudId, err := getJiraCloudId(ctx, r.p.jira)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get cloud ID, got error: %s", err))
+		return
+	}
+
+	payload, name, diags := automationRulePayload(ctx, &plan, cloudId)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	request, err := r.p.jira.NewRequest(ctx, http.MethodPost, automationRuleEndpoint(cloudId, ""), "", payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create automation rule request, got error: %s", err))
+		return
+	}
+	var created struct {
+		RuleUUID string `json:"ruleUuid"`
+	}
+	res, err := r.p.jira.Call(request, &created)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create automation rule, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Created automation rule")
+
+	plan.ID = types.StringValue(created.RuleUUID)
+	plan.Name = types.StringValue(name)
+
+	tflog.Debug(ctx, "Storing automation rule into the state", map[string]interface{}{
+		"createNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraAutomationRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading automation rule resource")
+
+	var state jiraAutomationRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded automation rule from state", map[string]interface{}{
+		"readState": fmt.Sprintf("%+v", state),
+	})
+
+	cloudId, err := getJiraCloudId(ctx, r.p.jira)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get cloud ID, got error: %s", err))
+		return
+	}
+
+	request, err := r.p.jira.NewRequest(ctx, http.MethodGet, automationRuleEndpoint(cloudId, state.ID.ValueString()), "", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create automation rule request, got error: %s", err))
+		return
+	}
+	rule := new(jiraAutomationRule)
+	res, err := r.p.jira.Call(request, rule)
+	if res != nil && res.Code == http.StatusNotFound {
+		tflog.Warn(ctx, "Unable to find automation rule in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get automation rule, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Retrieved automation rule from API state")
+
+	var summary jiraAutomationRuleSummary
+	if err := json.Unmarshal(rule.Rule, &summary); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse automation rule, got error: %s", err))
+		return
+	}
+
+	state.Name = types.StringValue(summary.Name)
+	state.Enabled = types.BoolValue(summary.State == "ENABLED")
+
+	var projectIds []string
+	for _, ari := range summary.RuleScopeARIs {
+		if _, projectId, ok := strings.Cut(ari, ":project/"); ok {
+			projectIds = append(projectIds, projectId)
+		}
+	}
+	if len(projectIds) == 0 {
+		state.ProjectIds = types.SetNull(types.StringType)
+	} else {
+		newProjectIds, diags := types.SetValueFrom(ctx, types.StringType, projectIds)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.ProjectIds = newProjectIds
+	}
+
+	// The exported definition includes IDs and metadata generated by Jira, so it is only stored when importing.
+	if state.Rule.IsNull() {
+		state.Rule = types.StringValue(string(rule.Rule))
+	}
+
+	tflog.Debug(ctx, "Storing automation rule into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", state),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraAutomationRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating automation rule resource")
+
+	var plan jiraAutomationRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded automation rule plan", map[string]interface{}{
+		"updatePlan": fmt.Sprintf("%+v", plan),
+	})
+
+	var state jiraAutomationRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded automation rule from state", map[string]interface{}{
+		"updateState": fmt.Sprintf("%+v", state),
+	})
+
+	cloudId, err := getJiraCloudId(ctx, r.p.jira)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get cloud ID, got error: %s", err))
+		return
+	}
+
+	payload, name, diags := automationRulePayload(ctx, &plan, cloudId)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	request, err := r.p.jira.NewRequest(ctx, http.MethodPut, automationRuleEndpoint(cloudId, state.ID.ValueString()), "", payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create automation rule request, got error: %s", err))
+		return
+	}
+	res, err := r.p.jira.Call(request, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update automation rule, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Updated automation rule in API state")
+
+	plan.ID = state.ID
+	plan.Name = types.StringValue(name)
+
+	tflog.Debug(ctx, "Storing automation rule into the state", map[string]interface{}{
+		"updateNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraAutomationRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting automation rule resource")
+
+	var state jiraAutomationRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded automation rule from state")
+
+	cloudId, err := getJiraCloudId(ctx, r.p.jira)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get cloud ID, got error: %s", err))
+		return
+	}
+
+	// The Jira Automation API cannot delete rules, so the rule is disabled instead.
+	payload := map[string]string{
+		"value": "DISABLED",
+	}
+	request, err := r.p.jira.NewRequest(ctx, http.MethodPut, automationRuleEndpoint(cloudId, state.ID.ValueString()+"/state"), "", payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create automation rule request, got error: %s", err))
+		return
+	}
+	res, err := r.p.jira.Call(request, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to disable automation rule, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Disabled automation rule in API state")
+
+	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
+}
+
+// automationRuleEndpoint returns the path of the Jira Automation API for rules, relative to the Jira site.
+func automationRuleEndpoint(cloudId, suffix string) string {
+	endpoint := fmt.Sprintf("gateway/api/automation/public/jira/%s/rest/v1/rule", cloudId)
+	if suffix != "" {
+		endpoint += "/" + suffix
+	}
+	return endpoint
+}
+
+// automationRulePayload returns the definition of the automation rule with the state and scope set from the plan, and the name of the rule.
+func automationRulePayload(ctx context.Context, plan *jiraAutomationRuleResourceModel, cloudId string) (*jiraAutomationRule, string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var definition map[string]interface{}
+	if err := json.Unmarshal([]byte(plan.Rule.ValueString()), &definition); err != nil {
+		diags.AddAttributeError(path.Root("rule"), "Invalid Automation Rule", fmt.Sprintf("Unable to parse value of \"rule\" attribute, got error: %s", err))
+		return nil, "", diags
+	}
+	// Exported rules are wrapped in a "rule" object.
+	if inner, ok := definition["rule"].(map[string]interface{}); ok {
+		definition = inner
+	}
+
+	if plan.Enabled.ValueBool() {
+		definition["state"] = "ENABLED"
+	} else {
+		definition["state"] = "DISABLED"
+	}
+
+	var projectIds []string
+	diags.Append(plan.ProjectIds.ElementsAs(ctx, &projectIds, false)...)
+	scope := []string{fmt.Sprintf("ari:cloud:jira::site/%s", cloudId)}
+	if len(projectIds) > 0 {
+		scope = nil
+		for _, projectId := range projectIds {
+			scope = append(scope, fmt.Sprintf("ari:cloud:jira:%s:project/%s", cloudId, projectId))
+		}
+	}
+	definition["ruleScopeARIs"] = scope
+
+	rule, err := json.Marshal(definition)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to encode automation rule, got error: %s", err))
+		return nil, "", diags
+	}
+	name, _ := definition["name"].(string)
+	return &jiraAutomationRule{Rule: rule}, name, diags
+}
+
+// getJiraCloudId returns the cloud ID of the Jira site, which the APIs hosted outside of Jira require.
+func getJiraCloudId(ctx context.Context, client *jira.Client) (string, error) {
+	request, err := client.NewRequest(ctx, http.MethodGet, "_edge/tenant_info", "", nil)
+	if err != nil {
+		return "", err
+	}
+	var tenant struct {
+		CloudId string `json:"cloudId"`
+	}
+	res, err := client.Call(request, &tenant)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		return "", fmt.Errorf("%s\n%s", err, resBody)
+	}
+	return tenant.CloudId, nil
+}
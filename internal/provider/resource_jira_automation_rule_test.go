@@ -0,0 +1,116 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraAutomationRule_Basic(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-automation-rule")
+	resourceName := "atlassian_jira_automation_rule.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutomationRuleConfig_basic(resourceName, randomName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", randomName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckNoResourceAttr(resourceName, "project_ids"),
+				),
+			},
+			{
+				Config: testAccAutomationRuleConfig_basic(resourceName, randomName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rule"},
+			},
+		},
+	})
+}
+
+func TestAccJiraAutomationRule_ProjectIds(t *testing.T) {
+	randomKey := strings.ToUpper(acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
+	randomName := acctest.RandomWithPrefix("tf-test-automation-rule")
+	resourceName := "atlassian_jira_automation_rule.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutomationRuleConfig_projectIds(resourceName, randomKey, randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "project_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "project_ids.*", "atlassian_jira_project.test", "id"),
+				),
+			},
+			{
+				Config: testAccAutomationRuleConfig_basic(resourceName, randomName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(resourceName, "project_ids"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAutomationRuleConfig_rule(name string) string {
+	return fmt.Sprintf(`
+	jsonencode({
+		name = %[1]q
+		trigger = {
+			component = "TRIGGER"
+			type      = "jira.manual.trigger.issue"
+			value     = {}
+		}
+		components = [
+			{
+				component = "ACTION"
+				type      = "codebarrel.action.log"
+				value     = "Triggered by {{initiator.displayName}}"
+			}
+		]
+	})
+	`, name)
+}
+
+func testAccAutomationRuleConfig_basic(resourceName, name string, enabled bool) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		rule    = %[3]s
+		enabled = %[4]t
+	}
+	`, splits[0], splits[1], testAccAutomationRuleConfig_rule(name), enabled)
+}
+
+func testAccAutomationRuleConfig_projectIds(resourceName, key, name string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	data "atlassian_jira_myself" "test" {}
+
+	resource "atlassian_jira_project" "test" {
+		key              = %[3]q
+		name             = %[4]q
+		lead_account_id  = data.atlassian_jira_myself.test.account_id
+		project_type_key = "software"
+	}
+
+	resource %[1]q %[2]q {
+		rule        = %[5]s
+		project_ids = [atlassian_jira_project.test.id]
+	}
+	`, splits[0], splits[1], key, name, testAccAutomationRuleConfig_rule(name))
+}
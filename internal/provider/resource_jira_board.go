@@ -0,0 +1,287 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraBoardResource struct {
+		p atlassianProvider
+	}
+
+	jiraBoardResourceModel struct {
+		ID        types.String `tfsdk:"id"`
+		Name      types.String `tfsdk:"name"`
+		Type      types.String `tfsdk:"type"`
+		FilterId  types.String `tfsdk:"filter_id"`
+		ProjectId types.String `tfsdk:"project_id"`
+	}
+
+	// The go-atlassian Jira client does not support the Agile REST API.
+	jiraBoard struct {
+		ID       int64  `json:"id"`
+		Name     string `json:"name"`
+		Type     string `json:"type"`
+		Location *struct {
+			ProjectID int64 `json:"projectId"`
+		} `json:"location,omitempty"`
+	}
+)
+
+var (
+	_ resource.Resource                = (*jiraBoardResource)(nil)
+	_ resource.ResourceWithImportState = (*jiraBoardResource)(nil)
+)
+
+func NewJiraBoardResource() resource.Resource {
+	return &jiraBoardResource{}
+}
+
+func (*jiraBoardResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_board"
+}
+
+func (*jiraBoardResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Board Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the board.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The name of the board. The maximum length is 255 characters.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The type of the board. Can be one of: `scrum` or `kanban`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("scrum", "kanban"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"filter_id": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The ID of the filter used to select the issues of the board.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The ID of the project the board is located in. " +
+					"If not set, the board is located in the profile of the user managing it.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *jiraBoardResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.p.jira = client
+}
+
+func (*jiraBoardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *jiraBoardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating board resource")
+
+	var plan jiraBoardResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded board plan", map[string]interface{}{
+		"createPlan": fmt.Sprintf("%+v", plan),
+	})
+
+	filterId, err := strconv.ParseInt(plan.FilterId.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("filter_id"), "Unable to parse value of \"filter_id\" attribute.", "Value of \"filter_id\" attribute can only be a numeric string.")
+		return
+	}
+	payload := map[string]interface{}{
+		"name":     plan.Name.ValueString(),
+		"type":     plan.Type.ValueString(),
+		"filterId": filterId,
+	}
+	if !plan.ProjectId.IsNull() {
+		payload["location"] = map[string]interface{}{
+			"type":           "project",
+			"projectKeyOrId": plan.ProjectId.ValueString(),
+		}
+	}
+	request, err := r.p.jira.NewRequest(ctx, http.MethodPost, "rest/agile/1.0/board", "", payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create board request, got error: %s", err))
+		return
+	}
+	board := new(jiraBoard)
+	res, err := r.p.jira.Call(request, board)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create board, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Created board")
+
+	plan.ID = types.StringValue(strconv.FormatInt(board.ID, 10))
+
+	tflog.Debug(ctx, "Storing board into the state", map[string]interface{}{
+		"createNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraBoardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading board resource")
+
+	var state jiraBoardResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded board from state", map[string]interface{}{
+		"readState": fmt.Sprintf("%+v", state),
+	})
+
+	request, err := r.p.jira.NewRequest(ctx, http.MethodGet, fmt.Sprintf("rest/agile/1.0/board/%s", state.ID.ValueString()), "", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create board request, got error: %s", err))
+		return
+	}
+	board := new(jiraBoard)
+	res, err := r.p.jira.Call(request, board)
+	if res != nil && res.Code == http.StatusNotFound {
+		tflog.Warn(ctx, "Unable to find board in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get board, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Retrieved board from API state")
+
+	request, err = r.p.jira.NewRequest(ctx, http.MethodGet, fmt.Sprintf("rest/agile/1.0/board/%s/configuration", state.ID.ValueString()), "", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create board configuration request, got error: %s", err))
+		return
+	}
+	configuration := new(struct {
+		Filter struct {
+			ID string `json:"id"`
+		} `json:"filter"`
+	})
+	res, err = r.p.jira.Call(request, configuration)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get board configuration, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Retrieved board configuration from API state")
+
+	state.Name = types.StringValue(board.Name)
+	state.Type = types.StringValue(board.Type)
+	state.FilterId = types.StringValue(configuration.Filter.ID)
+	if board.Location != nil && board.Location.ProjectID != 0 {
+		state.ProjectId = types.StringValue(strconv.FormatInt(board.Location.ProjectID, 10))
+	} else {
+		state.ProjectId = types.StringNull()
+	}
+
+	tflog.Debug(ctx, "Storing board into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", state),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraBoardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// The RequiresReplace plan modifier will trigger Terraform to destroy and recreate the resource
+	// if any of the required attributes changes, i.e. name, type, filter_id and/or project_id.
+	tflog.Debug(ctx, "If the value of any required attribute changes, Terraform will destroy and recreate the resource")
+}
+
+func (r *jiraBoardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting board resource")
+
+	var state jiraBoardResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded board from state")
+
+	request, err := r.p.jira.NewRequest(ctx, http.MethodDelete, fmt.Sprintf("rest/agile/1.0/board/%s", state.ID.ValueString()), "", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create board request, got error: %s", err))
+		return
+	}
+	res, err := r.p.jira.Call(request, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete board, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Deleted board from API state")
+
+	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
+}
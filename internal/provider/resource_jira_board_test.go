@@ -0,0 +1,69 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraBoard_Basic(t *testing.T) {
+	randomKey := strings.ToUpper(acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
+	randomName := acctest.RandomWithPrefix("tf-test-board")
+	resourceName := "atlassian_jira_board.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBoardConfig_basic(resourceName, randomKey, randomName, "scrum"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", randomName),
+					resource.TestCheckResourceAttr(resourceName, "type", "scrum"),
+					resource.TestCheckResourceAttrPair(resourceName, "filter_id", "atlassian_jira_filter.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "project_id", "atlassian_jira_project.test", "id"),
+				),
+			},
+			{
+				Config: testAccBoardConfig_basic(resourceName, randomKey, randomName, "kanban"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", "kanban"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccBoardConfig_basic(resourceName, key, name, boardType string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	data "atlassian_jira_myself" "test" {}
+
+	resource "atlassian_jira_project" "test" {
+		key              = %[3]q
+		name             = %[4]q
+		lead_account_id  = data.atlassian_jira_myself.test.account_id
+		project_type_key = "software"
+	}
+
+	resource "atlassian_jira_filter" "test" {
+		name = %[4]q
+		jql  = "project = ${atlassian_jira_project.test.key} ORDER BY Rank ASC"
+	}
+
+	resource %[1]q %[2]q {
+		name       = %[4]q
+		type       = %[5]q
+		filter_id  = atlassian_jira_filter.test.id
+		project_id = atlassian_jira_project.test.id
+	}
+	`, splits[0], splits[1], key, name, boardType)
+}
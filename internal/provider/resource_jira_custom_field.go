@@ -0,0 +1,307 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openscientia/terraform-provider-atlassian/internal/provider/planmodifiers/stringmodifiers"
+)
+
+type (
+	jiraCustomFieldResource struct {
+		p atlassianProvider
+	}
+
+	jiraCustomFieldResourceModel struct {
+		ID          types.String `tfsdk:"id"`
+		Name        types.String `tfsdk:"name"`
+		Description types.String `tfsdk:"description"`
+		Type        types.String `tfsdk:"type"`
+		SearcherKey types.String `tfsdk:"searcher_key"`
+	}
+)
+
+var (
+	_ resource.Resource                = (*jiraCustomFieldResource)(nil)
+	_ resource.ResourceWithImportState = (*jiraCustomFieldResource)(nil)
+)
+
+func NewJiraCustomFieldResource() resource.Resource {
+	return &jiraCustomFieldResource{}
+}
+
+func (*jiraCustomFieldResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_custom_field"
+}
+
+func (*jiraCustomFieldResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Custom Field Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the custom field, e.g. `customfield_10000`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the custom field, which is displayed in Jira. The maximum length is 255 characters.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the custom field, which is displayed in Jira.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringmodifiers.DefaultValue(""),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The type of the custom field, e.g. `com.atlassian.jira.plugin.system.customfieldtypes:textfield`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"searcher_key": schema.StringAttribute{
+				MarkdownDescription: "The searcher defines the way the field is searched in Jira, e.g. `com.atlassian.jira.plugin.system.customfieldtypes:textsearcher`. " +
+					"If not set, Jira uses the default searcher of the custom field type.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *jiraCustomFieldResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.p.jira = client
+}
+
+func (*jiraCustomFieldResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *jiraCustomFieldResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating custom field resource")
+
+	var plan jiraCustomFieldResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded custom field plan", map[string]interface{}{
+		"createPlan": fmt.Sprintf("%+v", plan),
+	})
+
+	payload := &models.CustomFieldScheme{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+		FieldType:   plan.Type.ValueString(),
+		SearcherKey: plan.SearcherKey.ValueString(),
+	}
+	customField, res, err := r.p.jira.Issue.Field.Create(ctx, payload)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create custom field, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Created custom field in API state")
+
+	plan.ID = types.StringValue(customField.ID)
+
+	if plan.SearcherKey.IsUnknown() {
+		customField, err := r.getCustomField(ctx, customField.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+		plan.SearcherKey = types.StringValue("")
+		if customField != nil {
+			plan.SearcherKey = types.StringValue(customField.SearcherKey)
+		}
+	}
+
+	tflog.Debug(ctx, "Storing custom field into the state", map[string]interface{}{
+		"createNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraCustomFieldResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading custom field resource")
+
+	var state jiraCustomFieldResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded custom field from state", map[string]interface{}{
+		"readState": fmt.Sprintf("%+v", state),
+	})
+
+	customField, err := r.getCustomField(ctx, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	if customField == nil {
+		// Custom fields moved to the trash are not returned either.
+		tflog.Warn(ctx, "Unable to find custom field in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	tflog.Debug(ctx, "Retrieved custom field from API state")
+
+	state.Name = types.StringValue(customField.Name)
+	state.Description = types.StringValue(customField.Description)
+	state.SearcherKey = types.StringValue(customField.SearcherKey)
+	if customField.Schema != nil {
+		state.Type = types.StringValue(customField.Schema.Custom)
+	}
+
+	tflog.Debug(ctx, "Storing custom field into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", state),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraCustomFieldResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating custom field resource")
+
+	var plan jiraCustomFieldResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded custom field plan", map[string]interface{}{
+		"updatePlan": fmt.Sprintf("%+v", plan),
+	})
+
+	var state jiraCustomFieldResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded custom field from state", map[string]interface{}{
+		"updateState": fmt.Sprintf("%+v", state),
+	})
+
+	// The go-atlassian library does not support updating custom fields.
+	payload := map[string]string{
+		"name":        plan.Name.ValueString(),
+		"description": plan.Description.ValueString(),
+	}
+	if plan.SearcherKey.ValueString() != "" {
+		payload["searcherKey"] = plan.SearcherKey.ValueString()
+	}
+	request, err := r.p.jira.NewRequest(ctx, http.MethodPut, fmt.Sprintf("rest/api/3/field/%s", state.ID.ValueString()), "", payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create custom field request, got error: %s", err))
+		return
+	}
+
+	res, err := r.p.jira.Call(request, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update custom field, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Updated custom field in API state")
+
+	plan.ID = state.ID
+
+	tflog.Debug(ctx, "Storing custom field into the state", map[string]interface{}{
+		"updateNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraCustomFieldResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting custom field resource")
+
+	var state jiraCustomFieldResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded custom field from state")
+
+	// Custom fields are moved to the trash, from which they can be restored within 60 days.
+	res, err := r.p.jira.Issue.Field.Trash.Move(ctx, state.ID.ValueString())
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to move custom field to trash, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Deleted custom field from API state")
+
+	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
+}
+
+// getCustomField returns the custom field with the given ID, or nil if it does not exist.
+func (r *jiraCustomFieldResource) getCustomField(ctx context.Context, customFieldId string) (*models.IssueFieldScheme, error) {
+	options := &models.FieldSearchOptionsScheme{
+		Types:  []string{"custom"},
+		IDs:    []string{customFieldId},
+		Expand: []string{"searcherKey"},
+	}
+	customFields, res, err := r.p.jira.Issue.Field.Search(ctx, options, 0, 50)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		return nil, fmt.Errorf("Unable to get custom field, got error: %s\n%s", err, resBody)
+	}
+
+	for _, f := range customFields.Values {
+		if f.ID == customFieldId {
+			return f, nil
+		}
+	}
+
+	return nil, nil
+}
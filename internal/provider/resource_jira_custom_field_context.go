@@ -0,0 +1,499 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openscientia/terraform-provider-atlassian/internal/provider/planmodifiers/stringmodifiers"
+)
+
+type (
+	jiraCustomFieldContextResource struct {
+		p atlassianProvider
+	}
+
+	jiraCustomFieldContextResourceModel struct {
+		ID           types.String `tfsdk:"id"`
+		FieldId      types.String `tfsdk:"field_id"`
+		Name         types.String `tfsdk:"name"`
+		Description  types.String `tfsdk:"description"`
+		ProjectIds   types.Set    `tfsdk:"project_ids"`
+		IssueTypeIds types.Set    `tfsdk:"issue_type_ids"`
+	}
+)
+
+var (
+	_ resource.Resource                = (*jiraCustomFieldContextResource)(nil)
+	_ resource.ResourceWithImportState = (*jiraCustomFieldContextResource)(nil)
+)
+
+func NewJiraCustomFieldContextResource() resource.Resource {
+	return &jiraCustomFieldContextResource{}
+}
+
+func (*jiraCustomFieldContextResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_custom_field_context"
+}
+
+func (*jiraCustomFieldContextResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Custom Field Context Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the custom field context.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"field_id": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The ID of the custom field, e.g. `customfield_10000`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the custom field context. The maximum length is 255 characters.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the custom field context. The maximum length is 255 characters.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringmodifiers.DefaultValue(""),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+				},
+			},
+			"project_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the projects the custom field context is scoped to. If not set, the context is global and applies to all projects. " +
+					"Switching between a global and a project-scoped context forces a new resource.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplaceIf(requiresReplaceIfSetNullChanges,
+						"Switching between a null and a non-null value forces a new resource.",
+						"Switching between a null and a non-null value forces a new resource."),
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"issue_type_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the issue types the custom field context applies to. If not set, the context applies to all issue types. " +
+					"Switching between all issue types and specific issue types forces a new resource.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplaceIf(requiresReplaceIfSetNullChanges,
+						"Switching between a null and a non-null value forces a new resource.",
+						"Switching between a null and a non-null value forces a new resource."),
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *jiraCustomFieldContextResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.p.jira = client
+}
+
+func (*jiraCustomFieldContextResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError("Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: field_id/id. Got: %q", req.ID))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Importing custom field context with import identifier: %+v", idParts))
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("field_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+}
+
+func (r *jiraCustomFieldContextResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating custom field context resource")
+
+	var plan jiraCustomFieldContextResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded custom field context plan", map[string]interface{}{
+		"createPlan": fmt.Sprintf("%+v", plan),
+	})
+
+	var projectIds, issueTypeIds []string
+	resp.Diagnostics.Append(plan.ProjectIds.ElementsAs(ctx, &projectIds, false)...)
+	resp.Diagnostics.Append(plan.IssueTypeIds.ElementsAs(ctx, &issueTypeIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := &models.FieldContextPayloadScheme{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+	}
+	for _, id := range projectIds {
+		v, err := strconv.Atoi(id)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("project_ids"), "Unable to parse value of \"project_ids\" attribute.", "Value of \"project_ids\" attribute can only contain numeric strings.")
+			return
+		}
+		payload.ProjectIDs = append(payload.ProjectIDs, v)
+	}
+	for _, id := range issueTypeIds {
+		v, err := strconv.Atoi(id)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("issue_type_ids"), "Unable to parse value of \"issue_type_ids\" attribute.", "Value of \"issue_type_ids\" attribute can only contain numeric strings.")
+			return
+		}
+		payload.IssueTypeIDs = append(payload.IssueTypeIDs, v)
+	}
+
+	fieldContext, res, err := r.p.jira.Issue.Field.Context.Create(ctx, plan.FieldId.ValueString(), payload)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create custom field context, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Created custom field context in API state")
+
+	plan.ID = types.StringValue(fieldContext.ID)
+
+	tflog.Debug(ctx, "Storing custom field context into the state", map[string]interface{}{
+		"createNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraCustomFieldContextResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading custom field context resource")
+
+	var state jiraCustomFieldContextResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded custom field context from state", map[string]interface{}{
+		"readState": fmt.Sprintf("%+v", state),
+	})
+
+	contextId, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("id"), "Unable to parse value of \"id\" attribute.", "Value of \"id\" attribute can only be a numeric string.")
+		return
+	}
+
+	// The go-atlassian library always filters contexts by "isGlobalContext" and "isAnyIssueType".
+	params := url.Values{}
+	params.Add("contextId", state.ID.ValueString())
+	request, err := r.p.jira.NewRequest(ctx, http.MethodGet, fmt.Sprintf("rest/api/3/field/%s/context?%s", state.FieldId.ValueString(), params.Encode()), "", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create custom field context request, got error: %s", err))
+		return
+	}
+
+	fieldContexts := new(models.CustomFieldContextPageScheme)
+	res, err := r.p.jira.Call(request, fieldContexts)
+	if (res != nil && res.Code == http.StatusNotFound) || (err == nil && len(fieldContexts.Values) == 0) {
+		tflog.Warn(ctx, "Unable to find custom field context in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get custom field context, got error: %s\n%s", err, resBody))
+		return
+	}
+	fieldContext := fieldContexts.Values[0]
+
+	state.Name = types.StringValue(fieldContext.Name)
+	state.Description = types.StringValue(fieldContext.Description)
+
+	state.ProjectIds = types.SetNull(types.StringType)
+	if !fieldContext.IsGlobalContext {
+		projectIds, err := r.getProjectIds(ctx, state.FieldId.ValueString(), contextId)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+		state.ProjectIds, _ = types.SetValueFrom(ctx, types.StringType, projectIds)
+	}
+
+	state.IssueTypeIds = types.SetNull(types.StringType)
+	if !fieldContext.IsAnyIssueType {
+		issueTypeIds, err := r.getIssueTypeIds(ctx, state.FieldId.ValueString(), contextId)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+		state.IssueTypeIds, _ = types.SetValueFrom(ctx, types.StringType, issueTypeIds)
+	}
+	tflog.Debug(ctx, "Retrieved custom field context from API state")
+
+	tflog.Debug(ctx, "Storing custom field context into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", state),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraCustomFieldContextResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating custom field context resource")
+
+	var plan jiraCustomFieldContextResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded custom field context plan", map[string]interface{}{
+		"updatePlan": fmt.Sprintf("%+v", plan),
+	})
+
+	var state jiraCustomFieldContextResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded custom field context from state", map[string]interface{}{
+		"updateState": fmt.Sprintf("%+v", state),
+	})
+
+	contextId, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("id"), "Unable to parse value of \"id\" attribute.", "Value of \"id\" attribute can only be a numeric string.")
+		return
+	}
+	fieldId := state.FieldId.ValueString()
+
+	if !plan.Name.Equal(state.Name) || !plan.Description.Equal(state.Description) {
+		// The go-atlassian library omits an empty description, which prevents removing it.
+		payload := map[string]string{
+			"name":        plan.Name.ValueString(),
+			"description": plan.Description.ValueString(),
+		}
+		request, err := r.p.jira.NewRequest(ctx, http.MethodPut, fmt.Sprintf("rest/api/3/field/%s/context/%d", fieldId, contextId), "", payload)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create custom field context request, got error: %s", err))
+			return
+		}
+
+		res, err := r.p.jira.Call(request, nil)
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update custom field context, got error: %s\n%s", err, resBody))
+			return
+		}
+	}
+
+	var planProjectIds, stateProjectIds []string
+	resp.Diagnostics.Append(plan.ProjectIds.ElementsAs(ctx, &planProjectIds, false)...)
+	resp.Diagnostics.Append(state.ProjectIds.ElementsAs(ctx, &stateProjectIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Projects are added before removing any, since a project-scoped context must be assigned to at least one project.
+	if toAdd := subtractStrings(planProjectIds, stateProjectIds); len(toAdd) > 0 {
+		res, err := r.p.jira.Issue.Field.Context.Link(ctx, fieldId, contextId, toAdd)
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to assign custom field context to projects, got error: %s\n%s", err, resBody))
+			return
+		}
+	}
+	if toRemove := subtractStrings(stateProjectIds, planProjectIds); len(toRemove) > 0 {
+		res, err := r.p.jira.Issue.Field.Context.UnLink(ctx, fieldId, contextId, toRemove)
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove custom field context from projects, got error: %s\n%s", err, resBody))
+			return
+		}
+	}
+
+	var planIssueTypeIds, stateIssueTypeIds []string
+	resp.Diagnostics.Append(plan.IssueTypeIds.ElementsAs(ctx, &planIssueTypeIds, false)...)
+	resp.Diagnostics.Append(state.IssueTypeIds.ElementsAs(ctx, &stateIssueTypeIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if toAdd := subtractStrings(planIssueTypeIds, stateIssueTypeIds); len(toAdd) > 0 {
+		res, err := r.p.jira.Issue.Field.Context.AddIssueTypes(ctx, fieldId, contextId, toAdd)
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add issue types to custom field context, got error: %s\n%s", err, resBody))
+			return
+		}
+	}
+	if toRemove := subtractStrings(stateIssueTypeIds, planIssueTypeIds); len(toRemove) > 0 {
+		res, err := r.p.jira.Issue.Field.Context.RemoveIssueTypes(ctx, fieldId, contextId, toRemove)
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove issue types from custom field context, got error: %s\n%s", err, resBody))
+			return
+		}
+	}
+	tflog.Debug(ctx, "Updated custom field context in API state")
+
+	plan.ID = state.ID
+
+	tflog.Debug(ctx, "Storing custom field context into the state", map[string]interface{}{
+		"updateNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraCustomFieldContextResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting custom field context resource")
+
+	var state jiraCustomFieldContextResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded custom field context from state")
+
+	contextId, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("id"), "Unable to parse value of \"id\" attribute.", "Value of \"id\" attribute can only be a numeric string.")
+		return
+	}
+
+	res, err := r.p.jira.Issue.Field.Context.Delete(ctx, state.FieldId.ValueString(), contextId)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete custom field context, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Deleted custom field context from API state")
+
+	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
+}
+
+func (r *jiraCustomFieldContextResource) getProjectIds(ctx context.Context, fieldId string, contextId int) ([]string, error) {
+	projectIds := []string{}
+	startAt := 0
+	for {
+		mapping, res, err := r.p.jira.Issue.Field.Context.ProjectsContext(ctx, fieldId, []int{contextId}, startAt, 50)
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			return nil, fmt.Errorf("Unable to get custom field context projects, got error: %s\n%s", err, resBody)
+		}
+		for _, m := range mapping.Values {
+			projectIds = append(projectIds, m.ProjectID)
+		}
+		if mapping.IsLast || len(mapping.Values) == 0 {
+			break
+		}
+		startAt += len(mapping.Values)
+	}
+	return projectIds, nil
+}
+
+func (r *jiraCustomFieldContextResource) getIssueTypeIds(ctx context.Context, fieldId string, contextId int) ([]string, error) {
+	issueTypeIds := []string{}
+	startAt := 0
+	for {
+		mapping, res, err := r.p.jira.Issue.Field.Context.IssueTypesContext(ctx, fieldId, []int{contextId}, startAt, 50)
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			return nil, fmt.Errorf("Unable to get custom field context issue types, got error: %s\n%s", err, resBody)
+		}
+		for _, m := range mapping.Values {
+			if m.IssueTypeID != "" {
+				issueTypeIds = append(issueTypeIds, m.IssueTypeID)
+			}
+		}
+		if mapping.IsLast || len(mapping.Values) == 0 {
+			break
+		}
+		startAt += len(mapping.Values)
+	}
+	return issueTypeIds, nil
+}
+
+// requiresReplaceIfSetNullChanges requires a replacement when a set attribute is added to or removed from the configuration.
+func requiresReplaceIfSetNullChanges(ctx context.Context, req planmodifier.SetRequest, resp *setplanmodifier.RequiresReplaceIfFuncResponse) {
+	resp.RequiresReplace = req.StateValue.IsNull() != req.PlanValue.IsNull()
+}
+
+// subtractStrings returns the elements of a that are not in b.
+func subtractStrings(a, b []string) []string {
+	var diff []string
+	for _, e := range a {
+		if !containsString(b, e) {
+			diff = append(diff, e)
+		}
+	}
+	return diff
+}
@@ -0,0 +1,392 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openscientia/terraform-provider-atlassian/internal/provider/planmodifiers/boolmodifiers"
+)
+
+type (
+	jiraCustomFieldContextOptionResource struct {
+		p atlassianProvider
+	}
+
+	jiraCustomFieldContextOptionResourceModel struct {
+		ID             types.String `tfsdk:"id"`
+		FieldId        types.String `tfsdk:"field_id"`
+		ContextId      types.String `tfsdk:"context_id"`
+		Value          types.String `tfsdk:"value"`
+		Disabled       types.Bool   `tfsdk:"disabled"`
+		ParentOptionId types.String `tfsdk:"parent_option_id"`
+		AfterOptionId  types.String `tfsdk:"after_option_id"`
+	}
+)
+
+var (
+	_ resource.Resource                = (*jiraCustomFieldContextOptionResource)(nil)
+	_ resource.ResourceWithImportState = (*jiraCustomFieldContextOptionResource)(nil)
+)
+
+func NewJiraCustomFieldContextOptionResource() resource.Resource {
+	return &jiraCustomFieldContextOptionResource{}
+}
+
+func (*jiraCustomFieldContextOptionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_custom_field_context_option"
+}
+
+func (*jiraCustomFieldContextOptionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Custom Field Context Option Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the custom field option.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"field_id": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The ID of the custom field, e.g. `customfield_10000`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"context_id": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The ID of the custom field context.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "The value of the custom field option. The maximum length is 255 characters.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+				},
+			},
+			"disabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the option is disabled. Disabled options cannot be selected in issues. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolmodifiers.DefaultValue(false),
+				},
+			},
+			"parent_option_id": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The ID of the parent option. Only applicable to cascading select custom fields.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"after_option_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the option after which this option is placed. If not set, the position of the option is not managed.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *jiraCustomFieldContextOptionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.p.jira = client
+}
+
+func (*jiraCustomFieldContextOptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "/")
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError("Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: field_id/context_id/id. Got: %q", req.ID))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Importing custom field context option with import identifier: %+v", idParts))
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("field_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("context_id"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[2])...)
+}
+
+func (r *jiraCustomFieldContextOptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating custom field context option resource")
+
+	var plan jiraCustomFieldContextOptionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded custom field context option plan", map[string]interface{}{
+		"createPlan": fmt.Sprintf("%+v", plan),
+	})
+
+	contextId, err := strconv.Atoi(plan.ContextId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("context_id"), "Unable to parse value of \"context_id\" attribute.", "Value of \"context_id\" attribute can only be a numeric string.")
+		return
+	}
+
+	payload := &models.FieldContextOptionListScheme{
+		Options: []*models.CustomFieldContextOptionScheme{
+			{
+				Value:    plan.Value.ValueString(),
+				Disabled: plan.Disabled.ValueBool(),
+				OptionID: plan.ParentOptionId.ValueString(),
+			},
+		},
+	}
+	options, res, err := r.p.jira.Issue.Field.Context.Option.Create(ctx, plan.FieldId.ValueString(), contextId, payload)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create custom field context option, got error: %s\n%s", err, resBody))
+		return
+	}
+	if len(options.Options) == 0 {
+		resp.Diagnostics.AddError("Client Error", "Unable to create custom field context option, got error: no option returned")
+		return
+	}
+	tflog.Debug(ctx, "Created custom field context option in API state")
+
+	plan.ID = types.StringValue(options.Options[0].ID)
+
+	if !plan.AfterOptionId.IsNull() {
+		if err := r.moveOption(ctx, plan.FieldId.ValueString(), contextId, plan.ID.ValueString(), plan.AfterOptionId.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "Storing custom field context option into the state", map[string]interface{}{
+		"createNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraCustomFieldContextOptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading custom field context option resource")
+
+	var state jiraCustomFieldContextOptionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded custom field context option from state", map[string]interface{}{
+		"readState": fmt.Sprintf("%+v", state),
+	})
+
+	contextId, err := strconv.Atoi(state.ContextId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("context_id"), "Unable to parse value of \"context_id\" attribute.", "Value of \"context_id\" attribute can only be a numeric string.")
+		return
+	}
+
+	// All options of the context are retrieved to determine the position of the option.
+	var options []*models.CustomFieldContextOptionScheme
+	startAt := 0
+	for {
+		page, res, err := r.p.jira.Issue.Field.Context.Option.Gets(ctx, state.FieldId.ValueString(), contextId, nil, startAt, 100)
+		if res != nil && res.Code == http.StatusNotFound {
+			tflog.Warn(ctx, "Unable to find custom field context option in API state, deleting resource from state")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get custom field context options, got error: %s\n%s", err, resBody))
+			return
+		}
+		options = append(options, page.Values...)
+		if page.IsLast || len(page.Values) == 0 {
+			break
+		}
+		startAt += len(page.Values)
+	}
+
+	var option *models.CustomFieldContextOptionScheme
+	afterOptionId := ""
+	for _, o := range options {
+		if o.ID == state.ID.ValueString() {
+			option = o
+			break
+		}
+		// Options are only ordered amongst options with the same parent.
+		if o.OptionID == state.ParentOptionId.ValueString() {
+			afterOptionId = o.ID
+		}
+	}
+	if option == nil {
+		tflog.Warn(ctx, "Unable to find custom field context option in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	tflog.Debug(ctx, "Retrieved custom field context option from API state")
+
+	state.Value = types.StringValue(option.Value)
+	state.Disabled = types.BoolValue(option.Disabled)
+	state.ParentOptionId = types.StringNull()
+	if option.OptionID != "" {
+		state.ParentOptionId = types.StringValue(option.OptionID)
+	}
+	if !state.AfterOptionId.IsNull() {
+		state.AfterOptionId = types.StringValue(afterOptionId)
+	}
+
+	tflog.Debug(ctx, "Storing custom field context option into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", state),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraCustomFieldContextOptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating custom field context option resource")
+
+	var plan jiraCustomFieldContextOptionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded custom field context option plan", map[string]interface{}{
+		"updatePlan": fmt.Sprintf("%+v", plan),
+	})
+
+	var state jiraCustomFieldContextOptionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded custom field context option from state", map[string]interface{}{
+		"updateState": fmt.Sprintf("%+v", state),
+	})
+
+	contextId, err := strconv.Atoi(state.ContextId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("context_id"), "Unable to parse value of \"context_id\" attribute.", "Value of \"context_id\" attribute can only be a numeric string.")
+		return
+	}
+
+	if !plan.Value.Equal(state.Value) || !plan.Disabled.Equal(state.Disabled) {
+		payload := &models.FieldContextOptionListScheme{
+			Options: []*models.CustomFieldContextOptionScheme{
+				{
+					ID:       state.ID.ValueString(),
+					Value:    plan.Value.ValueString(),
+					Disabled: plan.Disabled.ValueBool(),
+				},
+			},
+		}
+		_, res, err := r.p.jira.Issue.Field.Context.Option.Update(ctx, state.FieldId.ValueString(), contextId, payload)
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update custom field context option, got error: %s\n%s", err, resBody))
+			return
+		}
+	}
+
+	if !plan.AfterOptionId.IsNull() && !plan.AfterOptionId.Equal(state.AfterOptionId) {
+		if err := r.moveOption(ctx, state.FieldId.ValueString(), contextId, state.ID.ValueString(), plan.AfterOptionId.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+	}
+	tflog.Debug(ctx, "Updated custom field context option in API state")
+
+	plan.ID = state.ID
+
+	tflog.Debug(ctx, "Storing custom field context option into the state", map[string]interface{}{
+		"updateNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraCustomFieldContextOptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting custom field context option resource")
+
+	var state jiraCustomFieldContextOptionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded custom field context option from state")
+
+	contextId, err := strconv.Atoi(state.ContextId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("context_id"), "Unable to parse value of \"context_id\" attribute.", "Value of \"context_id\" attribute can only be a numeric string.")
+		return
+	}
+	optionId, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("id"), "Unable to parse value of \"id\" attribute.", "Value of \"id\" attribute can only be a numeric string.")
+		return
+	}
+
+	res, err := r.p.jira.Issue.Field.Context.Option.Delete(ctx, state.FieldId.ValueString(), contextId, optionId)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete custom field context option, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Deleted custom field context option from API state")
+
+	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
+}
+
+func (r *jiraCustomFieldContextOptionResource) moveOption(ctx context.Context, fieldId string, contextId int, optionId, afterOptionId string) error {
+	payload := &models.OrderFieldOptionPayloadScheme{
+		CustomFieldOptionIds: []string{optionId},
+		After:                afterOptionId,
+	}
+	res, err := r.p.jira.Issue.Field.Context.Option.Order(ctx, fieldId, contextId, payload)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		return fmt.Errorf("Unable to move custom field context option, got error: %s\n%s", err, resBody)
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccJiraCustomFieldContextOption_Basic(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-custom-field-context-option")
+	resourceName := "atlassian_jira_custom_field_context_option.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomFieldContextOptionConfig_basic(resourceName, randomName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "field_id", "atlassian_jira_custom_field.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "context_id", "atlassian_jira_custom_field_context.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "value", randomName),
+					resource.TestCheckResourceAttr(resourceName, "disabled", "false"),
+					resource.TestCheckNoResourceAttr(resourceName, "parent_option_id"),
+				),
+			},
+			{
+				Config: testAccCustomFieldContextOptionConfig_basic(resourceName, randomName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "disabled", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccCustomFieldContextOptionImportConfig,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccJiraCustomFieldContextOption_AfterOptionId(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-custom-field-context-option")
+	resourceName := "atlassian_jira_custom_field_context_option.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomFieldContextOptionConfig_afterOptionId(resourceName, randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "after_option_id", "atlassian_jira_custom_field_context_option.first", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCustomFieldContextOptionImportConfig(s *terraform.State) (string, error) {
+	attributes := s.RootModule().Resources["atlassian_jira_custom_field_context_option.test"].Primary.Attributes
+	return fmt.Sprintf("%s/%s/%s", attributes["field_id"], attributes["context_id"], attributes["id"]), nil
+}
+
+func testAccCustomFieldContextOptionConfig_context(name string) string {
+	return fmt.Sprintf(`
+	resource "atlassian_jira_custom_field" "test" {
+		name = %[1]q
+		type = "com.atlassian.jira.plugin.system.customfieldtypes:select"
+	}
+
+	resource "atlassian_jira_custom_field_context" "test" {
+		field_id = atlassian_jira_custom_field.test.id
+		name     = %[1]q
+	}
+	`, name)
+}
+
+func testAccCustomFieldContextOptionConfig_basic(resourceName, name string, disabled bool) string {
+	splits := strings.Split(resourceName, ".")
+	return testAccCustomFieldContextOptionConfig_context(name) + fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		field_id   = atlassian_jira_custom_field.test.id
+		context_id = atlassian_jira_custom_field_context.test.id
+		value      = %[3]q
+		disabled   = %[4]t
+	}
+	`, splits[0], splits[1], name, disabled)
+}
+
+func testAccCustomFieldContextOptionConfig_afterOptionId(resourceName, name string) string {
+	splits := strings.Split(resourceName, ".")
+	return testAccCustomFieldContextOptionConfig_context(name) + fmt.Sprintf(`
+	resource %[1]q "first" {
+		field_id   = atlassian_jira_custom_field.test.id
+		context_id = atlassian_jira_custom_field_context.test.id
+		value      = "%[3]s-first"
+	}
+
+	resource %[1]q %[2]q {
+		field_id        = atlassian_jira_custom_field.test.id
+		context_id      = atlassian_jira_custom_field_context.test.id
+		value           = %[3]q
+		after_option_id = atlassian_jira_custom_field_context_option.first.id
+	}
+	`, splits[0], splits[1], name)
+}
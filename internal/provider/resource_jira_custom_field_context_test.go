@@ -0,0 +1,124 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccJiraCustomFieldContext_Basic(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-custom-field-context")
+	resourceName := "atlassian_jira_custom_field_context.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomFieldContextConfig_basic(resourceName, randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "field_id", "atlassian_jira_custom_field.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", randomName),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckNoResourceAttr(resourceName, "project_ids"),
+					resource.TestCheckNoResourceAttr(resourceName, "issue_type_ids"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccCustomFieldContextImportConfig,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccJiraCustomFieldContext_Scoped(t *testing.T) {
+	randomKey := strings.ToUpper(acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
+	randomName := acctest.RandomWithPrefix("tf-test-custom-field-context")
+	resourceName := "atlassian_jira_custom_field_context.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomFieldContextConfig_scoped(resourceName, randomKey, randomName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+					resource.TestCheckResourceAttr(resourceName, "project_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "project_ids.*", "atlassian_jira_project.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "issue_type_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "issue_type_ids.*", "atlassian_jira_issue_type.test", "id"),
+				),
+			},
+			{
+				Config: testAccCustomFieldContextConfig_scoped(resourceName, randomKey, randomName, "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccCustomFieldContextImportConfig,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCustomFieldContextImportConfig(s *terraform.State) (string, error) {
+	fieldId := s.RootModule().Resources["atlassian_jira_custom_field_context.test"].Primary.Attributes["field_id"]
+	id := s.RootModule().Resources["atlassian_jira_custom_field_context.test"].Primary.Attributes["id"]
+	return fmt.Sprintf("%s/%s", fieldId, id), nil
+}
+
+func testAccCustomFieldContextConfig_field(name string) string {
+	return fmt.Sprintf(`
+	resource "atlassian_jira_custom_field" "test" {
+		name = %[1]q
+		type = "com.atlassian.jira.plugin.system.customfieldtypes:textfield"
+	}
+	`, name)
+}
+
+func testAccCustomFieldContextConfig_basic(resourceName, name string) string {
+	splits := strings.Split(resourceName, ".")
+	return testAccCustomFieldContextConfig_field(name) + fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		field_id = atlassian_jira_custom_field.test.id
+		name     = %[3]q
+	}
+	`, splits[0], splits[1], name)
+}
+
+func testAccCustomFieldContextConfig_scoped(resourceName, key, name, description string) string {
+	splits := strings.Split(resourceName, ".")
+	return testAccCustomFieldContextConfig_field(name) + fmt.Sprintf(`
+	data "atlassian_jira_myself" "test" {}
+
+	resource "atlassian_jira_project" "test" {
+		key              = %[3]q
+		name             = %[4]q
+		lead_account_id  = data.atlassian_jira_myself.test.account_id
+		project_type_key = "software"
+	}
+
+	resource "atlassian_jira_issue_type" "test" {
+		name = %[4]q
+	}
+
+	resource %[1]q %[2]q {
+		field_id       = atlassian_jira_custom_field.test.id
+		name           = %[4]q
+		description    = %[5]q
+		project_ids    = [atlassian_jira_project.test.id]
+		issue_type_ids = [atlassian_jira_issue_type.test.id]
+	}
+	`, splits[0], splits[1], key, name, description)
+}
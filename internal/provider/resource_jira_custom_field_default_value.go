@@ -0,0 +1,382 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraCustomFieldDefaultValueResource struct {
+		p atlassianProvider
+	}
+
+	jiraCustomFieldDefaultValueResourceModel struct {
+		ID                types.String `tfsdk:"id"`
+		FieldId           types.String `tfsdk:"field_id"`
+		ContextId         types.String `tfsdk:"context_id"`
+		Type              types.String `tfsdk:"type"`
+		Text              types.String `tfsdk:"text"`
+		OptionId          types.String `tfsdk:"option_id"`
+		CascadingOptionId types.String `tfsdk:"cascading_option_id"`
+		OptionIds         types.Set    `tfsdk:"option_ids"`
+	}
+
+	// models.CustomFieldDefaultValueScheme does not support the default values of text fields.
+	jiraCustomFieldDefaultValue struct {
+		ContextID         string   `json:"contextId"`
+		Type              string   `json:"type"`
+		Text              string   `json:"text,omitempty"`
+		OptionID          string   `json:"optionId,omitempty"`
+		CascadingOptionID string   `json:"cascadingOptionId,omitempty"`
+		OptionIDs         []string `json:"optionIds,omitempty"`
+	}
+
+	jiraCustomFieldDefaultValuePage struct {
+		IsLast bool                           `json:"isLast"`
+		Values []*jiraCustomFieldDefaultValue `json:"values"`
+	}
+)
+
+var (
+	_ resource.Resource                = (*jiraCustomFieldDefaultValueResource)(nil)
+	_ resource.ResourceWithImportState = (*jiraCustomFieldDefaultValueResource)(nil)
+)
+
+func NewJiraCustomFieldDefaultValueResource() resource.Resource {
+	return &jiraCustomFieldDefaultValueResource{}
+}
+
+func (*jiraCustomFieldDefaultValueResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_custom_field_default_value"
+}
+
+func (*jiraCustomFieldDefaultValueResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Custom Field Default Value Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the custom field default value, in the format `field_id/context_id`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"field_id": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The ID of the custom field, e.g. `customfield_10000`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"context_id": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The ID of the custom field context.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The type of the default value. " +
+					"Can be one of: `textfield`, `textarea`, `option.single`, `option.multiple`, `option.cascading`.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("textfield", "textarea", "option.single", "option.multiple", "option.cascading"),
+				},
+			},
+			"text": schema.StringAttribute{
+				MarkdownDescription: "The default text. Required when `type` is `textfield` or `textarea`.",
+				Optional:            true,
+			},
+			"option_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the default option. Required when `type` is `option.single` or `option.cascading`.",
+				Optional:            true,
+			},
+			"cascading_option_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the default child option. Only applicable when `type` is `option.cascading`.",
+				Optional:            true,
+			},
+			"option_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the default options. Required when `type` is `option.multiple`.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *jiraCustomFieldDefaultValueResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.p.jira = client
+}
+
+func (*jiraCustomFieldDefaultValueResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError("Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: field_id/context_id. Got: %q", req.ID))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Importing custom field default value with import identifier: %+v", idParts))
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("field_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("context_id"), idParts[1])...)
+}
+
+func (r *jiraCustomFieldDefaultValueResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating custom field default value resource")
+
+	var plan jiraCustomFieldDefaultValueResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded custom field default value plan", map[string]interface{}{
+		"createPlan": fmt.Sprintf("%+v", plan),
+	})
+
+	defaultValue, diags := expandCustomFieldDefaultValue(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.setDefaultValue(ctx, plan.FieldId.ValueString(), defaultValue); err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	tflog.Debug(ctx, "Created custom field default value in API state")
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s/%s", plan.FieldId.ValueString(), plan.ContextId.ValueString()))
+
+	tflog.Debug(ctx, "Storing custom field default value into the state", map[string]interface{}{
+		"createNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraCustomFieldDefaultValueResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading custom field default value resource")
+
+	var state jiraCustomFieldDefaultValueResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded custom field default value from state", map[string]interface{}{
+		"readState": fmt.Sprintf("%+v", state),
+	})
+
+	params := url.Values{}
+	params.Add("contextId", state.ContextId.ValueString())
+	request, err := r.p.jira.NewRequest(ctx, http.MethodGet, fmt.Sprintf("rest/api/3/field/%s/context/defaultValue?%s", state.FieldId.ValueString(), params.Encode()), "", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create custom field default value request, got error: %s", err))
+		return
+	}
+
+	defaultValues := new(jiraCustomFieldDefaultValuePage)
+	res, err := r.p.jira.Call(request, defaultValues)
+	if res != nil && res.Code == http.StatusNotFound {
+		tflog.Warn(ctx, "Unable to find custom field default value in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get custom field default value, got error: %s\n%s", err, resBody))
+		return
+	}
+
+	var defaultValue *jiraCustomFieldDefaultValue
+	for _, v := range defaultValues.Values {
+		if v.ContextID == state.ContextId.ValueString() {
+			defaultValue = v
+			break
+		}
+	}
+	if defaultValue == nil || (defaultValue.Text == "" && defaultValue.OptionID == "" && len(defaultValue.OptionIDs) == 0) {
+		tflog.Warn(ctx, "Unable to find custom field default value in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	tflog.Debug(ctx, "Retrieved custom field default value from API state")
+
+	state.ID = types.StringValue(fmt.Sprintf("%s/%s", state.FieldId.ValueString(), state.ContextId.ValueString()))
+	state.Type = types.StringValue(defaultValue.Type)
+	state.Text = types.StringNull()
+	if defaultValue.Text != "" {
+		state.Text = types.StringValue(defaultValue.Text)
+	}
+	state.OptionId = types.StringNull()
+	if defaultValue.OptionID != "" {
+		state.OptionId = types.StringValue(defaultValue.OptionID)
+	}
+	state.CascadingOptionId = types.StringNull()
+	if defaultValue.CascadingOptionID != "" {
+		state.CascadingOptionId = types.StringValue(defaultValue.CascadingOptionID)
+	}
+	state.OptionIds = types.SetNull(types.StringType)
+	if len(defaultValue.OptionIDs) > 0 {
+		state.OptionIds, _ = types.SetValueFrom(ctx, types.StringType, defaultValue.OptionIDs)
+	}
+
+	tflog.Debug(ctx, "Storing custom field default value into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", state),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraCustomFieldDefaultValueResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating custom field default value resource")
+
+	var plan jiraCustomFieldDefaultValueResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded custom field default value plan", map[string]interface{}{
+		"updatePlan": fmt.Sprintf("%+v", plan),
+	})
+
+	var state jiraCustomFieldDefaultValueResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded custom field default value from state", map[string]interface{}{
+		"updateState": fmt.Sprintf("%+v", state),
+	})
+
+	defaultValue, diags := expandCustomFieldDefaultValue(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.setDefaultValue(ctx, plan.FieldId.ValueString(), defaultValue); err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	tflog.Debug(ctx, "Updated custom field default value in API state")
+
+	plan.ID = state.ID
+
+	tflog.Debug(ctx, "Storing custom field default value into the state", map[string]interface{}{
+		"updateNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraCustomFieldDefaultValueResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting custom field default value resource")
+
+	var state jiraCustomFieldDefaultValueResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded custom field default value from state")
+
+	// A default value without any value removes the default value of the context.
+	defaultValue := &jiraCustomFieldDefaultValue{
+		ContextID: state.ContextId.ValueString(),
+		Type:      state.Type.ValueString(),
+	}
+	if err := r.setDefaultValue(ctx, state.FieldId.ValueString(), defaultValue); err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	tflog.Debug(ctx, "Deleted custom field default value from API state")
+
+	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
+}
+
+func (r *jiraCustomFieldDefaultValueResource) setDefaultValue(ctx context.Context, fieldId string, defaultValue *jiraCustomFieldDefaultValue) error {
+	payload := map[string]interface{}{
+		"defaultValues": []*jiraCustomFieldDefaultValue{defaultValue},
+	}
+	request, err := r.p.jira.NewRequest(ctx, http.MethodPut, fmt.Sprintf("rest/api/3/field/%s/context/defaultValue", fieldId), "", payload)
+	if err != nil {
+		return fmt.Errorf("Unable to create custom field default value request, got error: %s", err)
+	}
+
+	res, err := r.p.jira.Call(request, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		return fmt.Errorf("Unable to set custom field default value, got error: %s\n%s", err, resBody)
+	}
+	return nil
+}
+
+func expandCustomFieldDefaultValue(ctx context.Context, plan *jiraCustomFieldDefaultValueResourceModel) (*jiraCustomFieldDefaultValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	defaultValue := &jiraCustomFieldDefaultValue{
+		ContextID:         plan.ContextId.ValueString(),
+		Type:              plan.Type.ValueString(),
+		Text:              plan.Text.ValueString(),
+		OptionID:          plan.OptionId.ValueString(),
+		CascadingOptionID: plan.CascadingOptionId.ValueString(),
+	}
+	diags.Append(plan.OptionIds.ElementsAs(ctx, &defaultValue.OptionIDs, false)...)
+
+	switch defaultValue.Type {
+	case "textfield", "textarea":
+		if defaultValue.Text == "" {
+			diags.AddAttributeError(path.Root("text"), "Missing value of \"text\" attribute.", fmt.Sprintf("Value of \"text\" attribute is required when \"type\" is %q.", defaultValue.Type))
+		}
+	case "option.single", "option.cascading":
+		if defaultValue.OptionID == "" {
+			diags.AddAttributeError(path.Root("option_id"), "Missing value of \"option_id\" attribute.", fmt.Sprintf("Value of \"option_id\" attribute is required when \"type\" is %q.", defaultValue.Type))
+		}
+	case "option.multiple":
+		if len(defaultValue.OptionIDs) == 0 {
+			diags.AddAttributeError(path.Root("option_ids"), "Missing value of \"option_ids\" attribute.", fmt.Sprintf("Value of \"option_ids\" attribute is required when \"type\" is %q.", defaultValue.Type))
+		}
+	}
+
+	return defaultValue, diags
+}
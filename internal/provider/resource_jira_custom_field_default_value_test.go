@@ -0,0 +1,108 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraCustomFieldDefaultValue_Basic(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-custom-field-default-value")
+	resourceName := "atlassian_jira_custom_field_default_value.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomFieldDefaultValueConfig_basic(resourceName, randomName, "text1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "type", "textfield"),
+					resource.TestCheckResourceAttr(resourceName, "text", "text1"),
+				),
+			},
+			{
+				Config: testAccCustomFieldDefaultValueConfig_basic(resourceName, randomName, "text2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "text", "text2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccJiraCustomFieldDefaultValue_OptionSingle(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-custom-field-default-value")
+	resourceName := "atlassian_jira_custom_field_default_value.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomFieldDefaultValueConfig_optionSingle(resourceName, randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", "option.single"),
+					resource.TestCheckResourceAttrPair(resourceName, "option_id", "atlassian_jira_custom_field_context_option.test", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCustomFieldDefaultValueConfig_basic(resourceName, name, text string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	resource "atlassian_jira_custom_field" "test" {
+		name = %[3]q
+		type = "com.atlassian.jira.plugin.system.customfieldtypes:textfield"
+	}
+
+	resource "atlassian_jira_custom_field_context" "test" {
+		field_id = atlassian_jira_custom_field.test.id
+		name     = %[3]q
+	}
+
+	resource %[1]q %[2]q {
+		field_id   = atlassian_jira_custom_field.test.id
+		context_id = atlassian_jira_custom_field_context.test.id
+		type       = "textfield"
+		text       = %[4]q
+	}
+	`, splits[0], splits[1], name, text)
+}
+
+func testAccCustomFieldDefaultValueConfig_optionSingle(resourceName, name string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	resource "atlassian_jira_custom_field" "test" {
+		name = %[3]q
+		type = "com.atlassian.jira.plugin.system.customfieldtypes:select"
+	}
+
+	resource "atlassian_jira_custom_field_context" "test" {
+		field_id = atlassian_jira_custom_field.test.id
+		name     = %[3]q
+	}
+
+	resource "atlassian_jira_custom_field_context_option" "test" {
+		field_id   = atlassian_jira_custom_field.test.id
+		context_id = atlassian_jira_custom_field_context.test.id
+		value      = %[3]q
+	}
+
+	resource %[1]q %[2]q {
+		field_id   = atlassian_jira_custom_field.test.id
+		context_id = atlassian_jira_custom_field_context.test.id
+		type       = "option.single"
+		option_id  = atlassian_jira_custom_field_context_option.test.id
+	}
+	`, splits[0], splits[1], name)
+}
@@ -0,0 +1,80 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraCustomField_Basic(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-custom-field")
+	resourceName := "atlassian_jira_custom_field.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomFieldConfig_basic(resourceName, randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", randomName),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "type", "com.atlassian.jira.plugin.system.customfieldtypes:textfield"),
+					resource.TestCheckResourceAttrSet(resourceName, "searcher_key"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccJiraCustomField_Description(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-custom-field")
+	resourceName := "atlassian_jira_custom_field.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomFieldConfig_description(resourceName, randomName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+				),
+			},
+			{
+				Config: testAccCustomFieldConfig_description(resourceName, randomName, "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCustomFieldConfig_basic(resourceName, name string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		name = %[3]q
+		type = "com.atlassian.jira.plugin.system.customfieldtypes:textfield"
+	}
+	`, splits[0], splits[1], name)
+}
+
+func testAccCustomFieldConfig_description(resourceName, name, description string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		name        = %[3]q
+		description = %[4]q
+		type        = "com.atlassian.jira.plugin.system.customfieldtypes:textfield"
+	}
+	`, splits[0], splits[1], name, description)
+}
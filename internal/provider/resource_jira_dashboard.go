@@ -0,0 +1,435 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openscientia/terraform-provider-atlassian/internal/provider/planmodifiers/stringmodifiers"
+)
+
+type (
+	jiraDashboardResource struct {
+		p atlassianProvider
+	}
+
+	jiraDashboardResourceModel struct {
+		ID               types.String               `tfsdk:"id"`
+		Name             types.String               `tfsdk:"name"`
+		Description      types.String               `tfsdk:"description"`
+		SharePermissions []jiraSharePermissionModel `tfsdk:"share_permissions"`
+		EditPermissions  []jiraSharePermissionModel `tfsdk:"edit_permissions"`
+		OwnerAccountId   types.String               `tfsdk:"owner_account_id"`
+	}
+
+	jiraSharePermissionModel struct {
+		Type          types.String `tfsdk:"type"`
+		ProjectId     types.String `tfsdk:"project_id"`
+		ProjectRoleId types.String `tfsdk:"project_role_id"`
+		GroupId       types.String `tfsdk:"group_id"`
+		AccountId     types.String `tfsdk:"account_id"`
+	}
+
+	// The go-atlassian library omits empty share and edit permissions, which are required by the API.
+	jiraDashboard struct {
+		ID               string                `json:"id,omitempty"`
+		Name             string                `json:"name"`
+		Description      string                `json:"description"`
+		SharePermissions []jiraSharePermission `json:"sharePermissions"`
+		EditPermissions  []jiraSharePermission `json:"editPermissions"`
+		Owner            *struct {
+			AccountID string `json:"accountId"`
+		} `json:"owner,omitempty"`
+	}
+)
+
+var (
+	_ resource.Resource                = (*jiraDashboardResource)(nil)
+	_ resource.ResourceWithImportState = (*jiraDashboardResource)(nil)
+)
+
+func NewJiraDashboardResource() resource.Resource {
+	return &jiraDashboardResource{}
+}
+
+func (*jiraDashboardResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_dashboard"
+}
+
+func (*jiraDashboardResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Dashboard Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the dashboard.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the dashboard. The maximum length is 255 characters.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the dashboard.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringmodifiers.DefaultValue(""),
+				},
+			},
+			"share_permissions": schema.SetNestedAttribute{
+				MarkdownDescription: "The details of any view share permissions for the dashboard. " +
+					"If not set, the dashboard is private.",
+				Optional:     true,
+				NestedObject: sharePermissionNestedObject(),
+			},
+			"edit_permissions": schema.SetNestedAttribute{
+				MarkdownDescription: "The details of any edit share permissions for the dashboard. " +
+					"If not set, only the owner can edit the dashboard.",
+				Optional:     true,
+				NestedObject: sharePermissionNestedObject(),
+			},
+			"owner_account_id": schema.StringAttribute{
+				MarkdownDescription: "The account ID of the owner of the dashboard.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *jiraDashboardResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.p.jira = client
+}
+
+func (*jiraDashboardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *jiraDashboardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating dashboard resource")
+
+	var plan jiraDashboardResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded dashboard plan", map[string]interface{}{
+		"createPlan": fmt.Sprintf("%+v", plan),
+	})
+
+	validSharePermissions := validateSharePermissions("share_permissions", plan.SharePermissions, resp.Diagnostics.AddAttributeError)
+	validEditPermissions := validateSharePermissions("edit_permissions", plan.EditPermissions, resp.Diagnostics.AddAttributeError)
+	if !validSharePermissions || !validEditPermissions {
+		return
+	}
+
+	request, err := r.p.jira.NewRequest(ctx, http.MethodPost, "rest/api/3/dashboard", "", dashboardPayload(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create dashboard request, got error: %s", err))
+		return
+	}
+	dashboard := new(jiraDashboard)
+	res, err := r.p.jira.Call(request, dashboard)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create dashboard, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Created dashboard")
+
+	plan.ID = types.StringValue(dashboard.ID)
+	plan.OwnerAccountId = types.StringNull()
+	if dashboard.Owner != nil {
+		plan.OwnerAccountId = types.StringValue(dashboard.Owner.AccountID)
+	}
+
+	tflog.Debug(ctx, "Storing dashboard into the state", map[string]interface{}{
+		"createNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraDashboardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading dashboard resource")
+
+	var state jiraDashboardResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded dashboard from state", map[string]interface{}{
+		"readState": fmt.Sprintf("%+v", state),
+	})
+
+	request, err := r.p.jira.NewRequest(ctx, http.MethodGet, fmt.Sprintf("rest/api/3/dashboard/%s", state.ID.ValueString()), "", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create dashboard request, got error: %s", err))
+		return
+	}
+	dashboard := new(jiraDashboard)
+	res, err := r.p.jira.Call(request, dashboard)
+	if res != nil && res.Code == http.StatusNotFound {
+		tflog.Warn(ctx, "Unable to find dashboard in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get dashboard, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Retrieved dashboard from API state")
+
+	state.Name = types.StringValue(dashboard.Name)
+	state.Description = types.StringValue(dashboard.Description)
+	// An unset permissions attribute is equivalent to no permissions.
+	if state.SharePermissions != nil || len(dashboard.SharePermissions) > 0 {
+		state.SharePermissions = sharePermissionsModel(dashboard.SharePermissions)
+	}
+	if state.EditPermissions != nil || len(dashboard.EditPermissions) > 0 {
+		state.EditPermissions = sharePermissionsModel(dashboard.EditPermissions)
+	}
+	state.OwnerAccountId = types.StringNull()
+	if dashboard.Owner != nil {
+		state.OwnerAccountId = types.StringValue(dashboard.Owner.AccountID)
+	}
+
+	tflog.Debug(ctx, "Storing dashboard into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", state),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraDashboardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating dashboard resource")
+
+	var plan jiraDashboardResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded dashboard plan", map[string]interface{}{
+		"updatePlan": fmt.Sprintf("%+v", plan),
+	})
+
+	var state jiraDashboardResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded dashboard from state", map[string]interface{}{
+		"updateState": fmt.Sprintf("%+v", state),
+	})
+
+	validSharePermissions := validateSharePermissions("share_permissions", plan.SharePermissions, resp.Diagnostics.AddAttributeError)
+	validEditPermissions := validateSharePermissions("edit_permissions", plan.EditPermissions, resp.Diagnostics.AddAttributeError)
+	if !validSharePermissions || !validEditPermissions {
+		return
+	}
+
+	request, err := r.p.jira.NewRequest(ctx, http.MethodPut, fmt.Sprintf("rest/api/3/dashboard/%s", state.ID.ValueString()), "", dashboardPayload(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create dashboard request, got error: %s", err))
+		return
+	}
+	dashboard := new(jiraDashboard)
+	res, err := r.p.jira.Call(request, dashboard)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update dashboard, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Updated dashboard in API state")
+
+	plan.ID = state.ID
+	plan.OwnerAccountId = state.OwnerAccountId
+
+	tflog.Debug(ctx, "Storing dashboard into the state", map[string]interface{}{
+		"updateNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraDashboardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting dashboard resource")
+
+	var state jiraDashboardResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded dashboard from state")
+
+	res, err := r.p.jira.Dashboard.Delete(ctx, state.ID.ValueString())
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete dashboard, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Deleted dashboard from API state")
+
+	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
+}
+
+func dashboardPayload(plan jiraDashboardResourceModel) *jiraDashboard {
+	return &jiraDashboard{
+		Name:             plan.Name.ValueString(),
+		Description:      plan.Description.ValueString(),
+		SharePermissions: sharePermissionsPayload(plan.SharePermissions),
+		EditPermissions:  sharePermissionsPayload(plan.EditPermissions),
+	}
+}
+
+// sharePermissionNestedObject returns the schema of a share or edit permission.
+func sharePermissionNestedObject() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the share permission. " +
+					"Can be one of: `global`, `group`, `loggedin`, `project`, `projectRole` or `user`.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(share_permission_types...),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project. Required if `type` is `project` or `projectRole`.",
+				Optional:            true,
+			},
+			"project_role_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project role. Required if `type` is `projectRole`.",
+				Optional:            true,
+			},
+			"group_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the group. Required if `type` is `group`.",
+				Optional:            true,
+			},
+			"account_id": schema.StringAttribute{
+				MarkdownDescription: "The account ID of the user. Required if `type` is `user`.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func sharePermissionsPayload(permissions []jiraSharePermissionModel) []jiraSharePermission {
+	payload := []jiraSharePermission{}
+	for _, p := range permissions {
+		permission := jiraSharePermission{Type: p.Type.ValueString()}
+		if v := p.ProjectId.ValueString(); v != "" {
+			permission.Project = &jiraSharePermissionProject{ID: v}
+		}
+		if v, err := strconv.ParseInt(p.ProjectRoleId.ValueString(), 10, 64); err == nil {
+			permission.Role = &jiraSharePermissionRole{ID: v}
+		}
+		if v := p.GroupId.ValueString(); v != "" {
+			permission.Group = &jiraSharePermissionGroup{GroupID: v}
+		}
+		if v := p.AccountId.ValueString(); v != "" {
+			permission.User = &jiraSharePermissionUser{AccountID: v}
+		}
+		payload = append(payload, permission)
+	}
+	return payload
+}
+
+func sharePermissionsModel(permissions []jiraSharePermission) []jiraSharePermissionModel {
+	model := []jiraSharePermissionModel{}
+	for _, p := range permissions {
+		permission := jiraSharePermissionModel{
+			Type:          types.StringValue(p.Type),
+			ProjectId:     types.StringNull(),
+			ProjectRoleId: types.StringNull(),
+			GroupId:       types.StringNull(),
+			AccountId:     types.StringNull(),
+		}
+		if p.Project != nil {
+			permission.ProjectId = types.StringValue(p.Project.ID)
+		}
+		if p.Role != nil {
+			permission.ProjectRoleId = types.StringValue(strconv.FormatInt(p.Role.ID, 10))
+		}
+		if p.Group != nil {
+			permission.GroupId = types.StringValue(p.Group.GroupID)
+		}
+		if p.User != nil {
+			permission.AccountId = types.StringValue(p.User.AccountID)
+		}
+		model = append(model, permission)
+	}
+	return model
+}
+
+// validateSharePermissions checks that the attributes required by the type of each share permission are provided.
+func validateSharePermissions(attribute string, permissions []jiraSharePermissionModel, addError func(path.Path, string, string)) bool {
+	valid := true
+	for _, p := range permissions {
+		required := map[string]types.String{}
+		switch p.Type.ValueString() {
+		case "group":
+			required["group_id"] = p.GroupId
+		case "project":
+			required["project_id"] = p.ProjectId
+		case "projectRole":
+			required["project_id"] = p.ProjectId
+			required["project_role_id"] = p.ProjectRoleId
+		case "user":
+			required["account_id"] = p.AccountId
+		}
+		for name, value := range required {
+			if value.ValueString() == "" {
+				addError(path.Root(attribute),
+					fmt.Sprintf("Failed to provide a value for \"%s.%s\" attribute", attribute, name),
+					fmt.Sprintf("Value must be provided if \"%s.type\" is: %s", attribute, p.Type.ValueString()))
+				valid = false
+			}
+		}
+	}
+	return valid
+}
@@ -0,0 +1,511 @@
+package atlassian
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openscientia/terraform-provider-atlassian/internal/provider/validators"
+)
+
+type (
+	jiraDashboardGadgetResource struct {
+		p atlassianProvider
+	}
+
+	jiraDashboardGadgetResourceModel struct {
+		ID          types.String `tfsdk:"id"`
+		DashboardId types.String `tfsdk:"dashboard_id"`
+		ModuleKey   types.String `tfsdk:"module_key"`
+		Title       types.String `tfsdk:"title"`
+		Color       types.String `tfsdk:"color"`
+		Row         types.Int64  `tfsdk:"row"`
+		Column      types.Int64  `tfsdk:"column"`
+		Properties  types.Map    `tfsdk:"properties"`
+	}
+
+	// The go-atlassian library does not support dashboard gadgets.
+	jiraDashboardGadget struct {
+		ID        int64                        `json:"id,omitempty"`
+		ModuleKey string                       `json:"moduleKey,omitempty"`
+		Title     string                       `json:"title,omitempty"`
+		Color     string                       `json:"color,omitempty"`
+		Position  *jiraDashboardGadgetPosition `json:"position,omitempty"`
+	}
+
+	jiraDashboardGadgetPosition struct {
+		Row    int64 `json:"row"`
+		Column int64 `json:"column"`
+	}
+)
+
+var (
+	_ resource.Resource                = (*jiraDashboardGadgetResource)(nil)
+	_ resource.ResourceWithImportState = (*jiraDashboardGadgetResource)(nil)
+)
+
+var dashboard_gadget_colors = []string{"blue", "red", "yellow", "green", "cyan", "purple", "gray", "white"}
+
+func NewJiraDashboardGadgetResource() resource.Resource {
+	return &jiraDashboardGadgetResource{}
+}
+
+func (*jiraDashboardGadgetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_dashboard_gadget"
+}
+
+func (*jiraDashboardGadgetResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Dashboard Gadget Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the gadget.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"dashboard_id": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The ID of the dashboard.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"module_key": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The module key of the gadget type, " +
+					"e.g. `com.atlassian.jira.gadgets:filter-results-gadget`.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "The title of the gadget. If not set, the default title of the gadget type is used.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"color": schema.StringAttribute{
+				MarkdownDescription: "The color of the gadget. " +
+					"Can be one of: `blue`, `red`, `yellow`, `green`, `cyan`, `purple`, `gray` or `white`.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(dashboard_gadget_colors...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"row": schema.Int64Attribute{
+				MarkdownDescription: "The row position of the gadget on the dashboard. " +
+					"If neither `row` nor `column` are set, the gadget is placed in the first available position.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+					int64validator.AlsoRequires(path.MatchRoot("column")),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"column": schema.Int64Attribute{
+				MarkdownDescription: "The column position of the gadget on the dashboard.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+					int64validator.AlsoRequires(path.MatchRoot("row")),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"properties": schema.MapAttribute{
+				MarkdownDescription: "The properties of the gadget, where each property is a key and JSON document pair. " +
+					"Properties not set here are not managed by this resource.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.ValueStringsAre(validators.Json()),
+				},
+			},
+		},
+	}
+}
+
+func (r *jiraDashboardGadgetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.p.jira = client
+}
+
+func (*jiraDashboardGadgetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "/")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: dashboard_id/id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("dashboard_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+}
+
+func (r *jiraDashboardGadgetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating dashboard gadget resource")
+
+	var plan jiraDashboardGadgetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded dashboard gadget plan", map[string]interface{}{
+		"createPlan": fmt.Sprintf("%+v", plan),
+	})
+
+	properties := map[string]string{}
+	resp.Diagnostics.Append(plan.Properties.ElementsAs(ctx, &properties, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := dashboardGadgetPayload(plan)
+	payload.ModuleKey = plan.ModuleKey.ValueString()
+	request, err := r.p.jira.NewRequest(ctx, http.MethodPost, fmt.Sprintf("rest/api/3/dashboard/%s/gadget", plan.DashboardId.ValueString()), "", payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create dashboard gadget request, got error: %s", err))
+		return
+	}
+	gadget := new(jiraDashboardGadget)
+	res, err := r.p.jira.Call(request, gadget)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create dashboard gadget, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Created dashboard gadget")
+
+	plan.ID = types.StringValue(strconv.FormatInt(gadget.ID, 10))
+	plan.Title = types.StringValue(gadget.Title)
+	plan.Color = types.StringValue(gadget.Color)
+	if gadget.Position != nil {
+		plan.Row = types.Int64Value(gadget.Position.Row)
+		plan.Column = types.Int64Value(gadget.Position.Column)
+	}
+
+	for key, value := range properties {
+		if err := r.setDashboardItemProperty(ctx, plan.DashboardId.ValueString(), plan.ID.ValueString(), key, value); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set dashboard gadget property %q, got error: %s", key, err))
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "Storing dashboard gadget into the state", map[string]interface{}{
+		"createNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraDashboardGadgetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading dashboard gadget resource")
+
+	var state jiraDashboardGadgetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded dashboard gadget from state", map[string]interface{}{
+		"readState": fmt.Sprintf("%+v", state),
+	})
+
+	params := url.Values{}
+	params.Add("gadgetId", state.ID.ValueString())
+	request, err := r.p.jira.NewRequest(ctx, http.MethodGet, fmt.Sprintf("rest/api/3/dashboard/%s/gadget?%s", state.DashboardId.ValueString(), params.Encode()), "", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create dashboard gadget request, got error: %s", err))
+		return
+	}
+	page := new(struct {
+		Gadgets []jiraDashboardGadget `json:"gadgets"`
+	})
+	res, err := r.p.jira.Call(request, page)
+	if (res != nil && res.Code == http.StatusNotFound) || (err == nil && len(page.Gadgets) == 0) {
+		tflog.Warn(ctx, "Unable to find dashboard gadget in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get dashboard gadget, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Retrieved dashboard gadget from API state")
+
+	gadget := page.Gadgets[0]
+	state.ModuleKey = types.StringValue(gadget.ModuleKey)
+	state.Title = types.StringValue(gadget.Title)
+	state.Color = types.StringValue(gadget.Color)
+	if gadget.Position != nil {
+		state.Row = types.Int64Value(gadget.Position.Row)
+		state.Column = types.Int64Value(gadget.Position.Column)
+	}
+
+	if !state.Properties.IsNull() {
+		properties := map[string]string{}
+		resp.Diagnostics.Append(state.Properties.ElementsAs(ctx, &properties, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		newProperties := map[string]string{}
+		for key, value := range properties {
+			property, err := r.getDashboardItemProperty(ctx, state.DashboardId.ValueString(), state.ID.ValueString(), key)
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get dashboard gadget property %q, got error: %s", key, err))
+				return
+			}
+			if property == nil {
+				continue
+			}
+			// Keep the configured formatting of the value unless it differs semantically.
+			if jsonEqual(value, string(property.Value)) {
+				newProperties[key] = value
+			} else {
+				newProperties[key] = string(property.Value)
+			}
+		}
+		newState, diags := types.MapValueFrom(ctx, types.StringType, newProperties)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.Properties = newState
+	}
+
+	tflog.Debug(ctx, "Storing dashboard gadget into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", state),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraDashboardGadgetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating dashboard gadget resource")
+
+	var plan jiraDashboardGadgetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded dashboard gadget plan", map[string]interface{}{
+		"updatePlan": fmt.Sprintf("%+v", plan),
+	})
+
+	var state jiraDashboardGadgetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded dashboard gadget from state", map[string]interface{}{
+		"updateState": fmt.Sprintf("%+v", state),
+	})
+
+	dashboardId, gadgetId := state.DashboardId.ValueString(), state.ID.ValueString()
+
+	request, err := r.p.jira.NewRequest(ctx, http.MethodPut, fmt.Sprintf("rest/api/3/dashboard/%s/gadget/%s", dashboardId, gadgetId), "", dashboardGadgetPayload(plan))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create dashboard gadget request, got error: %s", err))
+		return
+	}
+	res, err := r.p.jira.Call(request, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update dashboard gadget, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Updated dashboard gadget in API state")
+
+	planProperties, stateProperties := map[string]string{}, map[string]string{}
+	resp.Diagnostics.Append(plan.Properties.ElementsAs(ctx, &planProperties, false)...)
+	resp.Diagnostics.Append(state.Properties.ElementsAs(ctx, &stateProperties, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for key := range stateProperties {
+		if _, ok := planProperties[key]; ok {
+			continue
+		}
+		if err := r.deleteDashboardItemProperty(ctx, dashboardId, gadgetId, key); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete dashboard gadget property %q, got error: %s", key, err))
+			return
+		}
+	}
+	for key, value := range planProperties {
+		if old, ok := stateProperties[key]; ok && jsonEqual(old, value) {
+			continue
+		}
+		if err := r.setDashboardItemProperty(ctx, dashboardId, gadgetId, key, value); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set dashboard gadget property %q, got error: %s", key, err))
+			return
+		}
+	}
+
+	plan.ID = state.ID
+	if plan.Title.IsUnknown() {
+		plan.Title = state.Title
+	}
+	if plan.Color.IsUnknown() {
+		plan.Color = state.Color
+	}
+	if plan.Row.IsUnknown() || plan.Column.IsUnknown() {
+		plan.Row, plan.Column = state.Row, state.Column
+	}
+
+	tflog.Debug(ctx, "Storing dashboard gadget into the state", map[string]interface{}{
+		"updateNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraDashboardGadgetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting dashboard gadget resource")
+
+	var state jiraDashboardGadgetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded dashboard gadget from state")
+
+	request, err := r.p.jira.NewRequest(ctx, http.MethodDelete, fmt.Sprintf("rest/api/3/dashboard/%s/gadget/%s", state.DashboardId.ValueString(), state.ID.ValueString()), "", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create dashboard gadget request, got error: %s", err))
+		return
+	}
+	res, err := r.p.jira.Call(request, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete dashboard gadget, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Deleted dashboard gadget from API state")
+
+	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
+}
+
+func dashboardGadgetPayload(plan jiraDashboardGadgetResourceModel) *jiraDashboardGadget {
+	payload := &jiraDashboardGadget{
+		Title: plan.Title.ValueString(),
+		Color: plan.Color.ValueString(),
+	}
+	if !plan.Row.IsUnknown() && !plan.Row.IsNull() && !plan.Column.IsUnknown() && !plan.Column.IsNull() {
+		payload.Position = &jiraDashboardGadgetPosition{
+			Row:    plan.Row.ValueInt64(),
+			Column: plan.Column.ValueInt64(),
+		}
+	}
+	return payload
+}
+
+func (r *jiraDashboardGadgetResource) getDashboardItemProperty(ctx context.Context, dashboardId, itemId, key string) (*jiraEntityProperty, error) {
+	request, err := r.p.jira.NewRequest(ctx, http.MethodGet, fmt.Sprintf("rest/api/3/dashboard/%s/items/%s/properties/%s", dashboardId, itemId, url.PathEscape(key)), "", nil)
+	if err != nil {
+		return nil, err
+	}
+	property := new(jiraEntityProperty)
+	res, err := r.p.jira.Call(request, property)
+	if res != nil && res.Code == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		return nil, fmt.Errorf("%s\n%s", err, resBody)
+	}
+	return property, nil
+}
+
+func (r *jiraDashboardGadgetResource) setDashboardItemProperty(ctx context.Context, dashboardId, itemId, key, value string) error {
+	request, err := r.p.jira.NewRequest(ctx, http.MethodPut, fmt.Sprintf("rest/api/3/dashboard/%s/items/%s/properties/%s", dashboardId, itemId, url.PathEscape(key)), "", json.RawMessage(value))
+	if err != nil {
+		return err
+	}
+	res, err := r.p.jira.Call(request, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		return fmt.Errorf("%s\n%s", err, resBody)
+	}
+	return nil
+}
+
+func (r *jiraDashboardGadgetResource) deleteDashboardItemProperty(ctx context.Context, dashboardId, itemId, key string) error {
+	request, err := r.p.jira.NewRequest(ctx, http.MethodDelete, fmt.Sprintf("rest/api/3/dashboard/%s/items/%s/properties/%s", dashboardId, itemId, url.PathEscape(key)), "", nil)
+	if err != nil {
+		return err
+	}
+	res, err := r.p.jira.Call(request, nil)
+	if err != nil && (res == nil || res.Code != http.StatusNotFound) {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		return fmt.Errorf("%s\n%s", err, resBody)
+	}
+	return nil
+}
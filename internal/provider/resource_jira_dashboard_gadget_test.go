@@ -0,0 +1,80 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccJiraDashboardGadget_Basic(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-dashboard-gadget")
+	resourceName := "atlassian_jira_dashboard_gadget.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardGadgetConfig_basic(resourceName, randomName, "foo", "blue", 5),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "dashboard_id", "atlassian_jira_dashboard.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "module_key", "com.atlassian.jira.gadgets:filter-results-gadget"),
+					resource.TestCheckResourceAttr(resourceName, "title", "foo"),
+					resource.TestCheckResourceAttr(resourceName, "color", "blue"),
+					resource.TestCheckResourceAttr(resourceName, "row", "0"),
+					resource.TestCheckResourceAttr(resourceName, "column", "0"),
+					resource.TestCheckResourceAttr(resourceName, "properties.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "properties.config", `{"num":5}`),
+				),
+			},
+			{
+				Config: testAccDashboardGadgetConfig_basic(resourceName, randomName, "bar", "red", 10),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "title", "bar"),
+					resource.TestCheckResourceAttr(resourceName, "color", "red"),
+					resource.TestCheckResourceAttr(resourceName, "properties.config", `{"num":10}`),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccDashboardGadgetImportConfig,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"properties"},
+			},
+		},
+	})
+}
+
+func testAccDashboardGadgetImportConfig(s *terraform.State) (string, error) {
+	dashboardId := s.RootModule().Resources["atlassian_jira_dashboard_gadget.test"].Primary.Attributes["dashboard_id"]
+	id := s.RootModule().Resources["atlassian_jira_dashboard_gadget.test"].Primary.Attributes["id"]
+	return fmt.Sprintf("%s/%s", dashboardId, id), nil
+}
+
+func testAccDashboardGadgetConfig_basic(resourceName, name, title, color string, num int) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	resource "atlassian_jira_dashboard" "test" {
+		name = %[3]q
+	}
+
+	resource %[1]q %[2]q {
+		dashboard_id = atlassian_jira_dashboard.test.id
+		module_key   = "com.atlassian.jira.gadgets:filter-results-gadget"
+		title        = %[4]q
+		color        = %[5]q
+		row          = 0
+		column       = 0
+		properties = {
+			config = jsonencode({
+				num = %[6]d
+			})
+		}
+	}
+	`, splits[0], splits[1], name, title, color, num)
+}
@@ -0,0 +1,88 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraDashboard_Basic(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-dashboard")
+	resourceName := "atlassian_jira_dashboard.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardConfig_basic(resourceName, randomName, "foo"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", randomName),
+					resource.TestCheckResourceAttr(resourceName, "description", "foo"),
+					resource.TestCheckNoResourceAttr(resourceName, "share_permissions"),
+					resource.TestCheckNoResourceAttr(resourceName, "edit_permissions"),
+					resource.TestCheckResourceAttrPair(resourceName, "owner_account_id", "data.atlassian_jira_myself.test", "account_id"),
+				),
+			},
+			{
+				Config: testAccDashboardConfig_permissions(resourceName, randomName+"-updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", randomName+"-updated"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "share_permissions.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "share_permissions.*", map[string]string{
+						"type": "loggedin",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "edit_permissions.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "edit_permissions.*.group_id", "atlassian_jira_group.test", "group_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccDashboardConfig_basic(resourceName, name, description string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	data "atlassian_jira_myself" "test" {}
+
+	resource %[1]q %[2]q {
+		name        = %[3]q
+		description = %[4]q
+	}
+	`, splits[0], splits[1], name, description)
+}
+
+func testAccDashboardConfig_permissions(resourceName, name string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	data "atlassian_jira_myself" "test" {}
+
+	resource "atlassian_jira_group" "test" {
+		name = %[3]q
+	}
+
+	resource %[1]q %[2]q {
+		name = %[3]q
+		share_permissions = [
+			{
+				type = "loggedin"
+			},
+		]
+		edit_permissions = [
+			{
+				type     = "group"
+				group_id = atlassian_jira_group.test.group_id
+			},
+		]
+	}
+	`, splits[0], splits[1], name)
+}
@@ -0,0 +1,335 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openscientia/terraform-provider-atlassian/internal/provider/planmodifiers/boolmodifiers"
+	"github.com/openscientia/terraform-provider-atlassian/internal/provider/planmodifiers/stringmodifiers"
+)
+
+type (
+	jiraFilterResource struct {
+		p atlassianProvider
+	}
+
+	jiraFilterResourceModel struct {
+		ID             types.String `tfsdk:"id"`
+		Name           types.String `tfsdk:"name"`
+		Jql            types.String `tfsdk:"jql"`
+		Description    types.String `tfsdk:"description"`
+		Favourite      types.Bool   `tfsdk:"favourite"`
+		OwnerAccountId types.String `tfsdk:"owner_account_id"`
+	}
+
+	// The go-atlassian library does not return the description of a filter and omits empty values when updating it.
+	jiraFilter struct {
+		ID          string `json:"id,omitempty"`
+		Name        string `json:"name"`
+		Jql         string `json:"jql"`
+		Description string `json:"description"`
+		Favourite   bool   `json:"favourite"`
+		Owner       *struct {
+			AccountID string `json:"accountId"`
+		} `json:"owner,omitempty"`
+	}
+)
+
+var (
+	_ resource.Resource                = (*jiraFilterResource)(nil)
+	_ resource.ResourceWithImportState = (*jiraFilterResource)(nil)
+)
+
+func NewJiraFilterResource() resource.Resource {
+	return &jiraFilterResource{}
+}
+
+func (*jiraFilterResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_filter"
+}
+
+func (*jiraFilterResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Filter Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the filter.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the filter. The name must be unique for the owner. " +
+					"The maximum length is 255 characters.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"jql": schema.StringAttribute{
+				MarkdownDescription: "The JQL query of the filter, e.g. `project = FOO ORDER BY Rank ASC`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the filter.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringmodifiers.DefaultValue(""),
+				},
+			},
+			"favourite": schema.BoolAttribute{
+				MarkdownDescription: "Whether the filter is selected as a favourite by the user managing it. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolmodifiers.DefaultValue(false),
+				},
+			},
+			"owner_account_id": schema.StringAttribute{
+				MarkdownDescription: "The account ID of the owner of the filter.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *jiraFilterResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.p.jira = client
+}
+
+func (*jiraFilterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *jiraFilterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating filter resource")
+
+	var plan jiraFilterResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded filter plan", map[string]interface{}{
+		"createPlan": fmt.Sprintf("%+v", plan),
+	})
+
+	payload := &models.FilterPayloadScheme{
+		Name:        plan.Name.ValueString(),
+		JQL:         plan.Jql.ValueString(),
+		Description: plan.Description.ValueString(),
+		Favorite:    plan.Favourite.ValueBool(),
+	}
+	filter, res, err := r.p.jira.Filter.Create(ctx, payload)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create filter, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Created filter")
+
+	plan.ID = types.StringValue(filter.ID)
+	plan.Jql = types.StringValue(filter.Jql)
+	plan.OwnerAccountId = types.StringNull()
+	if filter.Owner != nil {
+		plan.OwnerAccountId = types.StringValue(filter.Owner.AccountID)
+	}
+
+	tflog.Debug(ctx, "Storing filter into the state", map[string]interface{}{
+		"createNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraFilterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading filter resource")
+
+	var state jiraFilterResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded filter from state", map[string]interface{}{
+		"readState": fmt.Sprintf("%+v", state),
+	})
+
+	request, err := r.p.jira.NewRequest(ctx, http.MethodGet, fmt.Sprintf("rest/api/3/filter/%s", state.ID.ValueString()), "", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create filter request, got error: %s", err))
+		return
+	}
+	filter := new(jiraFilter)
+	res, err := r.p.jira.Call(request, filter)
+	if res != nil && (res.Code == http.StatusNotFound || res.Code == http.StatusBadRequest) {
+		// The API answers with "400 Bad Request" if the filter does not exist.
+		tflog.Warn(ctx, "Unable to find filter in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get filter, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Retrieved filter from API state")
+
+	state.Name = types.StringValue(filter.Name)
+	state.Jql = types.StringValue(filter.Jql)
+	state.Description = types.StringValue(filter.Description)
+	state.Favourite = types.BoolValue(filter.Favourite)
+	if filter.Owner != nil {
+		state.OwnerAccountId = types.StringValue(filter.Owner.AccountID)
+	}
+
+	tflog.Debug(ctx, "Storing filter into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", state),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraFilterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating filter resource")
+
+	var plan jiraFilterResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded filter plan", map[string]interface{}{
+		"updatePlan": fmt.Sprintf("%+v", plan),
+	})
+
+	var state jiraFilterResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded filter from state", map[string]interface{}{
+		"updateState": fmt.Sprintf("%+v", state),
+	})
+
+	// Share permissions are left untouched, as they are managed by "atlassian_jira_filter_permission" resources.
+	payload := &jiraFilter{
+		Name:        plan.Name.ValueString(),
+		Jql:         plan.Jql.ValueString(),
+		Description: plan.Description.ValueString(),
+		Favourite:   plan.Favourite.ValueBool(),
+	}
+	request, err := r.p.jira.NewRequest(ctx, http.MethodPut, fmt.Sprintf("rest/api/3/filter/%s", state.ID.ValueString()), "", payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create filter request, got error: %s", err))
+		return
+	}
+	filter := new(jiraFilter)
+	res, err := r.p.jira.Call(request, filter)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update filter, got error: %s\n%s", err, resBody))
+		return
+	}
+
+	// The favourite flag can only be removed with the dedicated endpoint.
+	if !plan.Favourite.Equal(state.Favourite) {
+		method := http.MethodDelete
+		if plan.Favourite.ValueBool() {
+			method = http.MethodPut
+		}
+		request, err := r.p.jira.NewRequest(ctx, method, fmt.Sprintf("rest/api/3/filter/%s/favourite", state.ID.ValueString()), "", nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create filter request, got error: %s", err))
+			return
+		}
+		res, err := r.p.jira.Call(request, nil)
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update filter favourite, got error: %s\n%s", err, resBody))
+			return
+		}
+	}
+	tflog.Debug(ctx, "Updated filter in API state")
+
+	plan.ID = state.ID
+	plan.Jql = types.StringValue(filter.Jql)
+	plan.OwnerAccountId = state.OwnerAccountId
+
+	tflog.Debug(ctx, "Storing filter into the state", map[string]interface{}{
+		"updateNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraFilterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting filter resource")
+
+	var state jiraFilterResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded filter from state")
+
+	filterId, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("id"), "Unable to parse value of \"id\" attribute.", "Value of \"id\" attribute can only be a numeric string.")
+		return
+	}
+	res, err := r.p.jira.Filter.Delete(ctx, filterId)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete filter, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Deleted filter from API state")
+
+	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
+}
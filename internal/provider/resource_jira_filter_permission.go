@@ -0,0 +1,373 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraFilterPermissionResource struct {
+		p atlassianProvider
+	}
+
+	jiraFilterPermissionResourceModel struct {
+		ID            types.String `tfsdk:"id"`
+		FilterId      types.String `tfsdk:"filter_id"`
+		Type          types.String `tfsdk:"type"`
+		ProjectId     types.String `tfsdk:"project_id"`
+		ProjectRoleId types.String `tfsdk:"project_role_id"`
+		GroupId       types.String `tfsdk:"group_id"`
+		AccountId     types.String `tfsdk:"account_id"`
+	}
+
+	// The go-atlassian library does not support sharing with groups by ID nor with users.
+	jiraSharePermission struct {
+		ID      int64                       `json:"id,omitempty"`
+		Type    string                      `json:"type"`
+		Project *jiraSharePermissionProject `json:"project,omitempty"`
+		Role    *jiraSharePermissionRole    `json:"role,omitempty"`
+		Group   *jiraSharePermissionGroup   `json:"group,omitempty"`
+		User    *jiraSharePermissionUser    `json:"user,omitempty"`
+	}
+
+	jiraSharePermissionProject struct {
+		ID string `json:"id"`
+	}
+
+	jiraSharePermissionRole struct {
+		ID int64 `json:"id"`
+	}
+
+	jiraSharePermissionGroup struct {
+		GroupID string `json:"groupId"`
+		Name    string `json:"name,omitempty"`
+	}
+
+	jiraSharePermissionUser struct {
+		AccountID string `json:"accountId"`
+	}
+)
+
+var (
+	_ resource.Resource                = (*jiraFilterPermissionResource)(nil)
+	_ resource.ResourceWithImportState = (*jiraFilterPermissionResource)(nil)
+)
+
+var share_permission_types = []string{"global", "group", "loggedin", "project", "projectRole", "user"}
+
+func NewJiraFilterPermissionResource() resource.Resource {
+	return &jiraFilterPermissionResource{}
+}
+
+func (*jiraFilterPermissionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_filter_permission"
+}
+
+func (*jiraFilterPermissionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Filter Permission Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the share permission.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"filter_id": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The ID of the filter.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The type of the share permission. " +
+					"Can be one of: `global`, `group`, `loggedin`, `project`, `projectRole` or `user`.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(share_permission_types...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The ID of the project to share the filter with. " +
+					"Required if `type` is `project` or `projectRole`.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project_role_id": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The ID of the project role to share the filter with. " +
+					"Required if `type` is `projectRole`.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"group_id": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The ID of the group to share the filter with. " +
+					"Required if `type` is `group`.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"account_id": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The account ID of the user to share the filter with. " +
+					"Required if `type` is `user`.",
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *jiraFilterPermissionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.p.jira = client
+}
+
+func (*jiraFilterPermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "/")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: filter_id/id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("filter_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+}
+
+func (r *jiraFilterPermissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating filter permission resource")
+
+	var plan jiraFilterPermissionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded filter permission plan", map[string]interface{}{
+		"createPlan": fmt.Sprintf("%+v", plan),
+	})
+
+	required := map[string][]string{
+		"group":       {"group_id"},
+		"project":     {"project_id"},
+		"projectRole": {"project_id", "project_role_id"},
+		"user":        {"account_id"},
+	}
+	values := map[string]types.String{
+		"group_id":        plan.GroupId,
+		"project_id":      plan.ProjectId,
+		"project_role_id": plan.ProjectRoleId,
+		"account_id":      plan.AccountId,
+	}
+	for _, attribute := range required[plan.Type.ValueString()] {
+		if values[attribute].ValueString() == "" {
+			resp.Diagnostics.AddAttributeError(path.Root(attribute),
+				fmt.Sprintf("Failed to provide a value for %q attribute", attribute),
+				fmt.Sprintf("Value must be provided if \"type\" is: %s", plan.Type.ValueString()))
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := map[string]interface{}{
+		"type": plan.Type.ValueString(),
+	}
+	if v := plan.ProjectId.ValueString(); v != "" {
+		payload["projectId"] = v
+	}
+	if v := plan.ProjectRoleId.ValueString(); v != "" {
+		payload["projectRoleId"] = v
+	}
+	if v := plan.GroupId.ValueString(); v != "" {
+		payload["groupId"] = v
+	}
+	if v := plan.AccountId.ValueString(); v != "" {
+		payload["accountId"] = v
+	}
+	request, err := r.p.jira.NewRequest(ctx, http.MethodPost, fmt.Sprintf("rest/api/3/filter/%s/permission", plan.FilterId.ValueString()), "", payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create filter permission request, got error: %s", err))
+		return
+	}
+	var permissions []jiraSharePermission
+	res, err := r.p.jira.Call(request, &permissions)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create filter permission, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Created filter permission")
+
+	// The API returns all share permissions of the filter, so the new one has to be found by its attributes.
+	for _, p := range permissions {
+		if plan.Type.ValueString() == p.Type && sharePermissionMatches(p, plan.ProjectId, plan.ProjectRoleId, plan.GroupId, plan.AccountId) {
+			plan.ID = types.StringValue(strconv.FormatInt(p.ID, 10))
+			break
+		}
+	}
+	if plan.ID.IsUnknown() {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find the new share permission of filter %s", plan.FilterId.ValueString()))
+		return
+	}
+
+	tflog.Debug(ctx, "Storing filter permission into the state", map[string]interface{}{
+		"createNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraFilterPermissionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading filter permission resource")
+
+	var state jiraFilterPermissionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded filter permission from state", map[string]interface{}{
+		"readState": fmt.Sprintf("%+v", state),
+	})
+
+	request, err := r.p.jira.NewRequest(ctx, http.MethodGet, fmt.Sprintf("rest/api/3/filter/%s/permission/%s", state.FilterId.ValueString(), state.ID.ValueString()), "", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create filter permission request, got error: %s", err))
+		return
+	}
+	permission := new(jiraSharePermission)
+	res, err := r.p.jira.Call(request, permission)
+	if res != nil && res.Code == http.StatusNotFound {
+		tflog.Warn(ctx, "Unable to find filter permission in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get filter permission, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Retrieved filter permission from API state")
+
+	state.Type = types.StringValue(permission.Type)
+	if permission.Project != nil {
+		state.ProjectId = types.StringValue(permission.Project.ID)
+	}
+	if permission.Role != nil {
+		state.ProjectRoleId = types.StringValue(strconv.FormatInt(permission.Role.ID, 10))
+	}
+	if permission.Group != nil {
+		state.GroupId = types.StringValue(permission.Group.GroupID)
+	}
+	if permission.User != nil {
+		state.AccountId = types.StringValue(permission.User.AccountID)
+	}
+
+	tflog.Debug(ctx, "Storing filter permission into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", state),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraFilterPermissionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// The RequiresReplace plan modifier will trigger Terraform to destroy and recreate the resource
+	// if any of the required attributes changes, i.e. all attributes of a share permission.
+	tflog.Debug(ctx, "If the value of any required attribute changes, Terraform will destroy and recreate the resource")
+}
+
+func (r *jiraFilterPermissionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting filter permission resource")
+
+	var state jiraFilterPermissionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded filter permission from state")
+
+	filterId, err := strconv.Atoi(state.FilterId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("filter_id"), "Unable to parse value of \"filter_id\" attribute.", "Value of \"filter_id\" attribute can only be a numeric string.")
+		return
+	}
+	permissionId, err := strconv.Atoi(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("id"), "Unable to parse value of \"id\" attribute.", "Value of \"id\" attribute can only be a numeric string.")
+		return
+	}
+	res, err := r.p.jira.Filter.Share.Delete(ctx, filterId, permissionId)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete filter permission, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Deleted filter permission from API state")
+
+	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
+}
+
+// sharePermissionMatches reports whether the share permission is granted to the given project, project role, group or user.
+func sharePermissionMatches(p jiraSharePermission, projectId, projectRoleId, groupId, accountId types.String) bool {
+	if projectId.ValueString() != "" && (p.Project == nil || p.Project.ID != projectId.ValueString()) {
+		return false
+	}
+	if projectRoleId.ValueString() != "" && (p.Role == nil || strconv.FormatInt(p.Role.ID, 10) != projectRoleId.ValueString()) {
+		return false
+	}
+	if groupId.ValueString() != "" && (p.Group == nil || p.Group.GroupID != groupId.ValueString()) {
+		return false
+	}
+	if accountId.ValueString() != "" && (p.User == nil || p.User.AccountID != accountId.ValueString()) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,63 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccJiraFilterPermission_Basic(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-filter-permission")
+	resourceName := "atlassian_jira_filter_permission.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFilterPermissionConfig_basic(resourceName, randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "filter_id", "atlassian_jira_filter.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "type", "group"),
+					resource.TestCheckResourceAttrPair(resourceName, "group_id", "atlassian_jira_group.test", "group_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccFilterPermissionImportConfig,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccFilterPermissionImportConfig(s *terraform.State) (string, error) {
+	filterId := s.RootModule().Resources["atlassian_jira_filter_permission.test"].Primary.Attributes["filter_id"]
+	id := s.RootModule().Resources["atlassian_jira_filter_permission.test"].Primary.Attributes["id"]
+	return fmt.Sprintf("%s/%s", filterId, id), nil
+}
+
+func testAccFilterPermissionConfig_basic(resourceName, name string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	resource "atlassian_jira_filter" "test" {
+		name = %[3]q
+		jql  = "issuetype = Bug"
+	}
+
+	resource "atlassian_jira_group" "test" {
+		name = %[3]q
+	}
+
+	resource %[1]q %[2]q {
+		filter_id = atlassian_jira_filter.test.id
+		type      = "group"
+		group_id  = atlassian_jira_group.test.group_id
+	}
+	`, splits[0], splits[1], name)
+}
@@ -0,0 +1,59 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraFilter_Basic(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-filter")
+	resourceName := "atlassian_jira_filter.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFilterConfig_basic(resourceName, randomName, "foo", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", randomName),
+					resource.TestCheckResourceAttr(resourceName, "jql", "issuetype = Bug"),
+					resource.TestCheckResourceAttr(resourceName, "description", "foo"),
+					resource.TestCheckResourceAttr(resourceName, "favourite", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "owner_account_id", "data.atlassian_jira_myself.test", "account_id"),
+				),
+			},
+			{
+				Config: testAccFilterConfig_basic(resourceName, randomName+"-updated", "", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", randomName+"-updated"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "favourite", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccFilterConfig_basic(resourceName, name, description string, favourite bool) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	data "atlassian_jira_myself" "test" {}
+
+	resource %[1]q %[2]q {
+		name        = %[3]q
+		jql         = "issuetype = Bug"
+		description = %[4]q
+		favourite   = %[5]t
+	}
+	`, splits[0], splits[1], name, description, favourite)
+}
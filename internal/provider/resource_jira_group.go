@@ -17,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	common "github.com/openscientia/terraform-provider-atlassian/internal/provider/models"
+	"github.com/openscientia/terraform-provider-atlassian/internal/provider/planmodifiers/boolmodifiers"
 )
 
 type (
@@ -25,11 +26,12 @@ type (
 	}
 
 	jiraGroupResourceModel struct {
-		ID      types.String `tfsdk:"id"`
-		Name    types.String `tfsdk:"name"`
-		GroupID types.String `tfsdk:"group_id"`
-		Self    types.String `tfsdk:"self"`
-		Users   types.Set    `tfsdk:"users"`
+		ID                 types.String `tfsdk:"id"`
+		Name               types.String `tfsdk:"name"`
+		GroupID            types.String `tfsdk:"group_id"`
+		Self               types.String `tfsdk:"self"`
+		Users              types.Set    `tfsdk:"users"`
+		DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
 	}
 
 	jiraGroupUsersModel struct {
@@ -154,6 +156,15 @@ func (*jiraGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					setplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"deletion_protection": schema.BoolAttribute{
+				MarkdownDescription: "Whether Terraform is prevented from destroying the group. " +
+					"The value must be set to `false` and applied before the group can be destroyed or replaced. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolmodifiers.DefaultValue(false),
+				},
+			},
 		},
 	}
 }
@@ -184,7 +195,7 @@ func (r *jiraGroupResource) Create(ctx context.Context, req resource.CreateReque
 	tflog.Debug(ctx, "Creating group resource")
 
 	var plan jiraGroupResourceModel
-	resp.Diagnostics.Append(req.Config.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -215,6 +226,10 @@ func (r *jiraGroupResource) Create(ctx context.Context, req resource.CreateReque
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to retrieve group details, got error: %s\n%s", err, resBody))
 		return
 	}
+	if len(groupDetails.Values) == 0 {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to retrieve group details, group %q not found", group.Name))
+		return
+	}
 
 	plan.ID = types.StringValue(groupDetails.Values[0].GroupID)
 	plan.GroupID = types.StringValue(groupDetails.Values[0].GroupID)
@@ -239,8 +254,12 @@ func (r *jiraGroupResource) Read(ctx context.Context, req resource.ReadRequest,
 		"readState": fmt.Sprintf("%+v", state),
 	})
 
-	bulkOptions := &models.GroupBulkOptionsScheme{
-		GroupNames: []string{state.Name.ValueString()},
+	// Prefer the group ID, which is not affected by the group being renamed outside of Terraform.
+	bulkOptions := &models.GroupBulkOptionsScheme{}
+	if state.GroupID.ValueString() != "" {
+		bulkOptions.GroupIDs = []string{state.GroupID.ValueString()}
+	} else {
+		bulkOptions.GroupNames = []string{state.Name.ValueString()}
 	}
 	group, res, err := r.p.jira.Group.Bulk(ctx, bulkOptions, 0, 1)
 	if err != nil {
@@ -251,6 +270,12 @@ func (r *jiraGroupResource) Read(ctx context.Context, req resource.ReadRequest,
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get group, got error: %s\n%s", err, resBody))
 		return
 	}
+	if len(group.Values) == 0 {
+		tflog.Warn(ctx, "Unable to find group in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.Name = types.StringValue(group.Values[0].Name)
 
 	isLast := false
 	startAt := 0
@@ -278,6 +303,10 @@ func (r *jiraGroupResource) Read(ctx context.Context, req resource.ReadRequest,
 	state.ID = types.StringValue(group.Values[0].GroupID)
 	state.GroupID = types.StringValue(group.Values[0].GroupID)
 	state.Self = types.StringValue(fmt.Sprintf("https://%s/rest/api/3/group?groupId=%s", r.p.jira.Site.Host, group.Values[0].GroupID))
+	// The value is not stored by Jira, so it is only missing after importing the group
+	if state.DeletionProtection.IsNull() {
+		state.DeletionProtection = types.BoolValue(false)
+	}
 
 	var users []jiraGroupUsersModel
 	for _, u := range members {
@@ -310,6 +339,18 @@ func (r *jiraGroupResource) Update(ctx context.Context, req resource.UpdateReque
 	// The RequiresReplace plan modifier will trigger Terraform to destroy and recreate the resource
 	// if any of the required attributes changes, i.e. name.
 	tflog.Debug(ctx, "If the value of any required attribute changes, Terraform will destroy and recreate the resource")
+
+	// Only attributes that are not stored by Jira can be updated in place, i.e. deletion_protection.
+	var plan jiraGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Storing group into the state", map[string]interface{}{
+		"updateNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *jiraGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -321,6 +362,12 @@ func (r *jiraGroupResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError("Deletion Protection Enabled",
+			"Unable to delete group, because \"deletion_protection\" is set to true. Set it to false and apply the configuration before destroying the group.")
+		return
+	}
+
 	res, err := r.p.jira.Group.Delete(ctx, state.Name.ValueString())
 	if err != nil {
 		var resBody string
@@ -0,0 +1,260 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraGroupMembershipResource struct {
+		p atlassianProvider
+	}
+
+	jiraGroupMembershipResourceModel struct {
+		ID        types.String `tfsdk:"id"`
+		GroupID   types.String `tfsdk:"group_id"`
+		AccountID types.String `tfsdk:"account_id"`
+	}
+)
+
+var (
+	_ resource.Resource                = (*jiraGroupMembershipResource)(nil)
+	_ resource.ResourceWithImportState = (*jiraGroupMembershipResource)(nil)
+)
+
+func NewJiraGroupMembershipResource() resource.Resource {
+	return &jiraGroupMembershipResource{}
+}
+
+func (*jiraGroupMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_group_membership"
+}
+
+func (*jiraGroupMembershipResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Group Membership Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the group membership. It is computed using `group_id` and `account_id` separated by a slash (`/`).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group_id": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The ID of the group.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"account_id": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The account ID of the user, which uniquely identifies the user across all Atlassian products.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *jiraGroupMembershipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.p.jira = client
+}
+
+func (*jiraGroupMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "/")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: group_id/account_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("account_id"), idParts[1])...)
+}
+
+func (r *jiraGroupMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating group membership resource")
+
+	var plan jiraGroupMembershipResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded group membership plan", map[string]interface{}{
+		"createPlan": fmt.Sprintf("%+v", plan),
+	})
+
+	// The go-atlassian library only supports adding users to groups by group name.
+	params := url.Values{}
+	params.Add("groupId", plan.GroupID.ValueString())
+	payload := map[string]interface{}{
+		"accountId": plan.AccountID.ValueString(),
+	}
+	request, err := r.p.jira.NewRequest(ctx, http.MethodPost, fmt.Sprintf("rest/api/3/group/user?%s", params.Encode()), "", payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create group membership request, got error: %s", err))
+		return
+	}
+	res, err := r.p.jira.Call(request, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create group membership, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Created group membership")
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s/%s", plan.GroupID.ValueString(), plan.AccountID.ValueString()))
+
+	tflog.Debug(ctx, "Storing group membership into the state", map[string]interface{}{
+		"createNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraGroupMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading group membership resource")
+
+	var state jiraGroupMembershipResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded group membership from state", map[string]interface{}{
+		"readState": fmt.Sprintf("%+v", state),
+	})
+
+	isMember := false
+	isLast := false
+	startAt := 0
+	maxResults := 50
+	for !isLast && !isMember {
+		params := url.Values{}
+		params.Add("groupId", state.GroupID.ValueString())
+		params.Add("includeInactiveUsers", "true")
+		params.Add("startAt", strconv.Itoa(startAt))
+		params.Add("maxResults", strconv.Itoa(maxResults))
+		request, err := r.p.jira.NewRequest(ctx, http.MethodGet, fmt.Sprintf("rest/api/3/group/member?%s", params.Encode()), "", nil)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create group membership request, got error: %s", err))
+			return
+		}
+		groupMembers := new(models.GroupMemberPageScheme)
+		res, err := r.p.jira.Call(request, groupMembers)
+		if res != nil && res.Code == http.StatusNotFound {
+			tflog.Warn(ctx, "Unable to find group in API state, deleting resource from state")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get group members, got error: %s\n%s", err, resBody))
+			return
+		}
+		for _, u := range groupMembers.Values {
+			if u.AccountID == state.AccountID.ValueString() {
+				isMember = true
+				break
+			}
+		}
+		startAt += maxResults
+		isLast = groupMembers.IsLast || len(groupMembers.Values) == 0
+	}
+	if !isMember {
+		tflog.Warn(ctx, "Unable to find group membership in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	tflog.Debug(ctx, "Retrieved group membership from API state")
+
+	state.ID = types.StringValue(fmt.Sprintf("%s/%s", state.GroupID.ValueString(), state.AccountID.ValueString()))
+
+	tflog.Debug(ctx, "Storing group membership into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", state),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraGroupMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// The RequiresReplace plan modifier will trigger Terraform to destroy and recreate the resource
+	// if any of the required attributes changes, i.e. group_id and/or account_id.
+	tflog.Debug(ctx, "If the value of any required attribute changes, Terraform will destroy and recreate the resource")
+}
+
+func (r *jiraGroupMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting group membership resource")
+
+	var state jiraGroupMembershipResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded group membership from state")
+
+	params := url.Values{}
+	params.Add("groupId", state.GroupID.ValueString())
+	params.Add("accountId", state.AccountID.ValueString())
+	request, err := r.p.jira.NewRequest(ctx, http.MethodDelete, fmt.Sprintf("rest/api/3/group/user?%s", params.Encode()), "", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create group membership request, got error: %s", err))
+		return
+	}
+	res, err := r.p.jira.Call(request, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete group membership, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Deleted group membership from API state")
+
+	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
+}
@@ -0,0 +1,50 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraGroupMembership_Basic(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-group-membership")
+	resourceName := "atlassian_jira_group_membership.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupMembershipConfig_basic(resourceName, randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "group_id", "atlassian_jira_group.test", "group_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "account_id", "data.atlassian_jira_myself.test", "account_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccGroupMembershipConfig_basic(resourceName, name string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	data "atlassian_jira_myself" "test" {}
+
+	resource "atlassian_jira_group" "test" {
+		name = %[3]q
+	}
+
+	resource %[1]q %[2]q {
+		group_id   = atlassian_jira_group.test.group_id
+		account_id = data.atlassian_jira_myself.test.account_id
+	}
+	`, splits[0], splits[1], name)
+}
@@ -2,6 +2,7 @@ package atlassian
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -59,6 +60,34 @@ func TestAccJiraGroup_Name(t *testing.T) {
 	})
 }
 
+func TestAccJiraGroup_DeletionProtection(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-group")
+	resourceName := "atlassian_jira_group.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGroupConfig_deletionProtection(resourceName, randomName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "true"),
+				),
+			},
+			{
+				Config:      testAccGroupConfig_deletionProtection(resourceName, randomName, true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("Deletion Protection Enabled"),
+			},
+			{
+				Config: testAccGroupConfig_deletionProtection(resourceName, randomName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
 func testAccGroupConfig_basic(resourceName, name string) string {
 	splits := strings.Split(resourceName, ".")
 	return fmt.Sprintf(`
@@ -67,3 +96,13 @@ func testAccGroupConfig_basic(resourceName, name string) string {
 	}
 	`, splits[0], splits[1], name)
 }
+
+func testAccGroupConfig_deletionProtection(resourceName, name string, deletionProtection bool) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		name                = %[3]q
+		deletion_protection = %[4]t
+	}
+	`, splits[0], splits[1], name, deletionProtection)
+}
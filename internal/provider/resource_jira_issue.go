@@ -0,0 +1,405 @@
+package atlassian
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openscientia/terraform-provider-atlassian/internal/provider/validators"
+)
+
+type (
+	jiraIssueResource struct {
+		p atlassianProvider
+	}
+
+	jiraIssueResourceModel struct {
+		ID           types.String `tfsdk:"id"`
+		Key          types.String `tfsdk:"key"`
+		ProjectId    types.String `tfsdk:"project_id"`
+		IssueTypeId  types.String `tfsdk:"issue_type_id"`
+		Summary      types.String `tfsdk:"summary"`
+		Description  types.String `tfsdk:"description"`
+		Labels       types.Set    `tfsdk:"labels"`
+		CustomFields types.Map    `tfsdk:"custom_fields"`
+	}
+
+	// The go-atlassian library models issue fields as fixed structs, which cannot hold arbitrary custom fields.
+	jiraIssue struct {
+		ID     string                     `json:"id"`
+		Key    string                     `json:"key"`
+		Fields map[string]json.RawMessage `json:"fields,omitempty"`
+	}
+
+	jiraIssueReference struct {
+		ID string `json:"id"`
+	}
+)
+
+var (
+	_ resource.Resource                = (*jiraIssueResource)(nil)
+	_ resource.ResourceWithImportState = (*jiraIssueResource)(nil)
+)
+
+func NewJiraIssueResource() resource.Resource {
+	return &jiraIssueResource{}
+}
+
+func (*jiraIssueResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_issue"
+}
+
+func (*jiraIssueResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Issue Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the issue.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "The key of the issue, e.g. `PROJ-1`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The ID of the project the issue belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"issue_type_id": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The ID of the issue type. It must be part of the issue type scheme of the project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"summary": schema.StringAttribute{
+				MarkdownDescription: "The summary of the issue. The maximum length is 255 characters.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the issue, as a JSON document in [Atlassian Document Format](https://developer.atlassian.com/cloud/jira/platform/apis/document/structure/).",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.Json(),
+				},
+			},
+			"labels": schema.SetAttribute{
+				MarkdownDescription: "The labels of the issue. Labels cannot contain spaces.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"custom_fields": schema.MapAttribute{
+				MarkdownDescription: "The custom fields of the issue, where each key is the ID of a custom field, e.g. `customfield_10010`, and each value is the JSON document of its value, e.g. `jsonencode({ value = \"High\" })`. " +
+					"Custom fields not set here are not managed by this resource. Changes to custom field values made outside of Terraform are not detected.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.RegexMatches(regexp.MustCompile(`^customfield_[0-9]+$`), "value must be a custom field ID, e.g. customfield_10010")),
+					mapvalidator.ValueStringsAre(validators.Json()),
+				},
+			},
+		},
+	}
+}
+
+func (r *jiraIssueResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.p.jira = client
+}
+
+func (*jiraIssueResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *jiraIssueResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating issue resource")
+
+	var plan jiraIssueResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded issue plan", map[string]interface{}{
+		"createPlan": fmt.Sprintf("%+v", plan),
+	})
+
+	fields, diags := issueFieldsPayload(ctx, &plan, nil)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	fields["project"] = jiraIssueReference{ID: plan.ProjectId.ValueString()}
+
+	request, err := r.p.jira.NewRequest(ctx, http.MethodPost, "rest/api/3/issue", "", map[string]interface{}{"fields": fields})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create issue request, got error: %s", err))
+		return
+	}
+	issue := new(jiraIssue)
+	res, err := r.p.jira.Call(request, issue)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create issue, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Created issue")
+
+	plan.ID = types.StringValue(issue.ID)
+	plan.Key = types.StringValue(issue.Key)
+
+	tflog.Debug(ctx, "Storing issue into the state", map[string]interface{}{
+		"createNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraIssueResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading issue resource")
+
+	var state jiraIssueResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded issue from state", map[string]interface{}{
+		"readState": fmt.Sprintf("%+v", state),
+	})
+
+	params := url.Values{}
+	params.Set("fields", "project,issuetype,summary,description,labels")
+	request, err := r.p.jira.NewRequest(ctx, http.MethodGet, fmt.Sprintf("rest/api/3/issue/%s?%s", state.ID.ValueString(), params.Encode()), "", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create issue request, got error: %s", err))
+		return
+	}
+	issue := new(jiraIssue)
+	res, err := r.p.jira.Call(request, issue)
+	if res != nil && res.Code == http.StatusNotFound {
+		tflog.Warn(ctx, "Unable to find issue in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get issue, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Retrieved issue from API state")
+
+	var (
+		project, issueType jiraIssueReference
+		summary            string
+		labels             []string
+	)
+	for field, out := range map[string]interface{}{"project": &project, "issuetype": &issueType, "summary": &summary, "labels": &labels} {
+		if raw, ok := issue.Fields[field]; ok {
+			if err := json.Unmarshal(raw, out); err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to parse issue field %q, got error: %s", field, err))
+				return
+			}
+		}
+	}
+
+	state.ID = types.StringValue(issue.ID)
+	state.Key = types.StringValue(issue.Key)
+	state.ProjectId = types.StringValue(project.ID)
+	state.IssueTypeId = types.StringValue(issueType.ID)
+	state.Summary = types.StringValue(summary)
+
+	description := string(issue.Fields["description"])
+	if description == "" || description == "null" {
+		state.Description = types.StringNull()
+	} else if !jsonEqual(description, state.Description.ValueString()) {
+		state.Description = types.StringValue(description)
+	}
+
+	if len(labels) == 0 && state.Labels.IsNull() {
+		state.Labels = types.SetNull(types.StringType)
+	} else {
+		newLabels, diags := types.SetValueFrom(ctx, types.StringType, labels)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.Labels = newLabels
+	}
+
+	// Custom fields are kept as configured, as Jira returns a richer representation of their values than it accepts.
+
+	tflog.Debug(ctx, "Storing issue into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", state),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraIssueResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating issue resource")
+
+	var plan jiraIssueResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded issue plan", map[string]interface{}{
+		"updatePlan": fmt.Sprintf("%+v", plan),
+	})
+
+	var state jiraIssueResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded issue from state", map[string]interface{}{
+		"updateState": fmt.Sprintf("%+v", state),
+	})
+
+	fields, diags := issueFieldsPayload(ctx, &plan, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request, err := r.p.jira.NewRequest(ctx, http.MethodPut, fmt.Sprintf("rest/api/3/issue/%s", state.ID.ValueString()), "", map[string]interface{}{"fields": fields})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create issue request, got error: %s", err))
+		return
+	}
+	res, err := r.p.jira.Call(request, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update issue, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Updated issue in API state")
+
+	plan.ID = state.ID
+	plan.Key = state.Key
+
+	tflog.Debug(ctx, "Storing issue into the state", map[string]interface{}{
+		"updateNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraIssueResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting issue resource")
+
+	var state jiraIssueResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded issue from state")
+
+	request, err := r.p.jira.NewRequest(ctx, http.MethodDelete, fmt.Sprintf("rest/api/3/issue/%s", state.ID.ValueString()), "", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create issue request, got error: %s", err))
+		return
+	}
+	res, err := r.p.jira.Call(request, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete issue, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Deleted issue from API state")
+
+	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
+}
+
+// issueFieldsPayload returns the fields of an issue to create or edit.
+// When editing, custom fields removed from the configuration are cleared.
+func issueFieldsPayload(ctx context.Context, plan, state *jiraIssueResourceModel) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	fields := map[string]interface{}{
+		"summary": plan.Summary.ValueString(),
+	}
+
+	// Changing the issue type requires the issue to be moved, so it is only set on creation.
+	if state == nil {
+		fields["issuetype"] = jiraIssueReference{ID: plan.IssueTypeId.ValueString()}
+	}
+
+	if !plan.Description.IsNull() {
+		fields["description"] = json.RawMessage(plan.Description.ValueString())
+	} else if state != nil {
+		fields["description"] = nil
+	}
+
+	labels := []string{}
+	diags.Append(plan.Labels.ElementsAs(ctx, &labels, false)...)
+	if len(labels) > 0 || state != nil {
+		fields["labels"] = labels
+	}
+
+	customFields := map[string]string{}
+	diags.Append(plan.CustomFields.ElementsAs(ctx, &customFields, false)...)
+	if state != nil {
+		oldCustomFields := map[string]string{}
+		diags.Append(state.CustomFields.ElementsAs(ctx, &oldCustomFields, false)...)
+		for key := range oldCustomFields {
+			if _, ok := customFields[key]; !ok {
+				fields[key] = nil
+			}
+		}
+	}
+	for key, value := range customFields {
+		fields[key] = json.RawMessage(value)
+	}
+
+	return fields, diags
+}
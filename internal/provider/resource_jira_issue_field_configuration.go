@@ -3,6 +3,7 @@ package atlassian
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strconv"
 
 	jira "github.com/ctreminiom/go-atlassian/jira/v3"
@@ -11,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -50,6 +52,9 @@ func (*jiraIssueFieldConfigurationResource) Schema(_ context.Context, _ resource
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The ID of the issue field configuration.",
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "The name of the issue field configuration. " +
@@ -151,6 +156,11 @@ func (r *jiraIssueFieldConfigurationResource) Read(ctx context.Context, req reso
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get issue field configuration, got error: %s\n%s", err, resBody))
 		return
 	}
+	if len(issueFieldConfiguration.Values) == 0 {
+		tflog.Warn(ctx, "Unable to find issue field configuration in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	tflog.Debug(ctx, "Retrieved issue field configuration from API state")
 
 	state.Name = types.StringValue(issueFieldConfiguration.Values[0].Name)
@@ -183,8 +193,18 @@ func (r *jiraIssueFieldConfigurationResource) Update(ctx context.Context, req re
 		"updateState": fmt.Sprintf("%+v", state),
 	})
 
-	issueFieldConfigurationId, _ := strconv.Atoi(state.ID.ValueString())
-	res, err := r.p.jira.Issue.Field.Configuration.Update(ctx, issueFieldConfigurationId, plan.Name.ValueString(), plan.Description.ValueString())
+	// The go-atlassian library omits an empty description, which prevents removing it.
+	payload := map[string]string{
+		"name":        plan.Name.ValueString(),
+		"description": plan.Description.ValueString(),
+	}
+	request, err := r.p.jira.NewRequest(ctx, http.MethodPut, fmt.Sprintf("rest/api/3/fieldconfiguration/%s", state.ID.ValueString()), "", payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create issue field configuration request, got error: %s", err))
+		return
+	}
+
+	res, err := r.p.jira.Call(request, nil)
 	if err != nil {
 		var resBody string
 		if res != nil {
@@ -3,6 +3,7 @@ package atlassian
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"regexp"
 	"strconv"
 	"strings"
@@ -80,7 +81,7 @@ func (*jiraIssueFieldConfigurationItemResource) Schema(_ context.Context, _ reso
 						MarkdownDescription: "(Forces new resource) The ID of the field within the issue field configuration.",
 						Required:            true,
 						Validators: []validator.String{
-							stringvalidator.RegexMatches(regexp.MustCompile(`^customfield_[0-9]{5}$|^[a-zA-Z]*$`), ""),
+							stringvalidator.RegexMatches(regexp.MustCompile(`^customfield_[0-9]+$|^[a-zA-Z]*$`), ""),
 						},
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.RequiresReplace(),
@@ -191,25 +192,18 @@ func (r *jiraIssueFieldConfigurationItemResource) Create(ctx context.Context, re
 		return
 	}
 
-	items, res, err := r.p.jira.Issue.Field.Configuration.Item.Gets(ctx, issueFieldConfigurationId, 0, 50)
+	item, _, err := r.getIssueFieldConfigurationItem(ctx, issueFieldConfigurationId, plan.Item.ID.ValueString())
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get issue field configuration items, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.AddError("Client Error", err.Error())
 		return
 	}
-
-	for _, i := range items.Values {
-		if i.ID == plan.Item.ID.ValueString() {
-			plan.Item = &jiraIssueFieldConfigurationItem{
-				ID:          types.StringValue(plan.Item.ID.ValueString()),
-				Description: types.StringValue(i.Description),
-				IsHidden:    types.BoolValue(i.IsHidden),
-				IsRequired:  types.BoolValue(i.IsRequired),
-				Renderer:    types.StringValue(i.Renderer),
-			}
+	if item != nil {
+		plan.Item = &jiraIssueFieldConfigurationItem{
+			ID:          types.StringValue(plan.Item.ID.ValueString()),
+			Description: types.StringValue(item.Description),
+			IsHidden:    types.BoolValue(item.IsHidden),
+			IsRequired:  types.BoolValue(item.IsRequired),
+			Renderer:    types.StringValue(item.Renderer),
 		}
 	}
 	tflog.Debug(ctx, "Created issue field configuration item")
@@ -235,26 +229,23 @@ func (r *jiraIssueFieldConfigurationItemResource) Read(ctx context.Context, req
 	})
 
 	issueFieldConfigurationId, _ := strconv.Atoi(state.IssueFieldConfiguration.ValueString())
-	issueFieldConfigurationItem, res, err := r.p.jira.Issue.Field.Configuration.Item.Gets(ctx, issueFieldConfigurationId, 0, 50)
+	issueFieldConfigurationItem, res, err := r.getIssueFieldConfigurationItem(ctx, issueFieldConfigurationId, state.Item.ID.ValueString())
+	if (res != nil && res.Code == http.StatusNotFound) || (err == nil && issueFieldConfigurationItem == nil) {
+		tflog.Warn(ctx, "Unable to find issue field configuration item in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get issue field configuration item, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.AddError("Client Error", err.Error())
 		return
 	}
 
-	for _, i := range issueFieldConfigurationItem.Values {
-		if i.ID == state.Item.ID.ValueString() {
-			state.Item = &jiraIssueFieldConfigurationItem{
-				ID:          types.StringValue(state.Item.ID.ValueString()),
-				Description: types.StringValue(i.Description),
-				IsHidden:    types.BoolValue(i.IsHidden),
-				IsRequired:  types.BoolValue(i.IsRequired),
-				Renderer:    types.StringValue(i.Renderer),
-			}
-		}
+	state.Item = &jiraIssueFieldConfigurationItem{
+		ID:          types.StringValue(state.Item.ID.ValueString()),
+		Description: types.StringValue(issueFieldConfigurationItem.Description),
+		IsHidden:    types.BoolValue(issueFieldConfigurationItem.IsHidden),
+		IsRequired:  types.BoolValue(issueFieldConfigurationItem.IsRequired),
+		Renderer:    types.StringValue(issueFieldConfigurationItem.Renderer),
 	}
 	tflog.Debug(ctx, "Retrieved issue field configuration item from API state")
 
@@ -303,30 +294,25 @@ func (r *jiraIssueFieldConfigurationItemResource) Update(ctx context.Context, re
 	res, err := r.p.jira.Issue.Field.Configuration.Item.Update(ctx, issueFieldConfigurationId, &updateRequestPayload)
 	if err != nil {
 		var resBody string
-		resBody = res.Bytes.String()
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update issue field configuration item, got error: %s\n%s", err, resBody))
 		return
 	}
 
-	items, res, err := r.p.jira.Issue.Field.Configuration.Item.Gets(ctx, issueFieldConfigurationId, 0, 50)
+	item, _, err := r.getIssueFieldConfigurationItem(ctx, issueFieldConfigurationId, plan.Item.ID.ValueString())
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get issue field configuration items, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.AddError("Client Error", err.Error())
 		return
 	}
-
-	for _, i := range items.Values {
-		if i.ID == plan.Item.ID.ValueString() {
-			plan.Item = &jiraIssueFieldConfigurationItem{
-				ID:          types.StringValue(plan.Item.ID.ValueString()),
-				Description: types.StringValue(i.Description),
-				IsHidden:    types.BoolValue(i.IsHidden),
-				IsRequired:  types.BoolValue(i.IsRequired),
-				Renderer:    types.StringValue(i.Renderer),
-			}
+	if item != nil {
+		plan.Item = &jiraIssueFieldConfigurationItem{
+			ID:          types.StringValue(plan.Item.ID.ValueString()),
+			Description: types.StringValue(item.Description),
+			IsHidden:    types.BoolValue(item.IsHidden),
+			IsRequired:  types.BoolValue(item.IsRequired),
+			Renderer:    types.StringValue(item.Renderer),
 		}
 	}
 
@@ -343,6 +329,30 @@ func (r *jiraIssueFieldConfigurationItemResource) Delete(ctx context.Context, re
 	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
 }
 
+// getIssueFieldConfigurationItem returns the item with the given field ID, or nil if the issue field configuration does not contain it.
+func (r *jiraIssueFieldConfigurationItemResource) getIssueFieldConfigurationItem(ctx context.Context, issueFieldConfigurationId int, itemId string) (*models.FieldConfigurationItemScheme, *models.ResponseScheme, error) {
+	startAt := 0
+	for {
+		items, res, err := r.p.jira.Issue.Field.Configuration.Item.Gets(ctx, issueFieldConfigurationId, startAt, 100)
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			return nil, res, fmt.Errorf("Unable to get issue field configuration items, got error: %s\n%s", err, resBody)
+		}
+		for _, i := range items.Values {
+			if i.ID == itemId {
+				return i, res, nil
+			}
+		}
+		if items.IsLast || len(items.Values) == 0 {
+			return nil, res, nil
+		}
+		startAt += len(items.Values)
+	}
+}
+
 func (r *jiraIssueFieldConfigurationItemResource) checkIssueFieldConfigurationItemRenderable(ctx context.Context, p *jiraIssueFieldConfigurationItemResourceModel) diag.Diagnostic {
 	var isRenderable bool
 	searchPayload := models.FieldSearchOptionsScheme{
@@ -6,11 +6,13 @@ import (
 	"strconv"
 
 	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -23,9 +25,15 @@ type (
 	}
 
 	jiraIssueFieldConfigurationSchemeResourceModel struct {
-		ID          types.String `tfsdk:"id"`
-		Name        types.String `tfsdk:"name"`
-		Description types.String `tfsdk:"description"`
+		ID          types.String                               `tfsdk:"id"`
+		Name        types.String                               `tfsdk:"name"`
+		Description types.String                               `tfsdk:"description"`
+		Mappings    []jiraIssueFieldConfigurationSchemeMapping `tfsdk:"mappings"`
+	}
+
+	jiraIssueFieldConfigurationSchemeMapping struct {
+		IssueTypeId          types.String `tfsdk:"issue_type_id"`
+		FieldConfigurationId types.String `tfsdk:"field_configuration_id"`
 	}
 )
 
@@ -50,6 +58,9 @@ func (*jiraIssueFieldConfigurationSchemeResource) Schema(_ context.Context, _ re
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The ID of the issue field configuration scheme.",
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "The name of the issue field configuration scheme. " +
@@ -72,6 +83,24 @@ func (*jiraIssueFieldConfigurationSchemeResource) Schema(_ context.Context, _ re
 					stringmodifiers.DefaultValue(""),
 				},
 			},
+			"mappings": schema.SetNestedAttribute{
+				MarkdownDescription: "The issue field configurations mapped to issue types. " +
+					"If not set, the mappings are not managed by this resource and can be managed with `atlassian_jira_issue_field_configuration_scheme_mapping` resources.",
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"issue_type_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the issue type or `default`. " +
+								"The `default` mapping applies to all issue types without an issue field configuration.",
+							Required: true,
+						},
+						"field_configuration_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the issue field configuration.",
+							Required:            true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -123,6 +152,14 @@ func (r *jiraIssueFieldConfigurationSchemeResource) Create(ctx context.Context,
 
 	plan.ID = types.StringValue(issueFieldConfigurationScheme.ID)
 
+	if len(plan.Mappings) > 0 {
+		id, _ := strconv.Atoi(issueFieldConfigurationScheme.ID)
+		if err := r.linkMappings(ctx, id, plan.Mappings); err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+	}
+
 	tflog.Debug(ctx, "Storing issue field configuration scheme info into the state", map[string]interface{}{
 		"createNewState": fmt.Sprintf("%+v", plan),
 	})
@@ -151,6 +188,36 @@ func (r *jiraIssueFieldConfigurationSchemeResource) Read(ctx context.Context, re
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get issue field configuration scheme, got error: %s\n%s", err, resBody))
 		return
 	}
+	if len(issueFieldConfigurationScheme.Values) == 0 {
+		tflog.Warn(ctx, "Unable to find issue field configuration scheme in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if state.Mappings != nil {
+		mappings, err := r.getMappings(ctx, id)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+		// Every issue field configuration scheme has a default mapping, which is only stored when managed.
+		managesDefault := false
+		for _, m := range state.Mappings {
+			if m.IssueTypeId.ValueString() == "default" {
+				managesDefault = true
+			}
+		}
+		state.Mappings = []jiraIssueFieldConfigurationSchemeMapping{}
+		for _, m := range mappings {
+			if m.IssueTypeID == "default" && !managesDefault {
+				continue
+			}
+			state.Mappings = append(state.Mappings, jiraIssueFieldConfigurationSchemeMapping{
+				IssueTypeId:          types.StringValue(m.IssueTypeID),
+				FieldConfigurationId: types.StringValue(m.FieldConfigurationID),
+			})
+		}
+	}
 	tflog.Debug(ctx, "Retrieved issue field configuration scheme from API state")
 
 	state.Name = types.StringValue(issueFieldConfigurationScheme.Values[0].Name)
@@ -193,6 +260,39 @@ func (r *jiraIssueFieldConfigurationSchemeResource) Update(ctx context.Context,
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update issue field configuration scheme, got error: %s\n%s", err, resBody))
 		return
 	}
+
+	if plan.Mappings != nil {
+		var toUnlink []string
+		for _, sm := range state.Mappings {
+			found := false
+			for _, pm := range plan.Mappings {
+				if pm.IssueTypeId.Equal(sm.IssueTypeId) {
+					found = true
+				}
+			}
+			// The default mapping cannot be removed from an issue field configuration scheme.
+			if !found && sm.IssueTypeId.ValueString() != "default" {
+				toUnlink = append(toUnlink, sm.IssueTypeId.ValueString())
+			}
+		}
+		if len(toUnlink) > 0 {
+			res, err := r.p.jira.Issue.Field.Configuration.Scheme.Unlink(ctx, id, toUnlink)
+			if err != nil {
+				var resBody string
+				if res != nil {
+					resBody = res.Bytes.String()
+				}
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove issue field configuration scheme mappings, got error: %s\n%s", err, resBody))
+				return
+			}
+		}
+		if len(plan.Mappings) > 0 {
+			if err := r.linkMappings(ctx, id, plan.Mappings); err != nil {
+				resp.Diagnostics.AddError("Client Error", err.Error())
+				return
+			}
+		}
+	}
 	tflog.Debug(ctx, "Updated issue field configuration scheme")
 
 	plan.ID = types.StringValue(state.ID.ValueString())
@@ -225,3 +325,43 @@ func (r *jiraIssueFieldConfigurationSchemeResource) Delete(ctx context.Context,
 
 	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
 }
+
+func (r *jiraIssueFieldConfigurationSchemeResource) linkMappings(ctx context.Context, id int, mappings []jiraIssueFieldConfigurationSchemeMapping) error {
+	payload := &models.FieldConfigurationToIssueTypeMappingPayloadScheme{}
+	for _, m := range mappings {
+		payload.Mappings = append(payload.Mappings, &models.FieldConfigurationToIssueTypeMappingScheme{
+			IssueTypeID:          m.IssueTypeId.ValueString(),
+			FieldConfigurationID: m.FieldConfigurationId.ValueString(),
+		})
+	}
+
+	res, err := r.p.jira.Issue.Field.Configuration.Scheme.Link(ctx, id, payload)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		return fmt.Errorf("Unable to create issue field configuration scheme mappings, got error: %s\n%s", err, resBody)
+	}
+	return nil
+}
+
+func (r *jiraIssueFieldConfigurationSchemeResource) getMappings(ctx context.Context, id int) ([]*models.FieldConfigurationIssueTypeItemScheme, error) {
+	var mappings []*models.FieldConfigurationIssueTypeItemScheme
+	startAt := 0
+	for {
+		page, res, err := r.p.jira.Issue.Field.Configuration.Scheme.Mapping(ctx, []int{id}, startAt, 50)
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			return nil, fmt.Errorf("Unable to get issue field configuration scheme mappings, got error: %s\n%s", err, resBody)
+		}
+		mappings = append(mappings, page.Values...)
+		if page.IsLast || len(page.Values) == 0 {
+			return mappings, nil
+		}
+		startAt += len(page.Values)
+	}
+}
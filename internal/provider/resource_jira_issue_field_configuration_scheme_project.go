@@ -0,0 +1,264 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraIssueFieldConfigurationSchemeProjectResource struct {
+		p atlassianProvider
+	}
+
+	jiraIssueFieldConfigurationSchemeProjectResourceModel struct {
+		ID                         types.String `tfsdk:"id"`
+		ProjectId                  types.String `tfsdk:"project_id"`
+		FieldConfigurationSchemeId types.String `tfsdk:"field_configuration_scheme_id"`
+	}
+)
+
+var (
+	_ resource.Resource                = (*jiraIssueFieldConfigurationSchemeProjectResource)(nil)
+	_ resource.ResourceWithImportState = (*jiraIssueFieldConfigurationSchemeProjectResource)(nil)
+)
+
+func NewJiraIssueFieldConfigurationSchemeProjectResource() resource.Resource {
+	return &jiraIssueFieldConfigurationSchemeProjectResource{}
+}
+
+func (*jiraIssueFieldConfigurationSchemeProjectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_issue_field_configuration_scheme_project"
+}
+
+func (*jiraIssueFieldConfigurationSchemeProjectResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Issue Field Configuration Scheme Project Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the issue field configuration scheme project association. It is the same as `project_id`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The ID of the project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"field_configuration_scheme_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the issue field configuration scheme assigned to the project.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *jiraIssueFieldConfigurationSchemeProjectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.p.jira = client
+}
+
+func (*jiraIssueFieldConfigurationSchemeProjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), req.ID)...)
+}
+
+func (r *jiraIssueFieldConfigurationSchemeProjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating issue field configuration scheme project resource")
+
+	var plan jiraIssueFieldConfigurationSchemeProjectResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded issue field configuration scheme project plan", map[string]interface{}{
+		"createPlan": fmt.Sprintf("%+v", plan),
+	})
+
+	payload := &models.FieldConfigurationSchemeAssignPayload{
+		FieldConfigurationSchemeID: plan.FieldConfigurationSchemeId.ValueString(),
+		ProjectID:                  plan.ProjectId.ValueString(),
+	}
+	res, err := r.p.jira.Issue.Field.Configuration.Scheme.Assign(ctx, payload)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to assign issue field configuration scheme to project, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Created issue field configuration scheme project in API state")
+
+	plan.ID = plan.ProjectId
+
+	tflog.Debug(ctx, "Storing issue field configuration scheme project into the state", map[string]interface{}{
+		"createNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraIssueFieldConfigurationSchemeProjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading issue field configuration scheme project resource")
+
+	var state jiraIssueFieldConfigurationSchemeProjectResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded issue field configuration scheme project from state", map[string]interface{}{
+		"readState": fmt.Sprintf("%+v", state),
+	})
+
+	projectId, err := strconv.Atoi(state.ProjectId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("project_id"), "Unable to parse value of \"project_id\" attribute.", "Value of \"project_id\" attribute can only be a numeric string.")
+		return
+	}
+
+	schemeProjects, res, err := r.p.jira.Issue.Field.Configuration.Scheme.Project(ctx, []int{projectId}, 0, 50)
+	if res != nil && res.Code == http.StatusNotFound {
+		tflog.Warn(ctx, "Unable to find issue field configuration scheme project in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get issue field configuration scheme project, got error: %s\n%s", err, resBody))
+		return
+	}
+
+	var schemeId string
+	for _, v := range schemeProjects.Values {
+		// Projects using the default issue field configuration scheme are returned without a scheme.
+		if v.FieldConfigurationScheme != nil && containsString(v.ProjectIds, state.ProjectId.ValueString()) {
+			schemeId = v.FieldConfigurationScheme.ID
+		}
+	}
+	if schemeId == "" {
+		tflog.Warn(ctx, "Unable to find issue field configuration scheme project in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	tflog.Debug(ctx, "Retrieved issue field configuration scheme project from API state")
+
+	state.ID = state.ProjectId
+	state.FieldConfigurationSchemeId = types.StringValue(schemeId)
+
+	tflog.Debug(ctx, "Storing issue field configuration scheme project into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", state),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraIssueFieldConfigurationSchemeProjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating issue field configuration scheme project resource")
+
+	var plan jiraIssueFieldConfigurationSchemeProjectResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded issue field configuration scheme project plan", map[string]interface{}{
+		"updatePlan": fmt.Sprintf("%+v", plan),
+	})
+
+	var state jiraIssueFieldConfigurationSchemeProjectResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded issue field configuration scheme project from state", map[string]interface{}{
+		"updateState": fmt.Sprintf("%+v", state),
+	})
+
+	payload := &models.FieldConfigurationSchemeAssignPayload{
+		FieldConfigurationSchemeID: plan.FieldConfigurationSchemeId.ValueString(),
+		ProjectID:                  state.ProjectId.ValueString(),
+	}
+	res, err := r.p.jira.Issue.Field.Configuration.Scheme.Assign(ctx, payload)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to assign issue field configuration scheme to project, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Updated issue field configuration scheme project in API state")
+
+	plan.ID = state.ID
+
+	tflog.Debug(ctx, "Storing issue field configuration scheme project into the state", map[string]interface{}{
+		"updateNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraIssueFieldConfigurationSchemeProjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting issue field configuration scheme project resource")
+
+	var state jiraIssueFieldConfigurationSchemeProjectResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded issue field configuration scheme project from state")
+
+	// A null scheme ID assigns the default issue field configuration scheme to the project,
+	// which models.FieldConfigurationSchemeAssignPayload cannot represent.
+	payload := map[string]interface{}{
+		"fieldConfigurationSchemeId": nil,
+		"projectId":                  state.ProjectId.ValueString(),
+	}
+	request, err := r.p.jira.NewRequest(ctx, http.MethodPut, "rest/api/3/fieldconfigurationscheme/project", "", payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create issue field configuration scheme project request, got error: %s", err))
+		return
+	}
+
+	res, err := r.p.jira.Call(request, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to unassign issue field configuration scheme from project, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Deleted issue field configuration scheme project from API state")
+
+	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
+}
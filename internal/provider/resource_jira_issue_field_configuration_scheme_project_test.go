@@ -0,0 +1,68 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraIssueFieldConfigurationSchemeProject_Basic(t *testing.T) {
+	randomKey := strings.ToUpper(acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
+	randomName := acctest.RandomWithPrefix("tf-test-issue-field-configuration-scheme-project")
+	resourceName := "atlassian_jira_issue_field_configuration_scheme_project.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIssueFieldConfigurationSchemeProjectConfig_basic(resourceName, randomKey, randomName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", "atlassian_jira_project.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "project_id", "atlassian_jira_project.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "field_configuration_scheme_id", "atlassian_jira_issue_field_configuration_scheme.first", "id"),
+				),
+			},
+			{
+				Config: testAccIssueFieldConfigurationSchemeProjectConfig_basic(resourceName, randomKey, randomName, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "field_configuration_scheme_id", "atlassian_jira_issue_field_configuration_scheme.second", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccIssueFieldConfigurationSchemeProjectConfig_basic(resourceName, key, name, scheme string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	data "atlassian_jira_myself" "test" {}
+
+	resource "atlassian_jira_project" "test" {
+		key              = %[3]q
+		name             = %[4]q
+		lead_account_id  = data.atlassian_jira_myself.test.account_id
+		project_type_key = "software"
+	}
+
+	resource "atlassian_jira_issue_field_configuration_scheme" "first" {
+		name = "%[4]s-first"
+	}
+
+	resource "atlassian_jira_issue_field_configuration_scheme" "second" {
+		name = "%[4]s-second"
+	}
+
+	resource %[1]q %[2]q {
+		project_id                    = atlassian_jira_project.test.id
+		field_configuration_scheme_id = atlassian_jira_issue_field_configuration_scheme.%[5]s.id
+	}
+	`, splits[0], splits[1], key, name, scheme)
+}
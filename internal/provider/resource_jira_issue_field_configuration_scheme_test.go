@@ -58,6 +58,34 @@ func TestAccJiraIssueFieldConfigurationScheme_Description(t *testing.T) {
 	})
 }
 
+func TestAccJiraIssueFieldConfigurationScheme_Mappings(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-issue-field-configuration-scheme")
+	resourceName := "atlassian_jira_issue_field_configuration_scheme.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIssueFieldConfigurationScheme_mappings(resourceName, randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "mappings.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "mappings.*", map[string]string{
+						"issue_type_id": "default",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "mappings.*.issue_type_id", "atlassian_jira_issue_type.test", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Mappings are only managed when set in the configuration.
+				ImportStateVerifyIgnore: []string{"mappings"},
+			},
+		},
+	})
+}
+
 func testAccIssueFieldConfigurationScheme_basic(resourceName, name string) string {
 	splits := strings.Split(resourceName, ".")
 	return fmt.Sprintf(`
@@ -76,3 +104,30 @@ func testAccIssueFieldConfigurationScheme_description(resourceName, name, descri
 	}
 	`, splits[0], splits[1], name, description)
 }
+
+func testAccIssueFieldConfigurationScheme_mappings(resourceName, name string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	resource "atlassian_jira_issue_field_configuration" "test" {
+		name = %[3]q
+	}
+
+	resource "atlassian_jira_issue_type" "test" {
+		name = %[3]q
+	}
+
+	resource %[1]q %[2]q {
+		name = %[3]q
+		mappings = [
+			{
+				issue_type_id          = "default"
+				field_configuration_id = atlassian_jira_issue_field_configuration.test.id
+			},
+			{
+				issue_type_id          = atlassian_jira_issue_type.test.id
+				field_configuration_id = atlassian_jira_issue_field_configuration.test.id
+			},
+		]
+	}
+	`, splits[0], splits[1], name)
+}
@@ -79,6 +79,12 @@ func TestAccJiraIssueFieldConfiguration_Description(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
 				),
 			},
+			{
+				Config: testAccIssueFieldConfiguration_basic(resourceName, randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+				),
+			},
 		},
 	})
 }
@@ -0,0 +1,410 @@
+package atlassian
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openscientia/terraform-provider-atlassian/internal/provider/planmodifiers/boolmodifiers"
+	"github.com/openscientia/terraform-provider-atlassian/internal/provider/planmodifiers/stringmodifiers"
+	"github.com/openscientia/terraform-provider-atlassian/internal/provider/validators"
+)
+
+type (
+	jiraIssueFieldOptionResource struct {
+		p atlassianProvider
+	}
+
+	jiraIssueFieldOptionResourceModel struct {
+		ID            types.String `tfsdk:"id"`
+		FieldKey      types.String `tfsdk:"field_key"`
+		Value         types.String `tfsdk:"value"`
+		Properties    types.String `tfsdk:"properties"`
+		ProjectIds    types.Set    `tfsdk:"project_ids"`
+		NotSelectable types.Bool   `tfsdk:"not_selectable"`
+		IsDefault     types.Bool   `tfsdk:"is_default"`
+	}
+
+	// The go-atlassian library does not support the issue field options (apps) API.
+	jiraIssueFieldOption struct {
+		ID         int64                       `json:"id,omitempty"`
+		Value      string                      `json:"value"`
+		Properties json.RawMessage             `json:"properties,omitempty"`
+		Config     *jiraIssueFieldOptionConfig `json:"config,omitempty"`
+	}
+
+	jiraIssueFieldOptionConfig struct {
+		Scope      *jiraIssueFieldOptionScope `json:"scope,omitempty"`
+		Attributes []string                   `json:"attributes"`
+	}
+
+	jiraIssueFieldOptionScope struct {
+		Projects  []int64                            `json:"projects,omitempty"`
+		Projects2 []jiraIssueFieldOptionProjectScope `json:"projects2,omitempty"`
+		Global    *struct{}                          `json:"global,omitempty"`
+	}
+
+	jiraIssueFieldOptionProjectScope struct {
+		ID int64 `json:"id"`
+	}
+)
+
+var (
+	_ resource.Resource                = (*jiraIssueFieldOptionResource)(nil)
+	_ resource.ResourceWithImportState = (*jiraIssueFieldOptionResource)(nil)
+)
+
+func NewJiraIssueFieldOptionResource() resource.Resource {
+	return &jiraIssueFieldOptionResource{}
+}
+
+func (*jiraIssueFieldOptionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_issue_field_option"
+}
+
+func (*jiraIssueFieldOptionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Issue Field Option Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the issue field option.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"field_key": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The key of the field provided by an app, in the format `$(app-key)__$(field-key)`, " +
+					"e.g. `example-app__team-field`.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "The value of the issue field option.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"properties": schema.StringAttribute{
+				MarkdownDescription: "The properties of the issue field option as a JSON object, e.g. encoded with `jsonencode`. " +
+					"Properties can be used by the app to store additional data, and can be searched with JQL. Defaults to `{}`.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					validators.Json(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringmodifiers.DefaultValue("{}"),
+				},
+			},
+			"project_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the projects the issue field option is available in. If not set, the option is available in all projects.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"not_selectable": schema.BoolAttribute{
+				MarkdownDescription: "Whether the issue field option is hidden from the options users can select. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolmodifiers.DefaultValue(false),
+				},
+			},
+			"is_default": schema.BoolAttribute{
+				MarkdownDescription: "Whether the issue field option is the default option of the field. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolmodifiers.DefaultValue(false),
+				},
+			},
+		},
+	}
+}
+
+func (r *jiraIssueFieldOptionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.p.jira = client
+}
+
+func (*jiraIssueFieldOptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "/")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: field_key/id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("field_key"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+}
+
+func (r *jiraIssueFieldOptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating issue field option resource")
+
+	var plan jiraIssueFieldOptionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded issue field option plan", map[string]interface{}{
+		"createPlan": fmt.Sprintf("%+v", plan),
+	})
+
+	payload, err := issueFieldOptionPayload(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("project_ids"), "Unable to parse value of \"project_ids\" attribute.", err.Error())
+		return
+	}
+	request, err := r.p.jira.NewRequest(ctx, http.MethodPost, fmt.Sprintf("rest/api/3/field/%s/option", url.PathEscape(plan.FieldKey.ValueString())), "", payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create issue field option request, got error: %s", err))
+		return
+	}
+	option := new(jiraIssueFieldOption)
+	res, err := r.p.jira.Call(request, option)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create issue field option, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Created issue field option")
+
+	plan.ID = types.StringValue(strconv.FormatInt(option.ID, 10))
+
+	tflog.Debug(ctx, "Storing issue field option into the state", map[string]interface{}{
+		"createNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraIssueFieldOptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading issue field option resource")
+
+	var state jiraIssueFieldOptionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded issue field option from state", map[string]interface{}{
+		"readState": fmt.Sprintf("%+v", state),
+	})
+
+	request, err := r.p.jira.NewRequest(ctx, http.MethodGet, fmt.Sprintf("rest/api/3/field/%s/option/%s", url.PathEscape(state.FieldKey.ValueString()), state.ID.ValueString()), "", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create issue field option request, got error: %s", err))
+		return
+	}
+	option := new(jiraIssueFieldOption)
+	res, err := r.p.jira.Call(request, option)
+	if res != nil && res.Code == http.StatusNotFound {
+		tflog.Warn(ctx, "Unable to find issue field option in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get issue field option, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Retrieved issue field option from API state")
+
+	properties := string(option.Properties)
+	if properties == "" || properties == "null" {
+		properties = "{}"
+	}
+	// Keep the configured formatting of the properties unless they differ semantically.
+	if !jsonEqual(state.Properties.ValueString(), properties) {
+		state.Properties = types.StringValue(properties)
+	}
+
+	var projectIds []string
+	state.NotSelectable = types.BoolValue(false)
+	state.IsDefault = types.BoolValue(false)
+	if option.Config != nil {
+		if option.Config.Scope != nil {
+			for _, p := range option.Config.Scope.Projects2 {
+				projectIds = append(projectIds, strconv.FormatInt(p.ID, 10))
+			}
+			if len(option.Config.Scope.Projects2) == 0 {
+				for _, p := range option.Config.Scope.Projects {
+					projectIds = append(projectIds, strconv.FormatInt(p, 10))
+				}
+			}
+		}
+		state.NotSelectable = types.BoolValue(containsString(option.Config.Attributes, "notSelectable"))
+		state.IsDefault = types.BoolValue(containsString(option.Config.Attributes, "defaultValue"))
+	}
+	if len(projectIds) == 0 {
+		state.ProjectIds = types.SetNull(types.StringType)
+	} else {
+		state.ProjectIds, _ = types.SetValueFrom(ctx, types.StringType, projectIds)
+	}
+	state.Value = types.StringValue(option.Value)
+
+	tflog.Debug(ctx, "Storing issue field option into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", state),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraIssueFieldOptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating issue field option resource")
+
+	var plan jiraIssueFieldOptionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded issue field option plan", map[string]interface{}{
+		"updatePlan": fmt.Sprintf("%+v", plan),
+	})
+
+	var state jiraIssueFieldOptionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded issue field option from state", map[string]interface{}{
+		"updateState": fmt.Sprintf("%+v", state),
+	})
+
+	payload, err := issueFieldOptionPayload(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("project_ids"), "Unable to parse value of \"project_ids\" attribute.", err.Error())
+		return
+	}
+	payload.ID, _ = strconv.ParseInt(state.ID.ValueString(), 10, 64)
+	request, err := r.p.jira.NewRequest(ctx, http.MethodPut, fmt.Sprintf("rest/api/3/field/%s/option/%s", url.PathEscape(plan.FieldKey.ValueString()), state.ID.ValueString()), "", payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create issue field option request, got error: %s", err))
+		return
+	}
+	res, err := r.p.jira.Call(request, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update issue field option, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Updated issue field option in API state")
+
+	plan.ID = state.ID
+
+	tflog.Debug(ctx, "Storing issue field option into the state", map[string]interface{}{
+		"updateNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraIssueFieldOptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting issue field option resource")
+
+	var state jiraIssueFieldOptionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded issue field option from state")
+
+	request, err := r.p.jira.NewRequest(ctx, http.MethodDelete, fmt.Sprintf("rest/api/3/field/%s/option/%s", url.PathEscape(state.FieldKey.ValueString()), state.ID.ValueString()), "", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create issue field option request, got error: %s", err))
+		return
+	}
+	res, err := r.p.jira.Call(request, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete issue field option, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Deleted issue field option from API state")
+
+	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
+}
+
+func issueFieldOptionPayload(ctx context.Context, m *jiraIssueFieldOptionResourceModel) (*jiraIssueFieldOption, error) {
+	var projectIds []string
+	m.ProjectIds.ElementsAs(ctx, &projectIds, false)
+	ids, err := parseJiraIds(projectIds)
+	if err != nil {
+		return nil, err
+	}
+
+	scope := &jiraIssueFieldOptionScope{}
+	if len(ids) == 0 {
+		scope.Global = &struct{}{}
+	}
+	for _, id := range ids {
+		scope.Projects2 = append(scope.Projects2, jiraIssueFieldOptionProjectScope{ID: id})
+	}
+
+	attributes := []string{}
+	if m.NotSelectable.ValueBool() {
+		attributes = append(attributes, "notSelectable")
+	}
+	if m.IsDefault.ValueBool() {
+		attributes = append(attributes, "defaultValue")
+	}
+
+	return &jiraIssueFieldOption{
+		Value:      m.Value.ValueString(),
+		Properties: json.RawMessage(m.Properties.ValueString()),
+		Config: &jiraIssueFieldOptionConfig{
+			Scope:      scope,
+			Attributes: attributes,
+		},
+	}, nil
+}
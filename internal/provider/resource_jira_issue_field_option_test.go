@@ -0,0 +1,82 @@
+package atlassian
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccJiraIssueFieldOption_Basic(t *testing.T) {
+	// Issue field options can only be managed for fields provided by an installed app.
+	fieldKey := os.Getenv("ATLASSIAN_APP_FIELD_KEY")
+	if fieldKey == "" {
+		t.Skip("ATLASSIAN_APP_FIELD_KEY must be set to run issue field option acceptance tests.")
+	}
+	randomValue := acctest.RandomWithPrefix("tf-test-option")
+	resourceName := "atlassian_jira_issue_field_option.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIssueFieldOptionConfig_basic(resourceName, fieldKey, randomValue),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "field_key", fieldKey),
+					resource.TestCheckResourceAttr(resourceName, "value", randomValue),
+					resource.TestCheckResourceAttr(resourceName, "properties", "{}"),
+					resource.TestCheckNoResourceAttr(resourceName, "project_ids"),
+					resource.TestCheckResourceAttr(resourceName, "not_selectable", "false"),
+					resource.TestCheckResourceAttr(resourceName, "is_default", "false"),
+				),
+			},
+			{
+				Config: testAccIssueFieldOptionConfig_update(resourceName, fieldKey, randomValue+"-updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "value", randomValue+"-updated"),
+					resource.TestCheckResourceAttr(resourceName, "properties", `{"team":"platform"}`),
+					resource.TestCheckResourceAttr(resourceName, "not_selectable", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccIssueFieldOptionImportConfig,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccIssueFieldOptionImportConfig(s *terraform.State) (string, error) {
+	fieldKey := s.RootModule().Resources["atlassian_jira_issue_field_option.test"].Primary.Attributes["field_key"]
+	id := s.RootModule().Resources["atlassian_jira_issue_field_option.test"].Primary.Attributes["id"]
+	return fmt.Sprintf("%s/%s", fieldKey, id), nil
+}
+
+func testAccIssueFieldOptionConfig_basic(resourceName, fieldKey, value string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		field_key = %[3]q
+		value     = %[4]q
+	}
+	`, splits[0], splits[1], fieldKey, value)
+}
+
+func testAccIssueFieldOptionConfig_update(resourceName, fieldKey, value string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		field_key      = %[3]q
+		value          = %[4]q
+		properties     = jsonencode({ team = "platform" })
+		not_selectable = true
+	}
+	`, splits[0], splits[1], fieldKey, value)
+}
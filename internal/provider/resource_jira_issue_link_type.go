@@ -0,0 +1,247 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraIssueLinkTypeResource struct {
+		p atlassianProvider
+	}
+
+	jiraIssueLinkTypeResourceModel struct {
+		ID      types.String `tfsdk:"id"`
+		Name    types.String `tfsdk:"name"`
+		Inward  types.String `tfsdk:"inward"`
+		Outward types.String `tfsdk:"outward"`
+	}
+)
+
+var (
+	_ resource.Resource                = (*jiraIssueLinkTypeResource)(nil)
+	_ resource.ResourceWithImportState = (*jiraIssueLinkTypeResource)(nil)
+)
+
+func NewJiraIssueLinkTypeResource() resource.Resource {
+	return &jiraIssueLinkTypeResource{}
+}
+
+func (*jiraIssueLinkTypeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_issue_link_type"
+}
+
+func (*jiraIssueLinkTypeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Issue Link Type Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the issue link type.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the issue link type. The name must be unique.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"inward": schema.StringAttribute{
+				MarkdownDescription: "The description of the issue link type inward link, e.g. `is blocked by`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"outward": schema.StringAttribute{
+				MarkdownDescription: "The description of the issue link type outward link, e.g. `blocks`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+		},
+	}
+}
+
+func (r *jiraIssueLinkTypeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.p.jira = client
+}
+
+func (*jiraIssueLinkTypeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *jiraIssueLinkTypeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating issue link type resource")
+
+	var plan jiraIssueLinkTypeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded issue link type plan", map[string]interface{}{
+		"createPlan": fmt.Sprintf("%+v", plan),
+	})
+
+	payload := &models.LinkTypeScheme{
+		Name:    plan.Name.ValueString(),
+		Inward:  plan.Inward.ValueString(),
+		Outward: plan.Outward.ValueString(),
+	}
+	issueLinkType, res, err := r.p.jira.Issue.Link.Type.Create(ctx, payload)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create issue link type, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Created issue link type")
+
+	plan.ID = types.StringValue(issueLinkType.ID)
+
+	tflog.Debug(ctx, "Storing issue link type into the state", map[string]interface{}{
+		"createNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraIssueLinkTypeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading issue link type resource")
+
+	var state jiraIssueLinkTypeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded issue link type from state", map[string]interface{}{
+		"readState": fmt.Sprintf("%+v", state),
+	})
+
+	issueLinkType, res, err := r.p.jira.Issue.Link.Type.Get(ctx, state.ID.ValueString())
+	if res != nil && res.Code == http.StatusNotFound {
+		tflog.Warn(ctx, "Unable to find issue link type in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get issue link type, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Retrieved issue link type from API state")
+
+	state.Name = types.StringValue(issueLinkType.Name)
+	state.Inward = types.StringValue(issueLinkType.Inward)
+	state.Outward = types.StringValue(issueLinkType.Outward)
+
+	tflog.Debug(ctx, "Storing issue link type into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", state),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraIssueLinkTypeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating issue link type resource")
+
+	var plan jiraIssueLinkTypeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded issue link type plan", map[string]interface{}{
+		"updatePlan": fmt.Sprintf("%+v", plan),
+	})
+
+	var state jiraIssueLinkTypeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded issue link type from state", map[string]interface{}{
+		"updateState": fmt.Sprintf("%+v", state),
+	})
+
+	payload := &models.LinkTypeScheme{
+		Name:    plan.Name.ValueString(),
+		Inward:  plan.Inward.ValueString(),
+		Outward: plan.Outward.ValueString(),
+	}
+	_, res, err := r.p.jira.Issue.Link.Type.Update(ctx, state.ID.ValueString(), payload)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update issue link type, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Updated issue link type in API state")
+
+	plan.ID = state.ID
+
+	tflog.Debug(ctx, "Storing issue link type into the state", map[string]interface{}{
+		"updateNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraIssueLinkTypeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting issue link type resource")
+
+	var state jiraIssueLinkTypeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded issue link type from state")
+
+	res, err := r.p.jira.Issue.Link.Type.Delete(ctx, state.ID.ValueString())
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete issue link type, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Deleted issue link type from API state")
+
+	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
+}
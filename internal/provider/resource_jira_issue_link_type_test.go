@@ -0,0 +1,54 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraIssueLinkType_Basic(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-link-type")
+	resourceName := "atlassian_jira_issue_link_type.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIssueLinkTypeConfig_basic(resourceName, randomName, "is blocked by", "blocks"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", randomName),
+					resource.TestCheckResourceAttr(resourceName, "inward", "is blocked by"),
+					resource.TestCheckResourceAttr(resourceName, "outward", "blocks"),
+				),
+			},
+			{
+				Config: testAccIssueLinkTypeConfig_basic(resourceName, randomName+"-updated", "is depended on by", "depends on"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", randomName+"-updated"),
+					resource.TestCheckResourceAttr(resourceName, "inward", "is depended on by"),
+					resource.TestCheckResourceAttr(resourceName, "outward", "depends on"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccIssueLinkTypeConfig_basic(resourceName, name, inward, outward string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		name    = %[3]q
+		inward  = %[4]q
+		outward = %[5]q
+	}
+	`, splits[0], splits[1], name, inward, outward)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 
 	jira "github.com/ctreminiom/go-atlassian/jira/v3"
 	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
@@ -12,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -27,6 +29,7 @@ type (
 		ID          types.String `tfsdk:"id"`
 		Name        types.String `tfsdk:"name"`
 		Description types.String `tfsdk:"description"`
+		Fields      types.List   `tfsdk:"fields"`
 	}
 )
 
@@ -51,10 +54,13 @@ func (*jiraIssueScreenResource) Schema(_ context.Context, _ resource.SchemaReque
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The ID of the issue screen.",
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the screen." +
-					"The name must be unique." +
+				MarkdownDescription: "The name of the screen. " +
+					"The name must be unique. " +
 					"The maximum length is 255 characters.",
 				Required: true,
 				Validators: []validator.String{
@@ -62,7 +68,7 @@ func (*jiraIssueScreenResource) Schema(_ context.Context, _ resource.SchemaReque
 				},
 			},
 			"description": schema.StringAttribute{
-				MarkdownDescription: "The description of the screen." +
+				MarkdownDescription: "The description of the screen. " +
 					"The maximum length is 255 characters.",
 				Optional: true,
 				Computed: true,
@@ -73,6 +79,13 @@ func (*jiraIssueScreenResource) Schema(_ context.Context, _ resource.SchemaReque
 					stringmodifiers.DefaultValue(""),
 				},
 			},
+			"fields": schema.ListAttribute{
+				MarkdownDescription: "The ordered list of IDs of the fields on the first tab of the screen. " +
+					"Only fields available to the screen can be added, and fields not in the list are removed from the tab. " +
+					"If not set, the fields of the screen are not managed by this resource.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
 		},
 	}
 }
@@ -124,6 +137,18 @@ func (r *jiraIssueScreenResource) Create(ctx context.Context, req resource.Creat
 
 	plan.ID = types.StringValue(strconv.Itoa(newIssueScreen.ID))
 
+	if !plan.Fields.IsNull() {
+		var fields []string
+		resp.Diagnostics.Append(plan.Fields.ElementsAs(ctx, &fields, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if err := r.updateFields(ctx, newIssueScreen.ID, fields); err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+	}
+
 	tflog.Debug(ctx, "Storing issue screen info into the state", map[string]interface{}{
 		"createNewState": fmt.Sprintf("%+v", plan),
 	})
@@ -157,6 +182,22 @@ func (r *jiraIssueScreenResource) Read(ctx context.Context, req resource.ReadReq
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get issue screen, got error: %s\n%s", err, resBody))
 		return
 	}
+	if len(issueScreen.Values) == 0 {
+		tflog.Warn(ctx, "Unable to find issue screen in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if !state.Fields.IsNull() {
+		tabId, fields, err := r.getFields(ctx, issueScreenId)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+		if tabId != 0 {
+			state.Fields, _ = types.ListValueFrom(ctx, types.StringType, fields)
+		}
+	}
 	tflog.Debug(ctx, "Retrieved issue screen from API state")
 
 	state.Name = types.StringValue(issueScreen.Values[0].Name)
@@ -199,12 +240,25 @@ func (r *jiraIssueScreenResource) Update(ctx context.Context, req resource.Updat
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update issue screen, got error: %s\n%s", err, resBody))
 		return
 	}
+
+	if !plan.Fields.IsNull() {
+		var fields []string
+		resp.Diagnostics.Append(plan.Fields.ElementsAs(ctx, &fields, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if err := r.updateFields(ctx, issueScreenId, fields); err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+	}
 	tflog.Debug(ctx, "Updated issue screen in API state")
 
 	var updatedState = jiraIssueScreenResourceModel{
 		ID:          types.StringValue(state.ID.ValueString()),
 		Name:        types.StringValue(plan.Name.ValueString()),
 		Description: types.StringValue(plan.Description.ValueString()),
+		Fields:      plan.Fields,
 	}
 
 	tflog.Debug(ctx, "Storing issue screen info into the state")
@@ -235,3 +289,111 @@ func (r *jiraIssueScreenResource) Delete(ctx context.Context, req resource.Delet
 
 	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
 }
+
+// getFields returns the ID of the first tab of the screen and the IDs of the fields on it.
+func (r *jiraIssueScreenResource) getFields(ctx context.Context, screenId int) (int, []string, error) {
+	tabs, res, err := r.p.jira.Screen.Tab.Gets(ctx, screenId, "")
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		return 0, nil, fmt.Errorf("Unable to get issue screen tabs, got error: %s\n%s", err, resBody)
+	}
+	if len(tabs) == 0 {
+		return 0, nil, nil
+	}
+
+	tabFields, res, err := r.p.jira.Screen.Tab.Field.Gets(ctx, screenId, tabs[0].ID)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		return 0, nil, fmt.Errorf("Unable to get issue screen tab fields, got error: %s\n%s", err, resBody)
+	}
+	fields := []string{}
+	for _, f := range tabFields {
+		fields = append(fields, f.ID)
+	}
+	return tabs[0].ID, fields, nil
+}
+
+// updateFields adds and removes fields on the first tab of the screen and moves them into the order of fields.
+func (r *jiraIssueScreenResource) updateFields(ctx context.Context, screenId int, fields []string) error {
+	tabId, currentFields, err := r.getFields(ctx, screenId)
+	if err != nil {
+		return err
+	}
+	if tabId == 0 {
+		tab, res, err := r.p.jira.Screen.Tab.Create(ctx, screenId, "Field Tab")
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			return fmt.Errorf("Unable to create issue screen tab, got error: %s\n%s", err, resBody)
+		}
+		tabId = tab.ID
+	}
+
+	toAdd := subtractStrings(fields, currentFields)
+	if len(toAdd) > 0 {
+		availableFields, res, err := r.p.jira.Screen.Available(ctx, screenId)
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			return fmt.Errorf("Unable to get available issue screen fields, got error: %s\n%s", err, resBody)
+		}
+		var available []string
+		for _, f := range availableFields {
+			available = append(available, f.ID)
+		}
+		if unavailable := subtractStrings(toAdd, available); len(unavailable) > 0 {
+			return fmt.Errorf("Unable to add fields to issue screen, the following fields are not available to the screen: %s", strings.Join(unavailable, ", "))
+		}
+	}
+
+	for _, f := range subtractStrings(currentFields, fields) {
+		res, err := r.p.jira.Screen.Tab.Field.Remove(ctx, screenId, tabId, f)
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			return fmt.Errorf("Unable to remove field %s from issue screen, got error: %s\n%s", f, err, resBody)
+		}
+	}
+
+	for _, f := range toAdd {
+		_, res, err := r.p.jira.Screen.Tab.Field.Add(ctx, screenId, tabId, f)
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			return fmt.Errorf("Unable to add field %s to issue screen, got error: %s\n%s", f, err, resBody)
+		}
+	}
+
+	// Fields are appended to the tab, so they only need to be moved when the resulting order differs.
+	order := append(subtractStrings(currentFields, subtractStrings(currentFields, fields)), toAdd...)
+	if strings.Join(order, ",") == strings.Join(fields, ",") {
+		return nil
+	}
+	for i, f := range fields {
+		var after, position string
+		if i == 0 {
+			position = "First"
+		} else {
+			after = fields[i-1]
+		}
+		if err := moveScreenTabField(ctx, r.p.jira, screenId, tabId, f, after, position); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
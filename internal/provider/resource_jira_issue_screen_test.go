@@ -5,6 +5,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
@@ -42,6 +43,34 @@ func TestAccJiraIssueScreen_Basic(t *testing.T) {
 	})
 }
 
+func TestAccJiraIssueScreen_Fields(t *testing.T) {
+	resourceName := "atlassian_jira_issue_screen.test"
+	randomName := acctest.RandomWithPrefix("tf-test-issue-screen")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJiraIssueScreenConfig_fields(resourceName, randomName, `"summary", "description"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "fields.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "fields.0", "summary"),
+					resource.TestCheckResourceAttr(resourceName, "fields.1", "description"),
+				),
+			},
+			{
+				Config: testAccJiraIssueScreenConfig_fields(resourceName, randomName, `"assignee", "summary"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "fields.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "fields.0", "assignee"),
+					resource.TestCheckResourceAttr(resourceName, "fields.1", "summary"),
+				),
+			},
+		},
+	})
+}
+
 func testAccJiraIssueScreenConfig_basic(resource_name, name string) string {
 	splits := strings.Split(resource_name, ".")
 	return fmt.Sprintf(
@@ -50,3 +79,13 @@ func testAccJiraIssueScreenConfig_basic(resource_name, name string) string {
 		}`, splits[0], splits[1], name,
 	)
 }
+
+func testAccJiraIssueScreenConfig_fields(resource_name, name, fields string) string {
+	splits := strings.Split(resource_name, ".")
+	return fmt.Sprintf(
+		`resource %[1]q %[2]q {
+			name   = %[3]q
+			fields = [%[4]s]
+		}`, splits[0], splits[1], name, fields,
+	)
+}
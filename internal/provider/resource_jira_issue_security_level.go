@@ -0,0 +1,596 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openscientia/terraform-provider-atlassian/internal/provider/planmodifiers/boolmodifiers"
+	"github.com/openscientia/terraform-provider-atlassian/internal/provider/planmodifiers/stringmodifiers"
+)
+
+type (
+	jiraIssueSecurityLevelResource struct {
+		p atlassianProvider
+	}
+
+	jiraIssueSecurityLevelResourceModel struct {
+		ID          types.String                        `tfsdk:"id"`
+		SchemeId    types.String                        `tfsdk:"scheme_id"`
+		Name        types.String                        `tfsdk:"name"`
+		Description types.String                        `tfsdk:"description"`
+		IsDefault   types.Bool                          `tfsdk:"is_default"`
+		Members     []jiraIssueSecurityLevelMemberModel `tfsdk:"members"`
+	}
+
+	jiraIssueSecurityLevelMemberModel struct {
+		Type      types.String `tfsdk:"type"`
+		Parameter types.String `tfsdk:"parameter"`
+	}
+
+	// The go-atlassian library does not support issue security levels.
+	jiraIssueSecurityLevel struct {
+		ID                    string `json:"id"`
+		Name                  string `json:"name"`
+		Description           string `json:"description"`
+		IsDefault             bool   `json:"isDefault"`
+		IssueSecuritySchemeID string `json:"issueSecuritySchemeId"`
+	}
+
+	jiraIssueSecurityLevelMember struct {
+		ID     string `json:"id"`
+		Holder struct {
+			Type      string `json:"type"`
+			Parameter string `json:"parameter"`
+			Value     string `json:"value"`
+		} `json:"holder"`
+	}
+)
+
+var (
+	_ resource.Resource                = (*jiraIssueSecurityLevelResource)(nil)
+	_ resource.ResourceWithImportState = (*jiraIssueSecurityLevelResource)(nil)
+)
+
+var security_level_member_types = []string{
+	"applicationRole", "assignee", "group", "groupCustomField", "projectLead",
+	"projectRole", "reporter", "user", "userCustomField",
+}
+
+func NewJiraIssueSecurityLevelResource() resource.Resource {
+	return &jiraIssueSecurityLevelResource{}
+}
+
+func (*jiraIssueSecurityLevelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_issue_security_level"
+}
+
+func (*jiraIssueSecurityLevelResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Issue Security Level Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the issue security level.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"scheme_id": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The ID of the issue security scheme.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the issue security level. " +
+					"The name must be unique within the issue security scheme. " +
+					"The maximum length is 255 characters.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the issue security level. " +
+					"The maximum length is 255 characters.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringmodifiers.DefaultValue(""),
+				},
+			},
+			"is_default": schema.BoolAttribute{
+				MarkdownDescription: "Whether the issue security level is the default level of the issue security scheme. " +
+					"Do not use together with the `default_level_id` attribute of `atlassian_jira_issue_security_scheme`.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolmodifiers.DefaultValue(false),
+				},
+			},
+			"members": schema.SetNestedAttribute{
+				MarkdownDescription: "The users, groups, fields or roles that can see issues with the issue security level. " +
+					"If not set, the members are not managed by this resource.",
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of member. " +
+								"Can be one of: `applicationRole`, `assignee`, `group`, `groupCustomField`, " +
+								"`projectLead`, `projectRole`, `reporter`, `user` or `userCustomField`.",
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(security_level_member_types...),
+							},
+						},
+						"parameter": schema.StringAttribute{
+							MarkdownDescription: "The identifier associated with the `type` value that defines the member, " +
+								"e.g. the group ID, the project role ID or the user account ID.",
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringmodifiers.DefaultValue(""),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *jiraIssueSecurityLevelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.p.jira = client
+}
+
+func (*jiraIssueSecurityLevelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "/")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: scheme_id/id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("scheme_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+}
+
+func (r *jiraIssueSecurityLevelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating issue security level resource")
+
+	var plan jiraIssueSecurityLevelResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded issue security level plan", map[string]interface{}{
+		"createPlan": fmt.Sprintf("%+v", plan),
+	})
+
+	if !validateIssueSecurityLevelMembers(plan.Members, resp.Diagnostics.AddAttributeError) {
+		return
+	}
+
+	level := map[string]interface{}{
+		"name":        plan.Name.ValueString(),
+		"description": plan.Description.ValueString(),
+		"isDefault":   plan.IsDefault.ValueBool(),
+	}
+	if len(plan.Members) > 0 {
+		level["members"] = issueSecurityLevelMembersPayload(plan.Members)
+	}
+	payload := map[string]interface{}{
+		"levels": []interface{}{level},
+	}
+	request, err := r.p.jira.NewRequest(ctx, http.MethodPut, fmt.Sprintf("rest/api/3/issuesecurityschemes/%s/level", plan.SchemeId.ValueString()), "", payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create issue security level request, got error: %s", err))
+		return
+	}
+	res, err := r.p.jira.Call(request, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create issue security level, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Created issue security level")
+
+	// The API does not return the ID of the new level, so it has to be looked up by its unique name.
+	levels, err := getIssueSecurityLevels(ctx, r.p.jira, plan.SchemeId.ValueString(), "")
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	for _, l := range levels {
+		if l.Name == plan.Name.ValueString() {
+			plan.ID = types.StringValue(l.ID)
+			break
+		}
+	}
+	if plan.ID.IsUnknown() {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find issue security level %q in issue security scheme %s", plan.Name.ValueString(), plan.SchemeId.ValueString()))
+		return
+	}
+
+	tflog.Debug(ctx, "Storing issue security level into the state", map[string]interface{}{
+		"createNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraIssueSecurityLevelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading issue security level resource")
+
+	var state jiraIssueSecurityLevelResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded issue security level from state", map[string]interface{}{
+		"readState": fmt.Sprintf("%+v", state),
+	})
+
+	levels, err := getIssueSecurityLevels(ctx, r.p.jira, state.SchemeId.ValueString(), state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	if len(levels) == 0 {
+		tflog.Warn(ctx, "Unable to find issue security level in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	tflog.Debug(ctx, "Retrieved issue security level from API state")
+
+	state.Name = types.StringValue(levels[0].Name)
+	state.Description = types.StringValue(levels[0].Description)
+	state.IsDefault = types.BoolValue(levels[0].IsDefault)
+
+	if state.Members != nil {
+		members, err := getIssueSecurityLevelMembers(ctx, r.p.jira, state.SchemeId.ValueString(), state.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+		newMembers := []jiraIssueSecurityLevelMemberModel{}
+		for _, m := range members {
+			newMembers = append(newMembers, jiraIssueSecurityLevelMemberModel{
+				Type:      types.StringValue(m.Holder.Type),
+				Parameter: types.StringValue(issueSecurityLevelMemberParameter(state.Members, m)),
+			})
+		}
+		state.Members = newMembers
+	}
+
+	tflog.Debug(ctx, "Storing issue security level into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", state),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraIssueSecurityLevelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating issue security level resource")
+
+	var plan jiraIssueSecurityLevelResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded issue security level plan", map[string]interface{}{
+		"updatePlan": fmt.Sprintf("%+v", plan),
+	})
+
+	var state jiraIssueSecurityLevelResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded issue security level from state", map[string]interface{}{
+		"updateState": fmt.Sprintf("%+v", state),
+	})
+
+	if !validateIssueSecurityLevelMembers(plan.Members, resp.Diagnostics.AddAttributeError) {
+		return
+	}
+
+	schemeId, levelId := state.SchemeId.ValueString(), state.ID.ValueString()
+
+	if !plan.Name.Equal(state.Name) || !plan.Description.Equal(state.Description) {
+		payload := map[string]interface{}{
+			"name":        plan.Name.ValueString(),
+			"description": plan.Description.ValueString(),
+		}
+		request, err := r.p.jira.NewRequest(ctx, http.MethodPatch, fmt.Sprintf("rest/api/3/issuesecurityschemes/%s/level/%s", schemeId, levelId), "", payload)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create issue security level request, got error: %s", err))
+			return
+		}
+		res, err := r.p.jira.Call(request, nil)
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update issue security level, got error: %s\n%s", err, resBody))
+			return
+		}
+	}
+
+	if !plan.IsDefault.Equal(state.IsDefault) {
+		defaultLevelId := ""
+		if plan.IsDefault.ValueBool() {
+			defaultLevelId = levelId
+		}
+		if err := setIssueSecuritySchemeDefaultLevel(ctx, r.p.jira, schemeId, defaultLevelId); err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+	}
+
+	if plan.Members != nil {
+		members, err := getIssueSecurityLevelMembers(ctx, r.p.jira, schemeId, levelId)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+
+		for _, m := range members {
+			if containsIssueSecurityLevelMember(plan.Members, m.Holder.Type, issueSecurityLevelMemberParameter(plan.Members, m)) {
+				continue
+			}
+			request, err := r.p.jira.NewRequest(ctx, http.MethodDelete, fmt.Sprintf("rest/api/3/issuesecurityschemes/%s/level/%s/member/%s", schemeId, levelId, m.ID), "", nil)
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create issue security level request, got error: %s", err))
+				return
+			}
+			res, err := r.p.jira.Call(request, nil)
+			if err != nil {
+				var resBody string
+				if res != nil {
+					resBody = res.Bytes.String()
+				}
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove issue security level member, got error: %s\n%s", err, resBody))
+				return
+			}
+		}
+
+		var toAdd []jiraIssueSecurityLevelMemberModel
+		for _, pm := range plan.Members {
+			found := false
+			for _, m := range members {
+				if m.Holder.Type == pm.Type.ValueString() && issueSecurityLevelMemberParameter(plan.Members, m) == pm.Parameter.ValueString() {
+					found = true
+					break
+				}
+			}
+			if !found {
+				toAdd = append(toAdd, pm)
+			}
+		}
+		if len(toAdd) > 0 {
+			payload := map[string]interface{}{
+				"members": issueSecurityLevelMembersPayload(toAdd),
+			}
+			request, err := r.p.jira.NewRequest(ctx, http.MethodPut, fmt.Sprintf("rest/api/3/issuesecurityschemes/%s/level/%s/member", schemeId, levelId), "", payload)
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create issue security level request, got error: %s", err))
+				return
+			}
+			res, err := r.p.jira.Call(request, nil)
+			if err != nil {
+				var resBody string
+				if res != nil {
+					resBody = res.Bytes.String()
+				}
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add issue security level members, got error: %s\n%s", err, resBody))
+				return
+			}
+		}
+	}
+	tflog.Debug(ctx, "Updated issue security level in API state")
+
+	plan.ID = state.ID
+
+	tflog.Debug(ctx, "Storing issue security level into the state", map[string]interface{}{
+		"updateNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraIssueSecurityLevelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting issue security level resource")
+
+	var state jiraIssueSecurityLevelResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded issue security level from state")
+
+	request, err := r.p.jira.NewRequest(ctx, http.MethodDelete, fmt.Sprintf("rest/api/3/issuesecurityschemes/%s/level/%s", state.SchemeId.ValueString(), state.ID.ValueString()), "", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create issue security level request, got error: %s", err))
+		return
+	}
+	res, err := r.p.jira.Call(request, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete issue security level, got error: %s\n%s", err, resBody))
+		return
+	}
+
+	// Removing an issue security level is an asynchronous operation.
+	if taskId := taskIdFromResponse(res); taskId != "" {
+		if err := waitForJiraTask(ctx, r.p.jira, taskId); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete issue security level, got error: %s", err))
+			return
+		}
+	}
+	tflog.Debug(ctx, "Deleted issue security level from API state")
+
+	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
+}
+
+// getIssueSecurityLevels returns the issue security levels of a scheme, optionally filtered by level ID.
+func getIssueSecurityLevels(ctx context.Context, client *jira.Client, schemeId, levelId string) ([]jiraIssueSecurityLevel, error) {
+	var levels []jiraIssueSecurityLevel
+	startAt := 0
+	for {
+		params := url.Values{}
+		params.Add("startAt", strconv.Itoa(startAt))
+		params.Add("maxResults", "50")
+		params.Add("schemeId", schemeId)
+		if levelId != "" {
+			params.Add("id", levelId)
+		}
+		request, err := client.NewRequest(ctx, http.MethodGet, fmt.Sprintf("rest/api/3/issuesecurityschemes/level?%s", params.Encode()), "", nil)
+		if err != nil {
+			return nil, fmt.Errorf("Unable to create issue security level request, got error: %s", err)
+		}
+		page := new(struct {
+			IsLast bool                     `json:"isLast"`
+			Values []jiraIssueSecurityLevel `json:"values"`
+		})
+		res, err := client.Call(request, page)
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			return nil, fmt.Errorf("Unable to get issue security levels, got error: %s\n%s", err, resBody)
+		}
+		levels = append(levels, page.Values...)
+		if page.IsLast || len(page.Values) == 0 {
+			break
+		}
+		startAt += len(page.Values)
+	}
+	return levels, nil
+}
+
+// getIssueSecurityLevelMembers returns all members of an issue security level.
+func getIssueSecurityLevelMembers(ctx context.Context, client *jira.Client, schemeId, levelId string) ([]jiraIssueSecurityLevelMember, error) {
+	var members []jiraIssueSecurityLevelMember
+	startAt := 0
+	for {
+		params := url.Values{}
+		params.Add("startAt", strconv.Itoa(startAt))
+		params.Add("maxResults", "50")
+		params.Add("schemeId", schemeId)
+		params.Add("levelId", levelId)
+		request, err := client.NewRequest(ctx, http.MethodGet, fmt.Sprintf("rest/api/3/issuesecurityschemes/level/member?%s", params.Encode()), "", nil)
+		if err != nil {
+			return nil, fmt.Errorf("Unable to create issue security level request, got error: %s", err)
+		}
+		page := new(struct {
+			IsLast bool                           `json:"isLast"`
+			Values []jiraIssueSecurityLevelMember `json:"values"`
+		})
+		res, err := client.Call(request, page)
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			return nil, fmt.Errorf("Unable to get issue security level members, got error: %s\n%s", err, resBody)
+		}
+		members = append(members, page.Values...)
+		if page.IsLast || len(page.Values) == 0 {
+			break
+		}
+		startAt += len(page.Values)
+	}
+	return members, nil
+}
+
+// issueSecurityLevelMemberParameter returns the parameter of a member as it is configured.
+// Depending on the member type, the API may return the identifier either as the holder parameter or as the holder value.
+func issueSecurityLevelMemberParameter(configured []jiraIssueSecurityLevelMemberModel, member jiraIssueSecurityLevelMember) string {
+	if member.Holder.Value != "" && containsIssueSecurityLevelMember(configured, member.Holder.Type, member.Holder.Value) {
+		return member.Holder.Value
+	}
+	return member.Holder.Parameter
+}
+
+func containsIssueSecurityLevelMember(members []jiraIssueSecurityLevelMemberModel, memberType, parameter string) bool {
+	for _, m := range members {
+		if m.Type.ValueString() == memberType && m.Parameter.ValueString() == parameter {
+			return true
+		}
+	}
+	return false
+}
+
+func issueSecurityLevelMembersPayload(members []jiraIssueSecurityLevelMemberModel) []interface{} {
+	var payload []interface{}
+	for _, m := range members {
+		member := map[string]interface{}{
+			"type": m.Type.ValueString(),
+		}
+		if m.Parameter.ValueString() != "" {
+			member["parameter"] = m.Parameter.ValueString()
+		}
+		payload = append(payload, member)
+	}
+	return payload
+}
+
+// validateIssueSecurityLevelMembers checks that a parameter is provided for the member types that require one.
+func validateIssueSecurityLevelMembers(members []jiraIssueSecurityLevelMemberModel, addError func(path.Path, string, string)) bool {
+	valid := true
+	for _, m := range members {
+		switch m.Type.ValueString() {
+		case "assignee", "projectLead", "reporter":
+			continue
+		}
+		if m.Parameter.ValueString() == "" {
+			addError(path.Root("members"),
+				"Failed to provide a value for \"members.parameter\" attribute",
+				fmt.Sprintf("Value must be provided if \"members.type\" is: %s", m.Type.ValueString()))
+			valid = false
+		}
+	}
+	return valid
+}
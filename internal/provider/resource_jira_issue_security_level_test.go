@@ -0,0 +1,121 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccJiraIssueSecurityLevel_Basic(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-security")
+	resourceName := "atlassian_jira_issue_security_level.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIssueSecurityLevelConfig_basic(resourceName, randomName, "foo", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "scheme_id", "atlassian_jira_issue_security_scheme.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", "foo"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "is_default", "false"),
+					resource.TestCheckNoResourceAttr(resourceName, "members"),
+				),
+			},
+			{
+				Config: testAccIssueSecurityLevelConfig_basic(resourceName, randomName, "bar", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "bar"),
+					resource.TestCheckResourceAttr(resourceName, "is_default", "true"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccIssueSecurityLevelImportConfig,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccJiraIssueSecurityLevel_Members(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-security")
+	resourceName := "atlassian_jira_issue_security_level.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIssueSecurityLevelConfig_members(resourceName, randomName, `{ type = "reporter" }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "members.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "members.*", map[string]string{
+						"type":      "reporter",
+						"parameter": "",
+					}),
+				),
+			},
+			{
+				Config: testAccIssueSecurityLevelConfig_members(resourceName, randomName, `{ type = "assignee" }, { type = "projectLead" }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "members.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "members.*", map[string]string{
+						"type": "assignee",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "members.*", map[string]string{
+						"type": "projectLead",
+					}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccIssueSecurityLevelImportConfig,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"members"},
+			},
+		},
+	})
+}
+
+func testAccIssueSecurityLevelImportConfig(s *terraform.State) (string, error) {
+	attributes := s.RootModule().Resources["atlassian_jira_issue_security_level.test"].Primary.Attributes
+	return fmt.Sprintf("%s/%s", attributes["scheme_id"], attributes["id"]), nil
+}
+
+func testAccIssueSecurityLevelConfig_basic(resourceName, schemeName, name string, isDefault bool) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	resource "atlassian_jira_issue_security_scheme" "test" {
+		name = %[3]q
+	}
+
+	resource %[1]q %[2]q {
+		scheme_id  = atlassian_jira_issue_security_scheme.test.id
+		name       = %[4]q
+		is_default = %[5]t
+	}
+	`, splits[0], splits[1], schemeName, name, isDefault)
+}
+
+func testAccIssueSecurityLevelConfig_members(resourceName, schemeName, members string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	resource "atlassian_jira_issue_security_scheme" "test" {
+		name = %[3]q
+	}
+
+	resource %[1]q %[2]q {
+		scheme_id = atlassian_jira_issue_security_scheme.test.id
+		name      = "foo"
+		members   = [%[4]s]
+	}
+	`, splits[0], splits[1], schemeName, members)
+}
@@ -0,0 +1,339 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openscientia/terraform-provider-atlassian/internal/provider/planmodifiers/stringmodifiers"
+)
+
+type (
+	jiraIssueSecuritySchemeResource struct {
+		p atlassianProvider
+	}
+
+	jiraIssueSecuritySchemeResourceModel struct {
+		ID             types.String `tfsdk:"id"`
+		Name           types.String `tfsdk:"name"`
+		Description    types.String `tfsdk:"description"`
+		DefaultLevelId types.String `tfsdk:"default_level_id"`
+	}
+
+	// The go-atlassian library does not support issue security schemes.
+	jiraIssueSecurityScheme struct {
+		ID                     int64  `json:"id"`
+		Name                   string `json:"name"`
+		Description            string `json:"description"`
+		DefaultSecurityLevelID int64  `json:"defaultSecurityLevelId"`
+	}
+)
+
+var (
+	_ resource.Resource                = (*jiraIssueSecuritySchemeResource)(nil)
+	_ resource.ResourceWithImportState = (*jiraIssueSecuritySchemeResource)(nil)
+)
+
+func NewJiraIssueSecuritySchemeResource() resource.Resource {
+	return &jiraIssueSecuritySchemeResource{}
+}
+
+func (*jiraIssueSecuritySchemeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_issue_security_scheme"
+}
+
+func (*jiraIssueSecuritySchemeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Issue Security Scheme Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the issue security scheme.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the issue security scheme. " +
+					"The name must be unique. " +
+					"The maximum length is 60 characters.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 60),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the issue security scheme. " +
+					"The maximum length is 255 characters.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringmodifiers.DefaultValue(""),
+				},
+			},
+			"default_level_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the default issue security level of the issue security scheme. " +
+					"The level must belong to the issue security scheme. " +
+					"If not set, the default level is not managed by this resource.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *jiraIssueSecuritySchemeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.p.jira = client
+}
+
+func (*jiraIssueSecuritySchemeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *jiraIssueSecuritySchemeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating issue security scheme resource")
+
+	var plan jiraIssueSecuritySchemeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded issue security scheme plan", map[string]interface{}{
+		"createPlan": fmt.Sprintf("%+v", plan),
+	})
+
+	payload := map[string]interface{}{
+		"name":        plan.Name.ValueString(),
+		"description": plan.Description.ValueString(),
+	}
+	request, err := r.p.jira.NewRequest(ctx, http.MethodPost, "rest/api/3/issuesecurityschemes", "", payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create issue security scheme request, got error: %s", err))
+		return
+	}
+	created := new(struct {
+		ID string `json:"id"`
+	})
+	res, err := r.p.jira.Call(request, created)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create issue security scheme, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Created issue security scheme")
+
+	plan.ID = types.StringValue(created.ID)
+
+	// A new issue security scheme has no levels, so there is no default level unless it is set.
+	if plan.DefaultLevelId.IsUnknown() {
+		plan.DefaultLevelId = types.StringNull()
+	} else if !plan.DefaultLevelId.IsNull() {
+		if err := setIssueSecuritySchemeDefaultLevel(ctx, r.p.jira, created.ID, plan.DefaultLevelId.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "Storing issue security scheme into the state", map[string]interface{}{
+		"createNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraIssueSecuritySchemeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading issue security scheme resource")
+
+	var state jiraIssueSecuritySchemeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded issue security scheme from state", map[string]interface{}{
+		"readState": fmt.Sprintf("%+v", state),
+	})
+
+	request, err := r.p.jira.NewRequest(ctx, http.MethodGet, fmt.Sprintf("rest/api/3/issuesecurityschemes/%s", state.ID.ValueString()), "", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create issue security scheme request, got error: %s", err))
+		return
+	}
+	issueSecurityScheme := new(jiraIssueSecurityScheme)
+	res, err := r.p.jira.Call(request, issueSecurityScheme)
+	if res != nil && res.Code == http.StatusNotFound {
+		tflog.Warn(ctx, "Unable to find issue security scheme in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get issue security scheme, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Retrieved issue security scheme from API state")
+
+	state.Name = types.StringValue(issueSecurityScheme.Name)
+	state.Description = types.StringValue(issueSecurityScheme.Description)
+	if issueSecurityScheme.DefaultSecurityLevelID > 0 {
+		state.DefaultLevelId = types.StringValue(fmt.Sprintf("%d", issueSecurityScheme.DefaultSecurityLevelID))
+	} else {
+		state.DefaultLevelId = types.StringNull()
+	}
+
+	tflog.Debug(ctx, "Storing issue security scheme into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", state),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraIssueSecuritySchemeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating issue security scheme resource")
+
+	var plan jiraIssueSecuritySchemeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded issue security scheme plan", map[string]interface{}{
+		"updatePlan": fmt.Sprintf("%+v", plan),
+	})
+
+	var state jiraIssueSecuritySchemeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded issue security scheme from state", map[string]interface{}{
+		"updateState": fmt.Sprintf("%+v", state),
+	})
+
+	if !plan.Name.Equal(state.Name) || !plan.Description.Equal(state.Description) {
+		payload := map[string]interface{}{
+			"name":        plan.Name.ValueString(),
+			"description": plan.Description.ValueString(),
+		}
+		request, err := r.p.jira.NewRequest(ctx, http.MethodPut, fmt.Sprintf("rest/api/3/issuesecurityschemes/%s", state.ID.ValueString()), "", payload)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create issue security scheme request, got error: %s", err))
+			return
+		}
+		res, err := r.p.jira.Call(request, nil)
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update issue security scheme, got error: %s\n%s", err, resBody))
+			return
+		}
+	}
+
+	if plan.DefaultLevelId.IsUnknown() {
+		plan.DefaultLevelId = state.DefaultLevelId
+	} else if !plan.DefaultLevelId.Equal(state.DefaultLevelId) {
+		if err := setIssueSecuritySchemeDefaultLevel(ctx, r.p.jira, state.ID.ValueString(), plan.DefaultLevelId.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+	}
+	tflog.Debug(ctx, "Updated issue security scheme in API state")
+
+	plan.ID = state.ID
+
+	tflog.Debug(ctx, "Storing issue security scheme into the state", map[string]interface{}{
+		"updateNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraIssueSecuritySchemeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting issue security scheme resource")
+
+	var state jiraIssueSecuritySchemeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded issue security scheme from state")
+
+	request, err := r.p.jira.NewRequest(ctx, http.MethodDelete, fmt.Sprintf("rest/api/3/issuesecurityschemes/%s", state.ID.ValueString()), "", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create issue security scheme request, got error: %s", err))
+		return
+	}
+	res, err := r.p.jira.Call(request, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete issue security scheme, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Deleted issue security scheme from API state")
+
+	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
+}
+
+// setIssueSecuritySchemeDefaultLevel sets the default issue security level of the scheme. An empty level ID removes the default level.
+func setIssueSecuritySchemeDefaultLevel(ctx context.Context, client *jira.Client, schemeId, levelId string) error {
+	defaultValue := map[string]interface{}{
+		"issueSecuritySchemeId": schemeId,
+		"defaultLevelId":        nil,
+	}
+	if levelId != "" {
+		defaultValue["defaultLevelId"] = levelId
+	}
+	payload := map[string]interface{}{
+		"defaultValues": []interface{}{defaultValue},
+	}
+	request, err := client.NewRequest(ctx, http.MethodPut, "rest/api/3/issuesecurityschemes/level/default", "", payload)
+	if err != nil {
+		return fmt.Errorf("Unable to create issue security scheme request, got error: %s", err)
+	}
+	res, err := client.Call(request, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		return fmt.Errorf("Unable to set default issue security level, got error: %s\n%s", err, resBody)
+	}
+	return nil
+}
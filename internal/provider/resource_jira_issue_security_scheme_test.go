@@ -0,0 +1,52 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraIssueSecurityScheme_Basic(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-security")
+	resourceName := "atlassian_jira_issue_security_scheme.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIssueSecuritySchemeConfig_basic(resourceName, randomName, "foo"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", randomName),
+					resource.TestCheckResourceAttr(resourceName, "description", "foo"),
+					resource.TestCheckNoResourceAttr(resourceName, "default_level_id"),
+				),
+			},
+			{
+				Config: testAccIssueSecuritySchemeConfig_basic(resourceName, randomName+"-updated", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", randomName+"-updated"),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccIssueSecuritySchemeConfig_basic(resourceName, name, description string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		name        = %[3]q
+		description = %[4]q
+	}
+	`, splits[0], splits[1], name, description)
+}
@@ -0,0 +1,134 @@
+package atlassian
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraIssue_Basic(t *testing.T) {
+	randomKey := strings.ToUpper(acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
+	randomName := acctest.RandomWithPrefix("tf-test-issue")
+	resourceName := "atlassian_jira_issue.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIssueConfig_basic(resourceName, randomKey, randomName, randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestMatchResourceAttr(resourceName, "key", regexp.MustCompile(fmt.Sprintf("^%s-[0-9]+$", randomKey))),
+					resource.TestCheckResourceAttrPair(resourceName, "project_id", "atlassian_jira_project.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "issue_type_id", "atlassian_jira_issue_type.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "summary", randomName),
+					resource.TestCheckNoResourceAttr(resourceName, "description"),
+					resource.TestCheckNoResourceAttr(resourceName, "labels"),
+				),
+			},
+			{
+				Config: testAccIssueConfig_basic(resourceName, randomKey, randomName, randomName+"2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "summary", randomName+"2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccJiraIssue_DescriptionAndLabels(t *testing.T) {
+	randomKey := strings.ToUpper(acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
+	randomName := acctest.RandomWithPrefix("tf-test-issue")
+	resourceName := "atlassian_jira_issue.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIssueConfig_descriptionAndLabels(resourceName, randomKey, randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "description"),
+					resource.TestCheckResourceAttr(resourceName, "labels.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "labels.*", "infrastructure"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "labels.*", "terraform"),
+				),
+			},
+			{
+				Config: testAccIssueConfig_basic(resourceName, randomKey, randomName, randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(resourceName, "description"),
+					resource.TestCheckNoResourceAttr(resourceName, "labels"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIssueConfig_project(key, name string) string {
+	return fmt.Sprintf(`
+	data "atlassian_jira_myself" "test" {}
+
+	resource "atlassian_jira_project" "test" {
+		key              = %[1]q
+		name             = %[2]q
+		lead_account_id  = data.atlassian_jira_myself.test.account_id
+		project_type_key = "software"
+	}
+
+	resource "atlassian_jira_issue_type" "test" {
+		name = %[2]q
+	}
+
+	resource "atlassian_jira_issue_type_scheme" "test" {
+		name           = %[2]q
+		issue_type_ids = [atlassian_jira_issue_type.test.id]
+	}
+
+	resource "atlassian_jira_issue_type_scheme_project" "test" {
+		project_id           = atlassian_jira_project.test.id
+		issue_type_scheme_id = atlassian_jira_issue_type_scheme.test.id
+	}
+	`, key, name)
+}
+
+func testAccIssueConfig_basic(resourceName, key, name, summary string) string {
+	splits := strings.Split(resourceName, ".")
+	return testAccIssueConfig_project(key, name) + fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		project_id    = atlassian_jira_issue_type_scheme_project.test.project_id
+		issue_type_id = atlassian_jira_issue_type.test.id
+		summary       = %[3]q
+	}
+	`, splits[0], splits[1], summary)
+}
+
+func testAccIssueConfig_descriptionAndLabels(resourceName, key, name string) string {
+	splits := strings.Split(resourceName, ".")
+	return testAccIssueConfig_project(key, name) + fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		project_id    = atlassian_jira_issue_type_scheme_project.test.project_id
+		issue_type_id = atlassian_jira_issue_type.test.id
+		summary       = %[3]q
+		description   = jsonencode({
+			type    = "doc"
+			version = 1
+			content = [
+				{
+					type    = "paragraph"
+					content = [{ type = "text", text = "Managed by Terraform." }]
+				}
+			]
+		})
+		labels = ["infrastructure", "terraform"]
+	}
+	`, splits[0], splits[1], name)
+}
@@ -88,7 +88,7 @@ func (*jiraIssueTypeResource) Schema(_ context.Context, _ resource.SchemaRequest
 				},
 			},
 			"avatar_id": schema.Int64Attribute{
-				MarkdownDescription: "The ID of the issue type's avatar.",
+				MarkdownDescription: "The ID of the issue type's avatar. Use `atlassian_jira_issue_type_avatar` to upload a custom avatar.",
 				Optional:            true,
 				Computed:            true,
 			},
@@ -0,0 +1,260 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraIssueTypeAvatarResource struct {
+		p atlassianProvider
+	}
+
+	jiraIssueTypeAvatarResourceModel struct {
+		ID            types.String `tfsdk:"id"`
+		IssueTypeId   types.String `tfsdk:"issue_type_id"`
+		Source        types.String `tfsdk:"source"`
+		ContentBase64 types.String `tfsdk:"content_base64"`
+	}
+)
+
+var (
+	_ resource.Resource                = (*jiraIssueTypeAvatarResource)(nil)
+	_ resource.ResourceWithImportState = (*jiraIssueTypeAvatarResource)(nil)
+)
+
+func NewJiraIssueTypeAvatarResource() resource.Resource {
+	return &jiraIssueTypeAvatarResource{}
+}
+
+func (*jiraIssueTypeAvatarResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_issue_type_avatar"
+}
+
+func (*jiraIssueTypeAvatarResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Issue Type Avatar Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the avatar.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"issue_type_id": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The ID of the issue type.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The path to a local GIF, JPEG or PNG image file. " +
+					"Changes to the content of the file are not detected, use `content_base64` with `filebase64` instead if needed. " +
+					"Conflicts with `content_base64`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("content_base64")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content_base64": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The base64-encoded content of a GIF, JPEG or PNG image. Conflicts with `source`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *jiraIssueTypeAvatarResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.p.jira = client
+}
+
+func (*jiraIssueTypeAvatarResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "/")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: issue_type_id/id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("issue_type_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+}
+
+func (r *jiraIssueTypeAvatarResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating issue type avatar resource")
+
+	var plan jiraIssueTypeAvatarResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded issue type avatar plan", map[string]interface{}{
+		"createPlan": fmt.Sprintf("%+v", plan),
+	})
+
+	img, err := avatarImage(plan.Source, plan.ContentBase64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Avatar Image", fmt.Sprintf("Unable to read avatar image, got error: %s", err))
+		return
+	}
+	avatar, err := uploadAvatar(ctx, r.p.jira, fmt.Sprintf("rest/api/3/universal_avatar/type/issuetype/owner/%s", plan.IssueTypeId.ValueString()), img)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create issue type avatar, got error: %s", err))
+		return
+	}
+	tflog.Debug(ctx, "Created issue type avatar")
+
+	plan.ID = types.StringValue(avatar.ID)
+
+	if err := r.selectAvatar(ctx, plan.IssueTypeId.ValueString(), avatar.ID); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set issue type avatar, got error: %s", err))
+		return
+	}
+	tflog.Debug(ctx, "Set issue type avatar")
+
+	tflog.Debug(ctx, "Storing issue type avatar into the state", map[string]interface{}{
+		"createNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraIssueTypeAvatarResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading issue type avatar resource")
+
+	var state jiraIssueTypeAvatarResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded issue type avatar from state", map[string]interface{}{
+		"readState": fmt.Sprintf("%+v", state),
+	})
+
+	avatars, err := getAvatars(ctx, r.p.jira, fmt.Sprintf("rest/api/3/universal_avatar/type/issuetype/owner/%s", state.IssueTypeId.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get issue type avatars, got error: %s", err))
+		return
+	}
+	tflog.Debug(ctx, "Retrieved issue type avatars from API state")
+
+	if avatars == nil || findAvatar(avatars.Custom, state.ID.ValueString()) == nil {
+		tflog.Warn(ctx, "Unable to find issue type avatar in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	tflog.Debug(ctx, "Storing issue type avatar into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", state),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraIssueTypeAvatarResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// The RequiresReplace plan modifier will trigger Terraform to destroy and recreate the resource
+	// if any of the required attributes changes, i.e. issue_type_id, source and/or content_base64.
+	tflog.Debug(ctx, "If the value of any required attribute changes, Terraform will destroy and recreate the resource")
+}
+
+func (r *jiraIssueTypeAvatarResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting issue type avatar resource")
+
+	var state jiraIssueTypeAvatarResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded issue type avatar from state")
+
+	avatars, err := getAvatars(ctx, r.p.jira, fmt.Sprintf("rest/api/3/universal_avatar/type/issuetype/owner/%s", state.IssueTypeId.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get issue type avatars, got error: %s", err))
+		return
+	}
+	// The selected avatar cannot be deleted, so fall back to the default system avatar first.
+	if avatars != nil && len(avatars.System) > 0 {
+		if avatar := findAvatar(avatars.Custom, state.ID.ValueString()); avatar != nil && avatar.IsSelected {
+			if err := r.selectAvatar(ctx, state.IssueTypeId.ValueString(), avatars.System[0].ID); err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reset issue type avatar, got error: %s", err))
+				return
+			}
+		}
+	}
+
+	request, err := r.p.jira.NewRequest(ctx, http.MethodDelete, fmt.Sprintf("rest/api/3/universal_avatar/type/issuetype/owner/%s/avatar/%s", state.IssueTypeId.ValueString(), state.ID.ValueString()), "", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create issue type avatar request, got error: %s", err))
+		return
+	}
+	res, err := r.p.jira.Call(request, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete issue type avatar, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Deleted issue type avatar from API state")
+
+	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
+}
+
+func (r *jiraIssueTypeAvatarResource) selectAvatar(ctx context.Context, issueTypeId, avatarId string) error {
+	id, err := strconv.ParseInt(avatarId, 10, 64)
+	if err != nil {
+		return err
+	}
+	request, err := r.p.jira.NewRequest(ctx, http.MethodPut, fmt.Sprintf("rest/api/3/issuetype/%s", issueTypeId), "", map[string]int64{"avatarId": id})
+	if err != nil {
+		return err
+	}
+	res, err := r.p.jira.Call(request, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		return fmt.Errorf("%s\n%s", err, resBody)
+	}
+	return nil
+}
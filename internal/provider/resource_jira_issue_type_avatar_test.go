@@ -0,0 +1,57 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccJiraIssueTypeAvatar_Basic(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-issue-type-avatar")
+	resourceName := "atlassian_jira_issue_type_avatar.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIssueTypeAvatarConfig_basic(resourceName, randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "issue_type_id", "atlassian_jira_issue_type.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "content_base64", testAccAvatarImage),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccIssueTypeAvatarImportConfig,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content_base64"},
+			},
+		},
+	})
+}
+
+func testAccIssueTypeAvatarImportConfig(s *terraform.State) (string, error) {
+	issueTypeId := s.RootModule().Resources["atlassian_jira_issue_type_avatar.test"].Primary.Attributes["issue_type_id"]
+	id := s.RootModule().Resources["atlassian_jira_issue_type_avatar.test"].Primary.Attributes["id"]
+	return fmt.Sprintf("%s/%s", issueTypeId, id), nil
+}
+
+func testAccIssueTypeAvatarConfig_basic(resourceName, name string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	resource "atlassian_jira_issue_type" "test" {
+		name = %[3]q
+	}
+
+	resource %[1]q %[2]q {
+		issue_type_id  = atlassian_jira_issue_type.test.id
+		content_base64 = %[4]q
+	}
+	`, splits[0], splits[1], name, testAccAvatarImage)
+}
@@ -3,6 +3,7 @@ package atlassian
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strconv"
 
 	jira "github.com/ctreminiom/go-atlassian/jira/v3"
@@ -12,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -53,6 +55,9 @@ func (*jiraIssueTypeSchemeResource) Schema(_ context.Context, _ resource.SchemaR
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The ID of the issue type scheme.",
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "The name of the issue type scheme. The name must be unique. The maximum length is 255 characters.",
@@ -81,7 +86,7 @@ func (*jiraIssueTypeSchemeResource) Schema(_ context.Context, _ resource.SchemaR
 				},
 			},
 			"issue_type_ids": schema.ListAttribute{
-				MarkdownDescription: "The list of issue types IDs of the issue type scheme. At least one standard issue type ID is required.",
+				MarkdownDescription: "The ordered list of issue types IDs of the issue type scheme. At least one standard issue type ID is required.",
 				Required:            true,
 				ElementType:         types.StringType,
 			},
@@ -188,20 +193,18 @@ func (r *jiraIssueTypeSchemeResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
-	issueTypeSchemeItems, res, err := r.p.jira.Issue.Type.Scheme.Items(ctx, []int{issueTypeSchemeID}, 0, 50)
-	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get issue type scheme items, got error: %s\n%s", err, resBody))
+	if len(issueTypeScheme.Values) == 0 {
+		tflog.Warn(ctx, "Unable to find issue type scheme in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
 		return
 	}
-	ids := types.ListNull(types.StringType)
-	for _, item := range issueTypeSchemeItems.Values {
-		id := types.StringValue(item.IssueTypeID)
-		ids, _ = types.ListValue(types.StringType, append(ids.Elements(), id))
+
+	issueTypeIds, err := r.getIssueTypeIds(ctx, issueTypeSchemeID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
 	}
+	ids, _ := types.ListValueFrom(ctx, types.StringType, issueTypeIds)
 	tflog.Debug(ctx, "Retrieved issue type scheme from API state")
 
 	state.Name = types.StringValue(issueTypeScheme.Values[0].Name)
@@ -236,22 +239,6 @@ func (r *jiraIssueTypeSchemeResource) Update(ctx context.Context, req resource.U
 		"updateState": fmt.Sprintf("%+v", state),
 	})
 
-	issueTypeSchemeID, _ := strconv.Atoi(state.ID.ValueString())
-
-	issueTypeSchemePayload := new(models.IssueTypeSchemePayloadScheme)
-	issueTypeSchemePayload.Name = plan.Name.ValueString()
-	issueTypeSchemePayload.Description = plan.Description.ValueString()
-
-	res, err := r.p.jira.Issue.Type.Scheme.Update(ctx, issueTypeSchemeID, issueTypeSchemePayload)
-	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update issue type scheme, got error: %s\n%s", err, resBody))
-		return
-	}
-
 	// Validate that default_issue_type_id is included in issue_type_ids
 	if plan.DefaultIssueTypeId.ValueString() != "" {
 		flag := false
@@ -266,31 +253,94 @@ func (r *jiraIssueTypeSchemeResource) Update(ctx context.Context, req resource.U
 		}
 	}
 
-	// Validate that new issue type(s) need to be added to issue type scheme
-	var ids []int
-	var exists bool
-	for _, p := range plan.IssueTypeIds.Elements() {
-		exists = false
-		for _, s := range state.IssueTypeIds.Elements() {
-			if p == s {
-				exists = true
+	issueTypeSchemeID, _ := strconv.Atoi(state.ID.ValueString())
+
+	var planIssueTypeIds, stateIssueTypeIds []string
+	resp.Diagnostics.Append(plan.IssueTypeIds.ElementsAs(ctx, &planIssueTypeIds, false)...)
+	resp.Diagnostics.Append(state.IssueTypeIds.ElementsAs(ctx, &stateIssueTypeIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Add new issue type(s) to issue type scheme, before they can be set as the default issue type
+	if toAdd := subtractStrings(planIssueTypeIds, stateIssueTypeIds); len(toAdd) != 0 {
+		var ids []int
+		for _, id := range toAdd {
+			newId, err := strconv.Atoi(id)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("issue_type_ids"), "Unable to parse value of \"issue_type_ids\" attribute.", "Value of \"issue_type_ids\" attribute can only contain numeric strings.")
+				return
 			}
+			ids = append(ids, newId)
+		}
+		res, err := r.p.jira.Issue.Type.Scheme.Append(ctx, issueTypeSchemeID, ids)
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add issue types to issue type scheme, got error: %s\n%s", err, resBody))
+			return
 		}
-		if !exists {
-			new_id, _ := strconv.Atoi(p.String())
-			ids = append(ids, new_id)
+	}
+
+	// models.IssueTypeSchemePayloadScheme omits empty values, which prevents removing the description or the default issue type.
+	payload := map[string]interface{}{
+		"name":               plan.Name.ValueString(),
+		"description":        plan.Description.ValueString(),
+		"defaultIssueTypeId": nil,
+	}
+	if plan.DefaultIssueTypeId.ValueString() != "" {
+		payload["defaultIssueTypeId"] = plan.DefaultIssueTypeId.ValueString()
+	}
+	request, err := r.p.jira.NewRequest(ctx, http.MethodPut, fmt.Sprintf("rest/api/3/issuetypescheme/%d", issueTypeSchemeID), "", payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create issue type scheme request, got error: %s", err))
+		return
+	}
+	res, err := r.p.jira.Call(request, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
 		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update issue type scheme, got error: %s\n%s", err, resBody))
+		return
 	}
 
-	// Add new issue type(s) to issue type scheme
-	if len(ids) != 0 {
-		res, err = r.p.jira.Issue.Type.Scheme.Append(ctx, issueTypeSchemeID, ids)
+	// Remove issue type(s) no longer in issue_type_ids from issue type scheme
+	for _, id := range subtractStrings(stateIssueTypeIds, planIssueTypeIds) {
+		issueTypeId, _ := strconv.Atoi(id)
+		res, err := r.p.jira.Issue.Type.Scheme.Remove(ctx, issueTypeSchemeID, issueTypeId)
 		if err != nil {
 			var resBody string
 			if res != nil {
 				resBody = res.Bytes.String()
 			}
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add issue types to issue type scheme, got error: %s\n%s", err, resBody))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove issue type from issue type scheme, got error: %s\n%s", err, resBody))
+			return
+		}
+	}
+
+	// Reorder issue types to match issue_type_ids
+	if !plan.IssueTypeIds.Equal(state.IssueTypeIds) && len(planIssueTypeIds) > 1 {
+		// The go-atlassian library does not support changing the order of issue types.
+		payload := map[string]interface{}{
+			"issueTypeIds": planIssueTypeIds,
+			"position":     "First",
+		}
+		request, err := r.p.jira.NewRequest(ctx, http.MethodPut, fmt.Sprintf("rest/api/3/issuetypescheme/%d/issuetype/move", issueTypeSchemeID), "", payload)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create issue type scheme request, got error: %s", err))
+			return
+		}
+		res, err := r.p.jira.Call(request, nil)
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to change order of issue types in issue type scheme, got error: %s\n%s", err, resBody))
 			return
 		}
 	}
@@ -333,3 +383,25 @@ func (r *jiraIssueTypeSchemeResource) Delete(ctx context.Context, req resource.D
 
 	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
 }
+
+func (r *jiraIssueTypeSchemeResource) getIssueTypeIds(ctx context.Context, issueTypeSchemeId int) ([]string, error) {
+	issueTypeIds := []string{}
+	startAt := 0
+	for {
+		items, res, err := r.p.jira.Issue.Type.Scheme.Items(ctx, []int{issueTypeSchemeId}, startAt, 50)
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			return nil, fmt.Errorf("Unable to get issue type scheme items, got error: %s\n%s", err, resBody)
+		}
+		for _, item := range items.Values {
+			issueTypeIds = append(issueTypeIds, item.IssueTypeID)
+		}
+		if items.IsLast || len(items.Values) == 0 {
+			return issueTypeIds, nil
+		}
+		startAt += len(items.Values)
+	}
+}
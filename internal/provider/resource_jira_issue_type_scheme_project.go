@@ -0,0 +1,272 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraIssueTypeSchemeProjectResource struct {
+		p atlassianProvider
+	}
+
+	jiraIssueTypeSchemeProjectResourceModel struct {
+		ID                types.String `tfsdk:"id"`
+		ProjectId         types.String `tfsdk:"project_id"`
+		IssueTypeSchemeId types.String `tfsdk:"issue_type_scheme_id"`
+	}
+)
+
+var (
+	_ resource.Resource                = (*jiraIssueTypeSchemeProjectResource)(nil)
+	_ resource.ResourceWithImportState = (*jiraIssueTypeSchemeProjectResource)(nil)
+)
+
+func NewJiraIssueTypeSchemeProjectResource() resource.Resource {
+	return &jiraIssueTypeSchemeProjectResource{}
+}
+
+func (*jiraIssueTypeSchemeProjectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_issue_type_scheme_project"
+}
+
+func (*jiraIssueTypeSchemeProjectResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Issue Type Scheme Project Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the issue type scheme project association. It is the same as `project_id`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The ID of the project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"issue_type_scheme_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the issue type scheme assigned to the project.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *jiraIssueTypeSchemeProjectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.p.jira = client
+}
+
+func (*jiraIssueTypeSchemeProjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), req.ID)...)
+}
+
+func (r *jiraIssueTypeSchemeProjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating issue type scheme project resource")
+
+	var plan jiraIssueTypeSchemeProjectResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded issue type scheme project plan", map[string]interface{}{
+		"createPlan": fmt.Sprintf("%+v", plan),
+	})
+
+	res, err := r.p.jira.Issue.Type.Scheme.Assign(ctx, plan.IssueTypeSchemeId.ValueString(), plan.ProjectId.ValueString())
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to assign issue type scheme to project, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Created issue type scheme project in API state")
+
+	plan.ID = plan.ProjectId
+
+	tflog.Debug(ctx, "Storing issue type scheme project into the state", map[string]interface{}{
+		"createNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraIssueTypeSchemeProjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading issue type scheme project resource")
+
+	var state jiraIssueTypeSchemeProjectResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded issue type scheme project from state", map[string]interface{}{
+		"readState": fmt.Sprintf("%+v", state),
+	})
+
+	projectId, err := strconv.Atoi(state.ProjectId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("project_id"), "Unable to parse value of \"project_id\" attribute.", "Value of \"project_id\" attribute can only be a numeric string.")
+		return
+	}
+
+	schemeProjects, res, err := r.p.jira.Issue.Type.Scheme.Projects(ctx, []int{projectId}, 0, 50)
+	if res != nil && res.Code == http.StatusNotFound {
+		tflog.Warn(ctx, "Unable to find issue type scheme project in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get issue type scheme project, got error: %s\n%s", err, resBody))
+		return
+	}
+
+	var schemeId string
+	for _, v := range schemeProjects.Values {
+		if v.IssueTypeScheme != nil && containsString(v.ProjectIds, state.ProjectId.ValueString()) {
+			schemeId = v.IssueTypeScheme.ID
+		}
+	}
+	if schemeId == "" {
+		tflog.Warn(ctx, "Unable to find issue type scheme project in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	tflog.Debug(ctx, "Retrieved issue type scheme project from API state")
+
+	state.ID = state.ProjectId
+	state.IssueTypeSchemeId = types.StringValue(schemeId)
+
+	tflog.Debug(ctx, "Storing issue type scheme project into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", state),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraIssueTypeSchemeProjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating issue type scheme project resource")
+
+	var plan jiraIssueTypeSchemeProjectResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded issue type scheme project plan", map[string]interface{}{
+		"updatePlan": fmt.Sprintf("%+v", plan),
+	})
+
+	var state jiraIssueTypeSchemeProjectResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded issue type scheme project from state", map[string]interface{}{
+		"updateState": fmt.Sprintf("%+v", state),
+	})
+
+	res, err := r.p.jira.Issue.Type.Scheme.Assign(ctx, plan.IssueTypeSchemeId.ValueString(), state.ProjectId.ValueString())
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to assign issue type scheme to project, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Updated issue type scheme project in API state")
+
+	plan.ID = state.ID
+
+	tflog.Debug(ctx, "Storing issue type scheme project into the state", map[string]interface{}{
+		"updateNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraIssueTypeSchemeProjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting issue type scheme project resource")
+
+	var state jiraIssueTypeSchemeProjectResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded issue type scheme project from state")
+
+	// Jira has no endpoint to unassign an issue type scheme, so the project is moved back to the default scheme.
+	defaultSchemeId, err := r.getDefaultIssueTypeSchemeId(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	res, err := r.p.jira.Issue.Type.Scheme.Assign(ctx, defaultSchemeId, state.ProjectId.ValueString())
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to unassign issue type scheme from project, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Deleted issue type scheme project from API state")
+
+	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
+}
+
+func (r *jiraIssueTypeSchemeProjectResource) getDefaultIssueTypeSchemeId(ctx context.Context) (string, error) {
+	startAt := 0
+	for {
+		schemes, res, err := r.p.jira.Issue.Type.Scheme.Gets(ctx, nil, startAt, 50)
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			return "", fmt.Errorf("Unable to get issue type schemes, got error: %s\n%s", err, resBody)
+		}
+		for _, scheme := range schemes.Values {
+			if scheme.IsDefault {
+				return scheme.ID, nil
+			}
+		}
+		if schemes.IsLast || len(schemes.Values) == 0 {
+			return "", fmt.Errorf("Unable to find the default issue type scheme")
+		}
+		startAt += len(schemes.Values)
+	}
+}
@@ -70,13 +70,28 @@ func TestAccJiraIssueTypeScheme_IssueTypeIds(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "issue_type_ids.#", "1"),
 				),
 			},
-			//TODO: https://github.com/openscientia/terraform-provider-atlassian/issues/102
-			// {
-			// 	Config: testAccJiraIssueTypeSchemeConfig_issuetypeids(resourceName, randomName),
-			// 	Check: resource.ComposeTestCheckFunc(
-			// 		resource.TestCheckResourceAttr(resourceName, "issue_type_ids.#", "2"),
-			// 	),
-			// },
+			{
+				Config: testAccJiraIssueTypeSchemeConfig_issuetypeids(resourceName, randomName, "test", "testb"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "issue_type_ids.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "issue_type_ids.0", "atlassian_jira_issue_type.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "issue_type_ids.1", "atlassian_jira_issue_type.testb", "id"),
+				),
+			},
+			{
+				Config: testAccJiraIssueTypeSchemeConfig_issuetypeids(resourceName, randomName, "testb", "test"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "issue_type_ids.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "issue_type_ids.0", "atlassian_jira_issue_type.testb", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "issue_type_ids.1", "atlassian_jira_issue_type.test", "id"),
+				),
+			},
+			{
+				Config: testAccJiraIssueTypeSchemeConfig_basic(resourceName, randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "issue_type_ids.#", "1"),
+				),
+			},
 		},
 	})
 }
@@ -110,21 +125,21 @@ func testAccJiraIssueTypeSchemeConfig_description(resourceName, name, descriptio
 	`, splits[0], splits[1], name, description)
 }
 
-//TODO: https://github.com/openscientia/terraform-provider-atlassian/issues/102
-// func testAccJiraIssueTypeSchemeConfig_issuetypeids(resourceName, name string) string {
-// 	splits := strings.Split(resourceName, ".")
-// 	return fmt.Sprintf(`
-// 	resource "atlassian_jira_issue_type" "test" {
-// 		name = %[3]q
-// 	}
+func testAccJiraIssueTypeSchemeConfig_issuetypeids(resourceName, name, first, second string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	resource "atlassian_jira_issue_type" "test" {
+		name = %[3]q
+	}
 
-// 	resource "atlassian_jira_issue_type" "testb" {
-// 		name = "%[3]s2"
-// 	}
+	resource "atlassian_jira_issue_type" "testb" {
+		name = "%[3]s2"
+	}
 
-// 	resource %[1]q %[2]q {
-// 		name = %[3]q
-// 		issue_type_ids = [resource.atlassian_jira_issue_type.test.id, resource.atlassian_jira_issue_type.testb.id]
-// 	}
-// 	`, splits[0], splits[1], name)
-// }
+	resource %[1]q %[2]q {
+		name = %[3]q
+		issue_type_ids = [resource.atlassian_jira_issue_type.%[4]s.id, resource.atlassian_jira_issue_type.%[5]s.id]
+		default_issue_type_id = resource.atlassian_jira_issue_type.%[5]s.id
+	}
+	`, splits[0], splits[1], name, first, second)
+}
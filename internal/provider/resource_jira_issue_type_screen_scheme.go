@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -57,6 +58,9 @@ func (*jiraIssueTypeScreenSchemeResource) Schema(_ context.Context, _ resource.S
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The ID of the issue type screen scheme.",
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "The name of the issue type screen scheme. " +
@@ -137,6 +141,11 @@ func (r *jiraIssueTypeScreenSchemeResource) Create(ctx context.Context, req reso
 		"createPlan": fmt.Sprintf("%+v", plan),
 	})
 
+	if !hasDefaultIssueTypeScreenSchemeMapping(plan.IssueTypeMappings) {
+		resp.Diagnostics.AddAttributeError(path.Root("issue_type_mappings"), "Missing default mapping.", "Value of \"issue_type_mappings\" attribute must contain exactly one entry with \"issue_type_id\" set to \"default\".")
+		return
+	}
+
 	issueTypeMappings := []*models.IssueTypeScreenSchemeMappingPayloadScheme{}
 	for _, v := range plan.IssueTypeMappings {
 		issueTypeMappings = append(issueTypeMappings, &models.IssueTypeScreenSchemeMappingPayloadScheme{
@@ -196,25 +205,46 @@ func (r *jiraIssueTypeScreenSchemeResource) Read(ctx context.Context, req resour
 		return
 	}
 
-	issueTypeScreenSchemeMappings, res, err := r.p.jira.Issue.Type.ScreenScheme.Mapping(ctx, []int{issueTypeScreenSchemeId}, 0, 50)
+	if len(issueTypeScreenSchemeDetails.Values) == 0 {
+		tflog.Warn(ctx, "Unable to find issue type screen scheme in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	issueTypeScreenSchemeMappings, err := r.getMappings(ctx, issueTypeScreenSchemeId)
 	if err != nil {
-		var resBody string
-		if res != nil {
-			resBody = res.Bytes.String()
-		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get issue type screen scheme mappings, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.AddError("Client Error", err.Error())
 		return
 	}
 	tflog.Debug(ctx, "Retrieved issue type screen scheme from API state")
 
 	state.Name = types.StringValue(issueTypeScreenSchemeDetails.Values[0].Name)
 	state.Description = types.StringValue(issueTypeScreenSchemeDetails.Values[0].Description)
+	// Keep the mappings in the order of the configuration, as the API returns them in its own order.
 	var mappings []jiraIssueTypeScreenSchemeMapping
-	for _, v := range issueTypeScreenSchemeMappings.Values {
-		mappings = append(mappings, jiraIssueTypeScreenSchemeMapping{
-			IssueTypeId:    types.StringValue(v.IssueTypeID),
-			ScreenSchemeId: types.StringValue(v.ScreenSchemeID),
-		})
+	for _, sm := range state.IssueTypeMappings {
+		for _, v := range issueTypeScreenSchemeMappings {
+			if v.IssueTypeID == sm.IssueTypeId.ValueString() {
+				mappings = append(mappings, jiraIssueTypeScreenSchemeMapping{
+					IssueTypeId:    types.StringValue(v.IssueTypeID),
+					ScreenSchemeId: types.StringValue(v.ScreenSchemeID),
+				})
+			}
+		}
+	}
+	for _, v := range issueTypeScreenSchemeMappings {
+		found := false
+		for _, sm := range state.IssueTypeMappings {
+			if v.IssueTypeID == sm.IssueTypeId.ValueString() {
+				found = true
+			}
+		}
+		if !found {
+			mappings = append(mappings, jiraIssueTypeScreenSchemeMapping{
+				IssueTypeId:    types.StringValue(v.IssueTypeID),
+				ScreenSchemeId: types.StringValue(v.ScreenSchemeID),
+			})
+		}
 	}
 	state.IssueTypeMappings = mappings
 
@@ -245,6 +275,11 @@ func (r *jiraIssueTypeScreenSchemeResource) Update(ctx context.Context, req reso
 		"updateState": fmt.Sprintf("%+v", state),
 	})
 
+	if !hasDefaultIssueTypeScreenSchemeMapping(plan.IssueTypeMappings) {
+		resp.Diagnostics.AddAttributeError(path.Root("issue_type_mappings"), "Missing default mapping.", "Value of \"issue_type_mappings\" attribute must contain exactly one entry with \"issue_type_id\" set to \"default\".")
+		return
+	}
+
 	err := r.updateNameAndDescription(ctx, &plan, &state)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", err.Error())
@@ -257,13 +292,14 @@ func (r *jiraIssueTypeScreenSchemeResource) Update(ctx context.Context, req reso
 		return
 	}
 
-	err = r.addMappings(ctx, &plan, &state)
+	// Remove mappings first, so that issue types mapped to a different screen scheme can be added again.
+	err = r.removeMappings(ctx, &plan, &state)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", err.Error())
 		return
 	}
 
-	err = r.removeMappings(ctx, &plan, &state)
+	err = r.addMappings(ctx, &plan, &state)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", err.Error())
 		return
@@ -335,8 +371,9 @@ func (r *jiraIssueTypeScreenSchemeResource) updateDefaultMapping(ctx context.Con
 			if err != nil {
 				var resBody string
 				if res != nil {
-					return fmt.Errorf(" Unable to update issue type screen scheme default mapping, got error: %s\n%s", err, resBody)
+					resBody = res.Bytes.String()
 				}
+				return fmt.Errorf(" Unable to update issue type screen scheme default mapping, got error: %s\n%s", err, resBody)
 			}
 			tflog.Debug(ctx, "Updated issue type screen scheme default mapping", map[string]interface{}{
 				"newDefaultMapping": fmt.Sprintf("%+v", planDefaultMapping),
@@ -403,8 +440,9 @@ func (r *jiraIssueTypeScreenSchemeResource) removeMappings(ctx context.Context,
 		if err != nil {
 			var resBody string
 			if res != nil {
-				return fmt.Errorf(" Unable to remove issue type screen scheme mappings, got error: %s\n%s", err, resBody)
+				resBody = res.Bytes.String()
 			}
+			return fmt.Errorf(" Unable to remove issue type screen scheme mappings, got error: %s\n%s", err, resBody)
 		}
 		tflog.Debug(ctx, "Removed issue type screen scheme mappings", map[string]interface{}{
 			"removedMappings": fmt.Sprintf("%+v", removeMappings),
@@ -413,3 +451,33 @@ func (r *jiraIssueTypeScreenSchemeResource) removeMappings(ctx context.Context,
 
 	return nil
 }
+
+func (r *jiraIssueTypeScreenSchemeResource) getMappings(ctx context.Context, id int) ([]*models.IssueTypeScreenSchemeItemScheme, error) {
+	var mappings []*models.IssueTypeScreenSchemeItemScheme
+	startAt := 0
+	for {
+		page, res, err := r.p.jira.Issue.Type.ScreenScheme.Mapping(ctx, []int{id}, startAt, 50)
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			return nil, fmt.Errorf("Unable to get issue type screen scheme mappings, got error: %s\n%s", err, resBody)
+		}
+		mappings = append(mappings, page.Values...)
+		if page.IsLast || len(page.Values) == 0 {
+			return mappings, nil
+		}
+		startAt += len(page.Values)
+	}
+}
+
+func hasDefaultIssueTypeScreenSchemeMapping(mappings []jiraIssueTypeScreenSchemeMapping) bool {
+	count := 0
+	for _, m := range mappings {
+		if m.IssueTypeId.ValueString() == "default" {
+			count++
+		}
+	}
+	return count == 1
+}
@@ -0,0 +1,306 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraIssueTypeScreenSchemeProjectResource struct {
+		p atlassianProvider
+	}
+
+	jiraIssueTypeScreenSchemeProjectResourceModel struct {
+		ID                      types.String `tfsdk:"id"`
+		IssueTypeScreenSchemeId types.String `tfsdk:"issue_type_screen_scheme_id"`
+		ProjectIds              types.Set    `tfsdk:"project_ids"`
+	}
+)
+
+var (
+	_ resource.Resource                = (*jiraIssueTypeScreenSchemeProjectResource)(nil)
+	_ resource.ResourceWithImportState = (*jiraIssueTypeScreenSchemeProjectResource)(nil)
+)
+
+// defaultIssueTypeScreenSchemeId is the ID of the Default Issue Type Screen Scheme, which every Jira instance provides.
+const defaultIssueTypeScreenSchemeId = "1"
+
+func NewJiraIssueTypeScreenSchemeProjectResource() resource.Resource {
+	return &jiraIssueTypeScreenSchemeProjectResource{}
+}
+
+func (*jiraIssueTypeScreenSchemeProjectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_issue_type_screen_scheme_project"
+}
+
+func (*jiraIssueTypeScreenSchemeProjectResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Issue Type Screen Scheme Project Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the issue type screen scheme project association. It is the same as `issue_type_screen_scheme_id`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"issue_type_screen_scheme_id": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The ID of the issue type screen scheme.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the projects the issue type screen scheme is assigned to. " +
+					"Projects assigned to the issue type screen scheme outside of this resource are ignored.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *jiraIssueTypeScreenSchemeProjectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.p.jira = client
+}
+
+func (*jiraIssueTypeScreenSchemeProjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("issue_type_screen_scheme_id"), req.ID)...)
+}
+
+func (r *jiraIssueTypeScreenSchemeProjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating issue type screen scheme project resource")
+
+	var plan jiraIssueTypeScreenSchemeProjectResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded issue type screen scheme project plan", map[string]interface{}{
+		"createPlan": fmt.Sprintf("%+v", plan),
+	})
+
+	var projectIds []string
+	resp.Diagnostics.Append(plan.ProjectIds.ElementsAs(ctx, &projectIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, projectId := range projectIds {
+		if err := r.assign(ctx, plan.IssueTypeScreenSchemeId.ValueString(), projectId); err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+	}
+	tflog.Debug(ctx, "Created issue type screen scheme project in API state")
+
+	plan.ID = plan.IssueTypeScreenSchemeId
+
+	tflog.Debug(ctx, "Storing issue type screen scheme project into the state", map[string]interface{}{
+		"createNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraIssueTypeScreenSchemeProjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading issue type screen scheme project resource")
+
+	var state jiraIssueTypeScreenSchemeProjectResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded issue type screen scheme project from state", map[string]interface{}{
+		"readState": fmt.Sprintf("%+v", state),
+	})
+
+	schemeId, err := strconv.Atoi(state.IssueTypeScreenSchemeId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("issue_type_screen_scheme_id"), "Unable to parse value of \"issue_type_screen_scheme_id\" attribute.", "Value of \"issue_type_screen_scheme_id\" attribute can only be a numeric string.")
+		return
+	}
+
+	var assignedProjectIds []string
+	startAt := 0
+	for {
+		page, res, err := r.p.jira.Issue.Type.ScreenScheme.SchemesByProject(ctx, schemeId, startAt, 50)
+		if res != nil && res.Code == http.StatusNotFound {
+			tflog.Warn(ctx, "Unable to find issue type screen scheme in API state, deleting resource from state")
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get issue type screen scheme projects, got error: %s\n%s", err, resBody))
+			return
+		}
+		for _, project := range page.Values {
+			assignedProjectIds = append(assignedProjectIds, project.ID)
+		}
+		if page.IsLast || len(page.Values) == 0 {
+			break
+		}
+		startAt += len(page.Values)
+	}
+
+	// Only keep the projects managed by this resource, unless it is being imported.
+	projectIds := assignedProjectIds
+	if !state.ProjectIds.IsNull() {
+		var stateProjectIds []string
+		resp.Diagnostics.Append(state.ProjectIds.ElementsAs(ctx, &stateProjectIds, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		projectIds = []string{}
+		for _, id := range stateProjectIds {
+			if containsString(assignedProjectIds, id) {
+				projectIds = append(projectIds, id)
+			}
+		}
+	}
+	if len(projectIds) == 0 {
+		tflog.Warn(ctx, "Unable to find issue type screen scheme project in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	tflog.Debug(ctx, "Retrieved issue type screen scheme project from API state")
+
+	state.ID = state.IssueTypeScreenSchemeId
+	ids, diags := types.SetValueFrom(ctx, types.StringType, projectIds)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.ProjectIds = ids
+
+	tflog.Debug(ctx, "Storing issue type screen scheme project into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", state),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraIssueTypeScreenSchemeProjectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating issue type screen scheme project resource")
+
+	var plan jiraIssueTypeScreenSchemeProjectResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded issue type screen scheme project plan", map[string]interface{}{
+		"updatePlan": fmt.Sprintf("%+v", plan),
+	})
+
+	var state jiraIssueTypeScreenSchemeProjectResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded issue type screen scheme project from state", map[string]interface{}{
+		"updateState": fmt.Sprintf("%+v", state),
+	})
+
+	var planProjectIds, stateProjectIds []string
+	resp.Diagnostics.Append(plan.ProjectIds.ElementsAs(ctx, &planProjectIds, false)...)
+	resp.Diagnostics.Append(state.ProjectIds.ElementsAs(ctx, &stateProjectIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, projectId := range subtractStrings(planProjectIds, stateProjectIds) {
+		if err := r.assign(ctx, state.IssueTypeScreenSchemeId.ValueString(), projectId); err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+	}
+	for _, projectId := range subtractStrings(stateProjectIds, planProjectIds) {
+		if err := r.assign(ctx, defaultIssueTypeScreenSchemeId, projectId); err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+	}
+	tflog.Debug(ctx, "Updated issue type screen scheme project in API state")
+
+	plan.ID = state.ID
+
+	tflog.Debug(ctx, "Storing issue type screen scheme project into the state", map[string]interface{}{
+		"updateNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraIssueTypeScreenSchemeProjectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting issue type screen scheme project resource")
+
+	var state jiraIssueTypeScreenSchemeProjectResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded issue type screen scheme project from state")
+
+	var projectIds []string
+	resp.Diagnostics.Append(state.ProjectIds.ElementsAs(ctx, &projectIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Jira has no endpoint to unassign an issue type screen scheme, so the projects are moved back to the default scheme.
+	for _, projectId := range projectIds {
+		if err := r.assign(ctx, defaultIssueTypeScreenSchemeId, projectId); err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+	}
+	tflog.Debug(ctx, "Deleted issue type screen scheme project from API state")
+
+	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
+}
+
+func (r *jiraIssueTypeScreenSchemeProjectResource) assign(ctx context.Context, schemeId, projectId string) error {
+	res, err := r.p.jira.Issue.Type.ScreenScheme.Assign(ctx, schemeId, projectId)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		return fmt.Errorf("Unable to assign issue type screen scheme %s to project %s, got error: %s\n%s", schemeId, projectId, err, resBody)
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraIssueTypeScreenSchemeProject_Basic(t *testing.T) {
+	randomKey := strings.ToUpper(acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
+	randomName := acctest.RandomWithPrefix("tf-test-issue-type-screen-scheme-project")
+	resourceName := "atlassian_jira_issue_type_screen_scheme_project.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIssueTypeScreenSchemeProjectConfig_basic(resourceName, randomKey, randomName, "atlassian_jira_project.first.id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", "atlassian_jira_issue_type_screen_scheme.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "issue_type_screen_scheme_id", "atlassian_jira_issue_type_screen_scheme.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "project_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "project_ids.*", "atlassian_jira_project.first", "id"),
+				),
+			},
+			{
+				Config: testAccIssueTypeScreenSchemeProjectConfig_basic(resourceName, randomKey, randomName, "atlassian_jira_project.first.id, atlassian_jira_project.second.id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "project_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "project_ids.*", "atlassian_jira_project.first", "id"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "project_ids.*", "atlassian_jira_project.second", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccIssueTypeScreenSchemeProjectConfig_basic(resourceName, randomKey, randomName, "atlassian_jira_project.second.id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "project_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "project_ids.*", "atlassian_jira_project.second", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIssueTypeScreenSchemeProjectConfig_basic(resourceName, key, name, projectIds string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	data "atlassian_jira_myself" "test" {}
+
+	resource "atlassian_jira_project" "first" {
+		key              = "%[3]sA"
+		name             = "%[4]s-first"
+		lead_account_id  = data.atlassian_jira_myself.test.account_id
+		project_type_key = "software"
+	}
+
+	resource "atlassian_jira_project" "second" {
+		key              = "%[3]sB"
+		name             = "%[4]s-second"
+		lead_account_id  = data.atlassian_jira_myself.test.account_id
+		project_type_key = "software"
+	}
+
+	resource "atlassian_jira_issue_type_screen_scheme" "test" {
+		name = %[4]q
+		issue_type_mappings = [
+			{
+				issue_type_id    = "default"
+				screen_scheme_id = "1"
+			}
+		]
+	}
+
+	resource %[1]q %[2]q {
+		issue_type_screen_scheme_id = atlassian_jira_issue_type_screen_scheme.test.id
+		project_ids                 = [%[5]s]
+	}
+	`, splits[0], splits[1], key, name, projectIds)
+}
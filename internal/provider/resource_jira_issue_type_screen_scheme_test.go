@@ -118,6 +118,35 @@ func TestAccJiraIssueTypeScreenScheme_IssueTypeMappings(t *testing.T) {
 	)
 }
 
+func TestAccJiraIssueTypeScreenScheme_IssueTypeMappingScreenScheme(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-issue-type-screen-scheme")
+	resourceName := "atlassian_jira_issue_type_screen_scheme.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIssueTypeScreenSchemeConfig_issuetypemappingscreenscheme(resourceName, randomName, `"1"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "issue_type_mappings.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "issue_type_mappings.0.issue_type_id", "atlassian_jira_issue_type.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "issue_type_mappings.0.screen_scheme_id", "1"),
+					resource.TestCheckResourceAttr(resourceName, "issue_type_mappings.1.issue_type_id", "default"),
+				),
+			},
+			{
+				Config: testAccIssueTypeScreenSchemeConfig_issuetypemappingscreenscheme(resourceName, randomName, "atlassian_jira_screen_scheme.test.id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "issue_type_mappings.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "issue_type_mappings.0.issue_type_id", "atlassian_jira_issue_type.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "issue_type_mappings.0.screen_scheme_id", "atlassian_jira_screen_scheme.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "issue_type_mappings.1.issue_type_id", "default"),
+				),
+			},
+		},
+	})
+}
+
 func testAccIssueTypeScreenSchemeConfig_basic(resourceName, name string) string {
 	splits := strings.Split(resourceName, ".")
 	return fmt.Sprintf(`
@@ -211,3 +240,33 @@ func testAccIssueTypeScreenSchemeConfig_issuetypemappings(resourceName, name, no
 	}
 	`, splits[0], splits[1], name, nonDefaultIssueTypeSuffix)
 }
+
+func testAccIssueTypeScreenSchemeConfig_issuetypemappingscreenscheme(resourceName, name, screenSchemeId string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	resource "atlassian_jira_issue_type" "test" {
+		name = %[3]q
+	}
+
+	resource "atlassian_jira_screen_scheme" "test" {
+		name = %[3]q
+		screens = {
+			default = 1
+		}
+	}
+
+	resource %[1]q %[2]q {
+		name = %[3]q
+		issue_type_mappings = [
+			{
+				issue_type_id = atlassian_jira_issue_type.test.id
+				screen_scheme_id = %[4]s
+			},
+			{
+				issue_type_id = "default"
+				screen_scheme_id = "1"
+			}
+		]
+	}
+	`, splits[0], splits[1], name, screenSchemeId)
+}
@@ -0,0 +1,488 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openscientia/terraform-provider-atlassian/internal/provider/planmodifiers/stringmodifiers"
+)
+
+type (
+	jiraNotificationSchemeResource struct {
+		p atlassianProvider
+	}
+
+	jiraNotificationSchemeResourceModel struct {
+		ID                       types.String                        `tfsdk:"id"`
+		Name                     types.String                        `tfsdk:"name"`
+		Description              types.String                        `tfsdk:"description"`
+		NotificationSchemeEvents []jiraNotificationSchemeEventsModel `tfsdk:"notification_scheme_events"`
+	}
+
+	jiraNotificationSchemeEventsModel struct {
+		EventId       types.String                              `tfsdk:"event_id"`
+		Notifications []jiraNotificationSchemeNotificationModel `tfsdk:"notifications"`
+	}
+
+	jiraNotificationSchemeNotificationModel struct {
+		NotificationType types.String `tfsdk:"notification_type"`
+		Parameter        types.String `tfsdk:"parameter"`
+	}
+
+	// jiraNotification is a single notification recipient configured for an event of a notification scheme.
+	jiraNotification struct {
+		ID               int
+		EventId          string
+		NotificationType string
+		Parameter        string
+	}
+)
+
+var (
+	_ resource.Resource                = (*jiraNotificationSchemeResource)(nil)
+	_ resource.ResourceWithImportState = (*jiraNotificationSchemeResource)(nil)
+
+	notification_types []string = []string{
+		"CurrentAssignee", "Reporter", "CurrentUser", "ProjectLead", "ComponentLead", "User",
+		"Group", "ProjectRole", "EmailAddress", "AllWatchers", "UserCustomField", "GroupCustomField",
+	}
+)
+
+func NewJiraNotificationSchemeResource() resource.Resource {
+	return &jiraNotificationSchemeResource{}
+}
+
+func (*jiraNotificationSchemeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_notification_scheme"
+}
+
+// notificationsSchemaAttribute returns the schema of the notification recipients of an event,
+// which is shared with the jira_notification_scheme_event resource.
+func notificationsSchemaAttribute() schema.SetNestedAttribute {
+	return schema.SetNestedAttribute{
+		MarkdownDescription: "The notification recipients of the event.",
+		Required:            true,
+		Validators: []validator.Set{
+			setvalidator.SizeAtLeast(1),
+		},
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"notification_type": schema.StringAttribute{
+					MarkdownDescription: "The notification type. Can be one of: `CurrentAssignee`, `Reporter`, `CurrentUser`, `ProjectLead`, `ComponentLead`, " +
+						"`User`, `Group`, `ProjectRole`, `EmailAddress`, `AllWatchers`, `UserCustomField`, `GroupCustomField`.",
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.OneOf(notification_types...),
+					},
+				},
+				"parameter": schema.StringAttribute{
+					MarkdownDescription: "The value corresponding to the specified notification type, " +
+						"i.e. the account ID for `User`, the group ID for `Group`, the project role ID for `ProjectRole`, " +
+						"the email address for `EmailAddress` and the custom field ID for `UserCustomField` and `GroupCustomField`.",
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func (*jiraNotificationSchemeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Notification Scheme Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the notification scheme.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the notification scheme. " +
+					"The name must be unique. The maximum length is 255 characters.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the notification scheme. The maximum length is 4000 characters.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringmodifiers.DefaultValue(""),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(4000),
+				},
+			},
+			"notification_scheme_events": schema.SetNestedAttribute{
+				MarkdownDescription: "The notification events and their recipients. " +
+					"If not set, the events of the notification scheme are not managed by this resource.",
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"event_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the event, e.g. `1` for \"Issue created\".",
+							Required:            true,
+						},
+						"notifications": notificationsSchemaAttribute(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *jiraNotificationSchemeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.p.jira = client
+}
+
+func (*jiraNotificationSchemeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *jiraNotificationSchemeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating notification scheme resource")
+
+	var plan jiraNotificationSchemeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded notification scheme plan", map[string]interface{}{
+		"createPlan": fmt.Sprintf("%+v", plan),
+	})
+
+	payload := &models.NotificationSchemePayloadScheme{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+		Events:      expandNotificationSchemeEvents(plan.NotificationSchemeEvents),
+	}
+
+	notificationScheme, res, err := r.p.jira.NotificationScheme.Create(ctx, payload)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create notification scheme, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Created notification scheme in API state")
+
+	plan.ID = types.StringValue(notificationScheme.Id)
+
+	tflog.Debug(ctx, "Storing notification scheme into the state", map[string]interface{}{
+		"createNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraNotificationSchemeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading notification scheme resource")
+
+	var state jiraNotificationSchemeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded notification scheme from state", map[string]interface{}{
+		"readState": fmt.Sprintf("%+v", state),
+	})
+
+	notificationScheme, res, err := r.p.jira.NotificationScheme.Get(ctx, state.ID.ValueString(), []string{"all"})
+	if res != nil && res.Code == http.StatusNotFound {
+		// If notification scheme not found in API state it means that resource was deleted outside Terraform
+		// and it must be deleted from Terraform state and recreated
+		tflog.Warn(ctx, "Unable to find notification scheme in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get notification scheme, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Retrieved notification scheme from API state")
+
+	state.Name = types.StringValue(notificationScheme.Name)
+	state.Description = types.StringValue(notificationScheme.Description)
+	// Events are only stored when managed by this resource, see "notification_scheme_events" attribute.
+	if state.NotificationSchemeEvents != nil {
+		state.NotificationSchemeEvents = flattenNotificationSchemeEvents(flattenNotifications(notificationScheme))
+	}
+
+	tflog.Debug(ctx, "Storing notification scheme into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", state),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraNotificationSchemeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating notification scheme resource")
+
+	var plan jiraNotificationSchemeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded notification scheme plan", map[string]interface{}{
+		"updatePlan": fmt.Sprintf("%+v", plan),
+	})
+
+	var state jiraNotificationSchemeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded notification scheme from state", map[string]interface{}{
+		"updateState": fmt.Sprintf("%+v", state),
+	})
+
+	if plan.Name.ValueString() != state.Name.ValueString() || plan.Description.ValueString() != state.Description.ValueString() {
+		payload := &models.NotificationSchemePayloadScheme{
+			Name:        plan.Name.ValueString(),
+			Description: plan.Description.ValueString(),
+		}
+		res, err := r.p.jira.NotificationScheme.Update(ctx, state.ID.ValueString(), payload)
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update notification scheme, got error: %s\n%s", err, resBody))
+			return
+		}
+		tflog.Debug(ctx, "Updated notification scheme name and description")
+	}
+
+	if plan.NotificationSchemeEvents != nil {
+		var desired []jiraNotification
+		for _, e := range plan.NotificationSchemeEvents {
+			for _, n := range e.Notifications {
+				desired = append(desired, jiraNotification{
+					EventId:          e.EventId.ValueString(),
+					NotificationType: n.NotificationType.ValueString(),
+					Parameter:        n.Parameter.ValueString(),
+				})
+			}
+		}
+		// All events of the notification scheme are managed, so any event which is not in the plan is removed.
+		err := updateNotifications(ctx, r.p.jira, state.ID.ValueString(), desired, func(string) bool { return true })
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+		tflog.Debug(ctx, "Updated notification scheme events")
+	}
+
+	plan.ID = state.ID
+
+	tflog.Debug(ctx, "Storing notification scheme into the state", map[string]interface{}{
+		"updateNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraNotificationSchemeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting notification scheme resource")
+
+	var state jiraNotificationSchemeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded notification scheme from state")
+
+	res, err := r.p.jira.NotificationScheme.Delete(ctx, state.ID.ValueString())
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete notification scheme, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Deleted notification scheme from API state")
+
+	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
+}
+
+func expandNotificationSchemeEvents(events []jiraNotificationSchemeEventsModel) []*models.NotificationSchemePayloadEventScheme {
+	var payload []*models.NotificationSchemePayloadEventScheme
+	for _, e := range events {
+		event := &models.NotificationSchemePayloadEventScheme{
+			Event: &models.NotificationSchemeEventTypeScheme{
+				ID: e.EventId.ValueString(),
+			},
+		}
+		for _, n := range e.Notifications {
+			event.Notifications = append(event.Notifications, &models.NotificationSchemeEventNotificationScheme{
+				NotificationType: n.NotificationType.ValueString(),
+				Parameter:        n.Parameter.ValueString(),
+			})
+		}
+		payload = append(payload, event)
+	}
+
+	return payload
+}
+
+func flattenNotifications(notificationScheme *models.NotificationSchemeScheme) []jiraNotification {
+	var notifications []jiraNotification
+	for _, e := range notificationScheme.NotificationSchemeEvents {
+		if e.Event == nil {
+			continue
+		}
+		for _, n := range e.Notifications {
+			notifications = append(notifications, jiraNotification{
+				ID:               n.ID,
+				EventId:          strconv.Itoa(e.Event.ID),
+				NotificationType: n.NotificationType,
+				Parameter:        n.Parameter,
+			})
+		}
+	}
+
+	return notifications
+}
+
+func flattenNotificationSchemeEvents(notifications []jiraNotification) []jiraNotificationSchemeEventsModel {
+	events := []jiraNotificationSchemeEventsModel{}
+	index := map[string]int{}
+	for _, n := range notifications {
+		i, ok := index[n.EventId]
+		if !ok {
+			i = len(events)
+			index[n.EventId] = i
+			events = append(events, jiraNotificationSchemeEventsModel{
+				EventId: types.StringValue(n.EventId),
+			})
+		}
+		events[i].Notifications = append(events[i].Notifications, flattenNotification(n))
+	}
+
+	return events
+}
+
+func flattenNotification(n jiraNotification) jiraNotificationSchemeNotificationModel {
+	notification := jiraNotificationSchemeNotificationModel{
+		NotificationType: types.StringValue(n.NotificationType),
+		Parameter:        types.StringNull(),
+	}
+	if n.Parameter != "" {
+		notification.Parameter = types.StringValue(n.Parameter)
+	}
+
+	return notification
+}
+
+// updateNotifications reconciles the notifications of the events selected by managed with desired,
+// removing existing notifications which are not desired and adding the missing ones.
+func updateNotifications(ctx context.Context, client *jira.Client, schemeId string, desired []jiraNotification, managed func(eventId string) bool) error {
+	notificationScheme, res, err := client.NotificationScheme.Get(ctx, schemeId, []string{"all"})
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		return fmt.Errorf("Unable to get notification scheme, got error: %s\n%s", err, resBody)
+	}
+
+	key := func(n jiraNotification) string {
+		return fmt.Sprintf("%s/%s/%s", n.EventId, n.NotificationType, n.Parameter)
+	}
+
+	existing := map[string]bool{}
+	wanted := map[string]bool{}
+	for _, n := range desired {
+		wanted[key(n)] = true
+	}
+
+	for _, n := range flattenNotifications(notificationScheme) {
+		if !managed(n.EventId) {
+			continue
+		}
+		if wanted[key(n)] {
+			existing[key(n)] = true
+			continue
+		}
+		res, err := client.NotificationScheme.Remove(ctx, schemeId, strconv.Itoa(n.ID))
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			return fmt.Errorf("Unable to remove notification from notification scheme, got error: %s\n%s", err, resBody)
+		}
+		tflog.Debug(ctx, "Removed notification from notification scheme", map[string]interface{}{
+			"removedNotification": fmt.Sprintf("%+v", n),
+		})
+	}
+
+	var events []jiraNotificationSchemeEventsModel
+	for _, e := range flattenNotificationSchemeEvents(desired) {
+		var notifications []jiraNotificationSchemeNotificationModel
+		for _, n := range e.Notifications {
+			if !existing[key(jiraNotification{EventId: e.EventId.ValueString(), NotificationType: n.NotificationType.ValueString(), Parameter: n.Parameter.ValueString()})] {
+				notifications = append(notifications, n)
+			}
+		}
+		if len(notifications) > 0 {
+			events = append(events, jiraNotificationSchemeEventsModel{EventId: e.EventId, Notifications: notifications})
+		}
+	}
+	if len(events) == 0 {
+		return nil
+	}
+
+	payload := &models.NotificationSchemeEventsPayloadScheme{
+		NotificationSchemeEvents: expandNotificationSchemeEvents(events),
+	}
+	res, err = client.NotificationScheme.Append(ctx, schemeId, payload)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		return fmt.Errorf("Unable to add notifications to notification scheme, got error: %s\n%s", err, resBody)
+	}
+	tflog.Debug(ctx, "Added notifications to notification scheme", map[string]interface{}{
+		"addedNotifications": fmt.Sprintf("%+v", events),
+	})
+
+	return nil
+}
@@ -0,0 +1,244 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraNotificationSchemeEventResource struct {
+		p atlassianProvider
+	}
+
+	jiraNotificationSchemeEventResourceModel struct {
+		ID                   types.String                              `tfsdk:"id"`
+		NotificationSchemeID types.String                              `tfsdk:"notification_scheme_id"`
+		EventId              types.String                              `tfsdk:"event_id"`
+		Notifications        []jiraNotificationSchemeNotificationModel `tfsdk:"notifications"`
+	}
+)
+
+var (
+	_ resource.Resource                = (*jiraNotificationSchemeEventResource)(nil)
+	_ resource.ResourceWithImportState = (*jiraNotificationSchemeEventResource)(nil)
+)
+
+func NewJiraNotificationSchemeEventResource() resource.Resource {
+	return &jiraNotificationSchemeEventResource{}
+}
+
+func (*jiraNotificationSchemeEventResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_notification_scheme_event"
+}
+
+func (*jiraNotificationSchemeEventResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Notification Scheme Event Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the notification scheme event, i.e. `notification_scheme_id` and `event_id` separated by a slash (`/`).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"notification_scheme_id": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The ID of the notification scheme.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"event_id": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The ID of the event, e.g. `1` for \"Issue created\".",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"notifications": notificationsSchemaAttribute(),
+		},
+	}
+}
+
+func (r *jiraNotificationSchemeEventResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.p.jira = client
+}
+
+func (*jiraNotificationSchemeEventResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError("Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: notification_scheme_id/event_id. Got: %q", req.ID))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Importing notification scheme event with import identifier: %+v", idParts))
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("notification_scheme_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("event_id"), idParts[1])...)
+}
+
+func (r *jiraNotificationSchemeEventResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating notification scheme event resource")
+
+	var plan jiraNotificationSchemeEventResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded notification scheme event plan", map[string]interface{}{
+		"createPlan": fmt.Sprintf("%+v", plan),
+	})
+
+	err := r.updateEventNotifications(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	tflog.Debug(ctx, "Created notification scheme event in API state")
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s/%s", plan.NotificationSchemeID.ValueString(), plan.EventId.ValueString()))
+
+	tflog.Debug(ctx, "Storing notification scheme event into the state", map[string]interface{}{
+		"createNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraNotificationSchemeEventResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading notification scheme event resource")
+
+	var state jiraNotificationSchemeEventResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded notification scheme event from state", map[string]interface{}{
+		"readState": fmt.Sprintf("%+v", state),
+	})
+
+	notificationScheme, res, err := r.p.jira.NotificationScheme.Get(ctx, state.NotificationSchemeID.ValueString(), []string{"all"})
+	if res != nil && res.Code == http.StatusNotFound {
+		tflog.Warn(ctx, "Unable to find notification scheme in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get notification scheme, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Retrieved notification scheme from API state")
+
+	var notifications []jiraNotificationSchemeNotificationModel
+	for _, n := range flattenNotifications(notificationScheme) {
+		if n.EventId == state.EventId.ValueString() {
+			notifications = append(notifications, flattenNotification(n))
+		}
+	}
+	if len(notifications) == 0 {
+		// If the event has no notifications in API state it means that resource was deleted outside Terraform
+		// and it must be deleted from Terraform state and recreated
+		tflog.Warn(ctx, "Unable to find notification scheme event in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.Notifications = notifications
+
+	tflog.Debug(ctx, "Storing notification scheme event into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", state),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraNotificationSchemeEventResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating notification scheme event resource")
+
+	var plan jiraNotificationSchemeEventResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded notification scheme event plan", map[string]interface{}{
+		"updatePlan": fmt.Sprintf("%+v", plan),
+	})
+
+	err := r.updateEventNotifications(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	tflog.Debug(ctx, "Updated notification scheme event in API state")
+
+	tflog.Debug(ctx, "Storing notification scheme event into the state", map[string]interface{}{
+		"updateNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraNotificationSchemeEventResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting notification scheme event resource")
+
+	var state jiraNotificationSchemeEventResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded notification scheme event from state")
+
+	// Removing all notifications of the event deletes it from the notification scheme.
+	state.Notifications = nil
+	err := r.updateEventNotifications(ctx, &state)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	tflog.Debug(ctx, "Deleted notification scheme event from API state")
+
+	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
+}
+
+func (r *jiraNotificationSchemeEventResource) updateEventNotifications(ctx context.Context, m *jiraNotificationSchemeEventResourceModel) error {
+	var desired []jiraNotification
+	for _, n := range m.Notifications {
+		desired = append(desired, jiraNotification{
+			EventId:          m.EventId.ValueString(),
+			NotificationType: n.NotificationType.ValueString(),
+			Parameter:        n.Parameter.ValueString(),
+		})
+	}
+
+	return updateNotifications(ctx, r.p.jira, m.NotificationSchemeID.ValueString(), desired, func(eventId string) bool {
+		return eventId == m.EventId.ValueString()
+	})
+}
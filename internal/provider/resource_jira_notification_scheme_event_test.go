@@ -0,0 +1,65 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraNotificationSchemeEvent_Basic(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-notification-scheme")
+	resourceName := "atlassian_jira_notification_scheme_event.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNotificationSchemeEventConfig_basic(resourceName, randomName, "Reporter"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "notification_scheme_id", "atlassian_jira_notification_scheme.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "event_id", "1"),
+					resource.TestCheckResourceAttr(resourceName, "notifications.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "notifications.*", map[string]string{
+						"notification_type": "Reporter",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccNotificationSchemeEventConfig_basic(resourceName, randomName, "AllWatchers"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "notifications.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "notifications.*", map[string]string{
+						"notification_type": "AllWatchers",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccNotificationSchemeEventConfig_basic(resourceName, name, notificationType string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	resource "atlassian_jira_notification_scheme" "test" {
+		name = %[3]q
+	}
+
+	resource %[1]q %[2]q {
+		notification_scheme_id = atlassian_jira_notification_scheme.test.id
+		event_id               = "1"
+		notifications = [
+			{ notification_type = "CurrentAssignee" },
+			{ notification_type = %[4]q },
+		]
+	}
+	`, splits[0], splits[1], name, notificationType)
+}
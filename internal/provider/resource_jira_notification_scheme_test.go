@@ -0,0 +1,126 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraNotificationScheme_Basic(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-notification-scheme")
+	resourceName := "atlassian_jira_notification_scheme.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNotificationSchemeConfig_basic(resourceName, randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", randomName),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckNoResourceAttr(resourceName, "notification_scheme_events"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccJiraNotificationScheme_Description(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-notification-scheme")
+	resourceName := "atlassian_jira_notification_scheme.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNotificationSchemeConfig_description(resourceName, randomName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+				),
+			},
+			{
+				Config: testAccNotificationSchemeConfig_description(resourceName, randomName, "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJiraNotificationScheme_Events(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-notification-scheme")
+	resourceName := "atlassian_jira_notification_scheme.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNotificationSchemeConfig_events(resourceName, randomName, "Reporter"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "notification_scheme_events.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "notification_scheme_events.0.event_id", "1"),
+					resource.TestCheckResourceAttr(resourceName, "notification_scheme_events.0.notifications.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "notification_scheme_events.0.notifications.*", map[string]string{
+						"notification_type": "Reporter",
+					}),
+				),
+			},
+			{
+				Config: testAccNotificationSchemeConfig_events(resourceName, randomName, "AllWatchers"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "notification_scheme_events.0.notifications.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "notification_scheme_events.0.notifications.*", map[string]string{
+						"notification_type": "AllWatchers",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccNotificationSchemeConfig_basic(resourceName, name string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		name = %[3]q
+	}
+	`, splits[0], splits[1], name)
+}
+
+func testAccNotificationSchemeConfig_description(resourceName, name, description string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		name        = %[3]q
+		description = %[4]q
+	}
+	`, splits[0], splits[1], name, description)
+}
+
+func testAccNotificationSchemeConfig_events(resourceName, name, notificationType string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		name = %[3]q
+		notification_scheme_events = [
+			{
+				event_id = "1"
+				notifications = [
+					{ notification_type = "CurrentAssignee" },
+					{ notification_type = %[4]q },
+				]
+			},
+		]
+	}
+	`, splits[0], splits[1], name, notificationType)
+}
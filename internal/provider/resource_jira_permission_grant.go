@@ -155,16 +155,22 @@ func (r *jiraPermissionGrantResource) Configure(ctx context.Context, req resourc
 }
 
 func (*jiraPermissionGrantResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, ",")
-	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+	var schemeId, grantId string
+	if idParts := strings.Split(req.ID, "/"); len(idParts) == 2 {
+		schemeId, grantId = idParts[0], idParts[1]
+	} else if idParts := strings.Split(req.ID, ","); len(idParts) == 2 {
+		// Deprecated import identifier format: ID,permission_scheme_id
+		grantId, schemeId = idParts[0], idParts[1]
+	}
+	if schemeId == "" || grantId == "" {
 		resp.Diagnostics.AddError("Unexpected Import Identifier",
-			fmt.Sprintf("Expected import identifier with format: ID, permission_scheme_id. Got: %q", req.ID))
+			fmt.Sprintf("Expected import identifier with format: permission_scheme_id/ID. Got: %q", req.ID))
 		return
 	}
-	tflog.Debug(ctx, fmt.Sprintf("Importing permission grant with import identifier: %+v", idParts))
+	tflog.Debug(ctx, fmt.Sprintf("Importing permission grant with import identifier: %s/%s", schemeId, grantId))
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("permission_scheme_id"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), grantId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("permission_scheme_id"), schemeId)...)
 }
 
 func (r *jiraPermissionGrantResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -153,7 +153,7 @@ func TestAccJiraPermissionGrant_Permission(t *testing.T) {
 func testAccPermissionGrantImportConfig(s *terraform.State) (string, error) {
 	permissionGrantId := s.RootModule().Resources["atlassian_jira_permission_grant.test"].Primary.Attributes["id"]
 	permissionSchemeId := s.RootModule().Resources["atlassian_jira_permission_grant.test"].Primary.Attributes["permission_scheme_id"]
-	return fmt.Sprintf("%s,%s", permissionGrantId, permissionSchemeId), nil
+	return fmt.Sprintf("%s/%s", permissionSchemeId, permissionGrantId), nil
 }
 
 func testAccPermissionGrantConfig_basic(resourceName string) string {
@@ -3,6 +3,7 @@ package atlassian
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strconv"
 
 	jira "github.com/ctreminiom/go-atlassian/jira/v3"
@@ -16,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openscientia/terraform-provider-atlassian/internal/provider/planmodifiers/boolmodifiers"
 	"github.com/openscientia/terraform-provider-atlassian/internal/provider/planmodifiers/stringmodifiers"
 )
 
@@ -25,10 +27,11 @@ type (
 	}
 
 	jiraPermissionSchemeResourceModel struct {
-		ID          types.String `tfsdk:"id"`
-		Self        types.String `tfsdk:"self"`
-		Name        types.String `tfsdk:"name"`
-		Description types.String `tfsdk:"description"`
+		ID                 types.String `tfsdk:"id"`
+		Self               types.String `tfsdk:"self"`
+		Name               types.String `tfsdk:"name"`
+		Description        types.String `tfsdk:"description"`
+		DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
 	}
 )
 
@@ -80,6 +83,15 @@ func (*jiraPermissionSchemeResource) Schema(_ context.Context, _ resource.Schema
 					stringmodifiers.DefaultValue(""),
 				},
 			},
+			"deletion_protection": schema.BoolAttribute{
+				MarkdownDescription: "Whether Terraform is prevented from destroying the permission scheme. " +
+					"The value must be set to `false` and applied before the permission scheme can be destroyed or replaced. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolmodifiers.DefaultValue(false),
+				},
+			},
 		},
 	}
 }
@@ -159,6 +171,13 @@ func (r *jiraPermissionSchemeResource) Read(ctx context.Context, req resource.Re
 	schemeId, _ := strconv.Atoi(state.ID.ValueString())
 
 	permissionScheme, res, err := r.p.jira.Permission.Scheme.Get(ctx, schemeId, []string{""})
+	if res != nil && res.Code == http.StatusNotFound {
+		// If permission scheme not found in API state it means that resource was deleted outside Terraform
+		// and it must be deleted from Terraform state and recreated
+		tflog.Warn(ctx, "Unable to find permission scheme in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		var resBody string
 		if res != nil {
@@ -172,6 +191,10 @@ func (r *jiraPermissionSchemeResource) Read(ctx context.Context, req resource.Re
 	state.Self = types.StringValue(permissionScheme.Self)
 	state.Name = types.StringValue(permissionScheme.Name)
 	state.Description = types.StringValue(permissionScheme.Description)
+	// The value is not stored by Jira, so it is only missing after importing the permission scheme
+	if state.DeletionProtection.IsNull() {
+		state.DeletionProtection = types.BoolValue(false)
+	}
 
 	tflog.Debug(ctx, "Storing permission scheme into the state", map[string]interface{}{
 		"readNewState": fmt.Sprintf("%+v", state),
@@ -233,6 +256,12 @@ func (r *jiraPermissionSchemeResource) Delete(ctx context.Context, req resource.
 	}
 	tflog.Debug(ctx, "Loaded permission scheme from state")
 
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError("Deletion Protection Enabled",
+			"Unable to delete permission scheme, because \"deletion_protection\" is set to true. Set it to false and apply the configuration before destroying the permission scheme.")
+		return
+	}
+
 	schemeId, _ := strconv.Atoi(state.ID.ValueString())
 
 	res, err := r.p.jira.Permission.Scheme.Delete(ctx, schemeId)
@@ -2,6 +2,7 @@ package atlassian
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -80,6 +81,34 @@ func TestAccJiraPermissionScheme_Description(t *testing.T) {
 	})
 }
 
+func TestAccJiraPermissionScheme_DeletionProtection(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-permission-scheme")
+	resourceName := "atlassian_jira_permission_scheme.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionScheme_deletionProtection(resourceName, randomName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "true"),
+				),
+			},
+			{
+				Config:      testAccPermissionScheme_deletionProtection(resourceName, randomName, true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("Deletion Protection Enabled"),
+			},
+			{
+				Config: testAccPermissionScheme_deletionProtection(resourceName, randomName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
 func testAccPermissionScheme_basic(resourceName, name string) string {
 	splits := strings.Split(resourceName, ".")
 	return fmt.Sprintf(`
@@ -98,3 +127,13 @@ func testAccPermissionScheme_description(resourceName, name, description string)
 	}
 	`, splits[0], splits[1], name, description)
 }
+
+func testAccPermissionScheme_deletionProtection(resourceName, name string, deletionProtection bool) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		name                = %[3]q
+		deletion_protection = %[4]t
+	}
+	`, splits[0], splits[1], name, deletionProtection)
+}
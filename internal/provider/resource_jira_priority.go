@@ -0,0 +1,406 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openscientia/terraform-provider-atlassian/internal/provider/planmodifiers/boolmodifiers"
+	"github.com/openscientia/terraform-provider-atlassian/internal/provider/planmodifiers/stringmodifiers"
+)
+
+type (
+	jiraPriorityResource struct {
+		p atlassianProvider
+	}
+
+	jiraPriorityResourceModel struct {
+		ID                    types.String `tfsdk:"id"`
+		Name                  types.String `tfsdk:"name"`
+		Description           types.String `tfsdk:"description"`
+		IconUrl               types.String `tfsdk:"icon_url"`
+		StatusColor           types.String `tfsdk:"status_color"`
+		IsDefault             types.Bool   `tfsdk:"is_default"`
+		ReplacementPriorityId types.String `tfsdk:"replacement_priority_id"`
+	}
+
+	// The go-atlassian library only supports reading priorities, so the following types
+	// are used to call the "Create priority", "Update priority" and "Get priority" endpoints.
+	jiraPriorityPayload struct {
+		Name        string `json:"name,omitempty"`
+		Description string `json:"description,omitempty"`
+		IconUrl     string `json:"iconUrl,omitempty"`
+		StatusColor string `json:"statusColor,omitempty"`
+	}
+
+	jiraPriorityDetails struct {
+		ID          string `json:"id,omitempty"`
+		Name        string `json:"name,omitempty"`
+		Description string `json:"description,omitempty"`
+		IconUrl     string `json:"iconUrl,omitempty"`
+		StatusColor string `json:"statusColor,omitempty"`
+		IsDefault   bool   `json:"isDefault,omitempty"`
+	}
+)
+
+var (
+	_ resource.Resource                = (*jiraPriorityResource)(nil)
+	_ resource.ResourceWithImportState = (*jiraPriorityResource)(nil)
+)
+
+func NewJiraPriorityResource() resource.Resource {
+	return &jiraPriorityResource{}
+}
+
+func (*jiraPriorityResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_priority"
+}
+
+func (*jiraPriorityResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Priority Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the priority.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the priority. Must be unique. The maximum length is 60 characters.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(60),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the priority. The maximum length is 255 characters.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringmodifiers.DefaultValue(""),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+				},
+			},
+			"icon_url": schema.StringAttribute{
+				MarkdownDescription: "The URL of an icon for the priority. Accepted protocols are HTTP and HTTPS. " +
+					"Built in icons can also be used, e.g. `/images/icons/priorities/major.png`.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+				},
+			},
+			"status_color": schema.StringAttribute{
+				MarkdownDescription: "The status color of the priority in 3-digit or 6-digit hexadecimal format, e.g. `#009900`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`), "value must be a color in 3-digit or 6-digit hexadecimal format"),
+				},
+			},
+			"is_default": schema.BoolAttribute{
+				MarkdownDescription: "Whether the priority is the default priority. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolmodifiers.DefaultValue(false),
+				},
+			},
+			"replacement_priority_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the priority that replaces this priority in all issues when the priority is deleted.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *jiraPriorityResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.p.jira = client
+}
+
+func (*jiraPriorityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *jiraPriorityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating priority resource")
+
+	var plan jiraPriorityResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded priority plan", map[string]interface{}{
+		"createPlan": fmt.Sprintf("%+v", plan),
+	})
+
+	payload := &jiraPriorityPayload{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+		IconUrl:     plan.IconUrl.ValueString(),
+		StatusColor: plan.StatusColor.ValueString(),
+	}
+	request, err := r.p.jira.NewRequest(ctx, http.MethodPost, "rest/api/3/priority", "", payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create priority request, got error: %s", err))
+		return
+	}
+
+	priority := new(jiraPriorityDetails)
+	res, err := r.p.jira.Call(request, priority)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create priority, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Created priority in API state")
+
+	plan.ID = types.StringValue(priority.ID)
+
+	if plan.IsDefault.ValueBool() {
+		if err := r.setDefaultPriority(ctx, priority.ID); err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+		tflog.Debug(ctx, "Set priority as default priority")
+	}
+
+	if plan.IconUrl.IsUnknown() {
+		details, _, err := r.getPriority(ctx, priority.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+		plan.IconUrl = types.StringValue(details.IconUrl)
+	}
+
+	tflog.Debug(ctx, "Storing priority into the state", map[string]interface{}{
+		"createNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraPriorityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading priority resource")
+
+	var state jiraPriorityResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded priority from state", map[string]interface{}{
+		"readState": fmt.Sprintf("%+v", state),
+	})
+
+	priority, res, err := r.getPriority(ctx, state.ID.ValueString())
+	if res != nil && res.Code == http.StatusNotFound {
+		tflog.Warn(ctx, "Unable to find priority in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	tflog.Debug(ctx, "Retrieved priority from API state")
+
+	state.Name = types.StringValue(priority.Name)
+	state.Description = types.StringValue(priority.Description)
+	state.IconUrl = types.StringValue(priority.IconUrl)
+	state.StatusColor = types.StringValue(priority.StatusColor)
+	state.IsDefault = types.BoolValue(priority.IsDefault)
+
+	tflog.Debug(ctx, "Storing priority into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", state),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraPriorityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating priority resource")
+
+	var plan jiraPriorityResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded priority plan", map[string]interface{}{
+		"updatePlan": fmt.Sprintf("%+v", plan),
+	})
+
+	var state jiraPriorityResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded priority from state", map[string]interface{}{
+		"updateState": fmt.Sprintf("%+v", state),
+	})
+
+	payload := &jiraPriorityPayload{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+		IconUrl:     plan.IconUrl.ValueString(),
+		StatusColor: plan.StatusColor.ValueString(),
+	}
+	request, err := r.p.jira.NewRequest(ctx, http.MethodPut, fmt.Sprintf("rest/api/3/priority/%s", state.ID.ValueString()), "", payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create priority request, got error: %s", err))
+		return
+	}
+
+	res, err := r.p.jira.Call(request, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update priority, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Updated priority in API state")
+
+	if plan.IsDefault.ValueBool() != state.IsDefault.ValueBool() {
+		// Unsetting the default priority leaves the instance without a default priority.
+		defaultPriorityId := ""
+		if plan.IsDefault.ValueBool() {
+			defaultPriorityId = state.ID.ValueString()
+		}
+		if err := r.setDefaultPriority(ctx, defaultPriorityId); err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+		tflog.Debug(ctx, "Updated default priority")
+	}
+
+	plan.ID = state.ID
+
+	tflog.Debug(ctx, "Storing priority into the state", map[string]interface{}{
+		"updateNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraPriorityResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting priority resource")
+
+	var state jiraPriorityResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded priority from state")
+
+	endpoint := fmt.Sprintf("rest/api/3/priority/%s", state.ID.ValueString())
+	if state.ReplacementPriorityId.ValueString() != "" {
+		params := url.Values{}
+		params.Add("replaceWith", state.ReplacementPriorityId.ValueString())
+		endpoint = fmt.Sprintf("%s?%s", endpoint, params.Encode())
+	}
+	request, err := r.p.jira.NewRequest(ctx, http.MethodDelete, endpoint, "", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create priority request, got error: %s", err))
+		return
+	}
+
+	res, err := r.p.jira.Call(request, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete priority, got error: %s\n%s", err, resBody))
+		return
+	}
+
+	// Issues are moved to the replacement priority by a long-running task.
+	if taskId := taskIdFromResponse(res); taskId != "" {
+		if err := waitForJiraTask(ctx, r.p.jira, taskId); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete priority, got error: %s", err))
+			return
+		}
+	}
+	tflog.Debug(ctx, "Deleted priority from API state")
+
+	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
+}
+
+func (r *jiraPriorityResource) getPriority(ctx context.Context, priorityId string) (*jiraPriorityDetails, *models.ResponseScheme, error) {
+	request, err := r.p.jira.NewRequest(ctx, http.MethodGet, fmt.Sprintf("rest/api/3/priority/%s", priorityId), "", nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Unable to create priority request, got error: %s", err)
+	}
+
+	priority := new(jiraPriorityDetails)
+	res, err := r.p.jira.Call(request, priority)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		return nil, res, fmt.Errorf("Unable to get priority, got error: %s\n%s", err, resBody)
+	}
+
+	return priority, res, nil
+}
+
+// setDefaultPriority sets the default priority, an empty priorityId removes the default priority.
+func (r *jiraPriorityResource) setDefaultPriority(ctx context.Context, priorityId string) error {
+	payload := map[string]interface{}{"id": nil}
+	if priorityId != "" {
+		payload["id"] = priorityId
+	}
+
+	request, err := r.p.jira.NewRequest(ctx, http.MethodPut, "rest/api/3/priority/default", "", payload)
+	if err != nil {
+		return fmt.Errorf("Unable to create default priority request, got error: %s", err)
+	}
+
+	res, err := r.p.jira.Call(request, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		return fmt.Errorf("Unable to set default priority, got error: %s\n%s", err, resBody)
+	}
+
+	return nil
+}
@@ -0,0 +1,599 @@
+package atlassian
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openscientia/terraform-provider-atlassian/internal/provider/planmodifiers/stringmodifiers"
+)
+
+type (
+	jiraPrioritySchemeResource struct {
+		p atlassianProvider
+	}
+
+	jiraPrioritySchemeResourceModel struct {
+		ID                types.String `tfsdk:"id"`
+		Name              types.String `tfsdk:"name"`
+		Description       types.String `tfsdk:"description"`
+		DefaultPriorityId types.String `tfsdk:"default_priority_id"`
+		PriorityIds       types.List   `tfsdk:"priority_ids"`
+		ProjectIds        types.Set    `tfsdk:"project_ids"`
+	}
+
+	// The go-atlassian library does not support priority schemes, so the following types
+	// are used to call the "Priority schemes" endpoints.
+	jiraPrioritySchemeCreatePayload struct {
+		Name              string  `json:"name"`
+		Description       string  `json:"description,omitempty"`
+		DefaultPriorityId int64   `json:"defaultPriorityId"`
+		PriorityIds       []int64 `json:"priorityIds"`
+		ProjectIds        []int64 `json:"projectIds,omitempty"`
+	}
+
+	jiraPrioritySchemeUpdatePayload struct {
+		Name              string                       `json:"name,omitempty"`
+		Description       string                       `json:"description"`
+		DefaultPriorityId int64                        `json:"defaultPriorityId,omitempty"`
+		Priorities        *jiraPrioritySchemeChangeSet `json:"priorities,omitempty"`
+		Projects          *jiraPrioritySchemeChangeSet `json:"projects,omitempty"`
+	}
+
+	jiraPrioritySchemeChangeSet struct {
+		Add    *jiraPrioritySchemeIds `json:"add,omitempty"`
+		Remove *jiraPrioritySchemeIds `json:"remove,omitempty"`
+	}
+
+	jiraPrioritySchemeIds struct {
+		Ids []int64 `json:"ids"`
+	}
+
+	jiraPrioritySchemeTaskResponse struct {
+		ID   json.Number `json:"id,omitempty"`
+		Task *struct {
+			ID json.Number `json:"id,omitempty"`
+		} `json:"task,omitempty"`
+	}
+
+	jiraPrioritySchemeDetails struct {
+		ID          json.Number `json:"id,omitempty"`
+		Name        string      `json:"name,omitempty"`
+		Description string      `json:"description,omitempty"`
+		IsDefault   bool        `json:"isDefault,omitempty"`
+	}
+
+	jiraPrioritySchemePage struct {
+		IsLast  bool                        `json:"isLast,omitempty"`
+		StartAt int                         `json:"startAt,omitempty"`
+		Total   int                         `json:"total,omitempty"`
+		Values  []jiraPrioritySchemeDetails `json:"values,omitempty"`
+	}
+
+	jiraPrioritySchemeItemPage struct {
+		IsLast  bool `json:"isLast,omitempty"`
+		StartAt int  `json:"startAt,omitempty"`
+		Total   int  `json:"total,omitempty"`
+		Values  []struct {
+			ID        json.Number `json:"id,omitempty"`
+			IsDefault bool        `json:"isDefault,omitempty"`
+		} `json:"values,omitempty"`
+	}
+)
+
+var (
+	_ resource.Resource                = (*jiraPrioritySchemeResource)(nil)
+	_ resource.ResourceWithImportState = (*jiraPrioritySchemeResource)(nil)
+)
+
+func NewJiraPrioritySchemeResource() resource.Resource {
+	return &jiraPrioritySchemeResource{}
+}
+
+func (*jiraPrioritySchemeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_priority_scheme"
+}
+
+func (*jiraPrioritySchemeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Priority Scheme Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the priority scheme.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the priority scheme. Must be unique. The maximum length is 255 characters.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the priority scheme. The maximum length is 4000 characters.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringmodifiers.DefaultValue(""),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(4000),
+				},
+			},
+			"default_priority_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the default priority of the priority scheme. Must be one of `priority_ids`.",
+				Required:            true,
+			},
+			"priority_ids": schema.ListAttribute{
+				MarkdownDescription: "The ordered list of IDs of the priorities in the priority scheme.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"project_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the projects associated with the priority scheme.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *jiraPrioritySchemeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.p.jira = client
+}
+
+func (*jiraPrioritySchemeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *jiraPrioritySchemeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating priority scheme resource")
+
+	var plan jiraPrioritySchemeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded priority scheme plan", map[string]interface{}{
+		"createPlan": fmt.Sprintf("%+v", plan),
+	})
+
+	defaultPriorityId, err := strconv.ParseInt(plan.DefaultPriorityId.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("default_priority_id"), "Unable to parse value of \"default_priority_id\" attribute.", "Value of \"default_priority_id\" attribute can only be a numeric string.")
+		return
+	}
+
+	var priorityIds, projectIds []string
+	resp.Diagnostics.Append(plan.PriorityIds.ElementsAs(ctx, &priorityIds, false)...)
+	resp.Diagnostics.Append(plan.ProjectIds.ElementsAs(ctx, &projectIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := &jiraPrioritySchemeCreatePayload{
+		Name:              plan.Name.ValueString(),
+		Description:       plan.Description.ValueString(),
+		DefaultPriorityId: defaultPriorityId,
+	}
+	payload.PriorityIds, err = parseJiraIds(priorityIds)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("priority_ids"), "Unable to parse value of \"priority_ids\" attribute.", err.Error())
+		return
+	}
+	payload.ProjectIds, err = parseJiraIds(projectIds)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("project_ids"), "Unable to parse value of \"project_ids\" attribute.", err.Error())
+		return
+	}
+
+	request, err := r.p.jira.NewRequest(ctx, http.MethodPost, "rest/api/3/priorityscheme", "", payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create priority scheme request, got error: %s", err))
+		return
+	}
+
+	prioritySchemeResponse := new(jiraPrioritySchemeTaskResponse)
+	res, err := r.p.jira.Call(request, prioritySchemeResponse)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create priority scheme, got error: %s\n%s", err, resBody))
+		return
+	}
+
+	if prioritySchemeResponse.Task != nil {
+		if err := waitForJiraTask(ctx, r.p.jira, prioritySchemeResponse.Task.ID.String()); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create priority scheme, got error: %s", err))
+			return
+		}
+	}
+	tflog.Debug(ctx, "Created priority scheme in API state")
+
+	plan.ID = types.StringValue(prioritySchemeResponse.ID.String())
+
+	tflog.Debug(ctx, "Storing priority scheme into the state", map[string]interface{}{
+		"createNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraPrioritySchemeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading priority scheme resource")
+
+	var state jiraPrioritySchemeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded priority scheme from state", map[string]interface{}{
+		"readState": fmt.Sprintf("%+v", state),
+	})
+
+	params := url.Values{}
+	params.Add("schemeId", state.ID.ValueString())
+	prioritySchemes, err := searchJiraPrioritySchemes(ctx, r.p.jira, params)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	if len(prioritySchemes) == 0 {
+		tflog.Warn(ctx, "Unable to find priority scheme in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	priorityScheme := prioritySchemes[0]
+	tflog.Debug(ctx, "Retrieved priority scheme from API state")
+
+	priorityIds, defaultPriorityId, err := getJiraPrioritySchemePriorities(ctx, r.p.jira, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	projectIds, err := getJiraPrioritySchemeProjects(ctx, r.p.jira, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	state.Name = types.StringValue(priorityScheme.Name)
+	state.Description = types.StringValue(priorityScheme.Description)
+	state.DefaultPriorityId = types.StringValue(defaultPriorityId)
+
+	// Keep the order of the priorities in the configuration if the priority scheme still contains the same priorities.
+	var statePriorityIds []string
+	resp.Diagnostics.Append(state.PriorityIds.ElementsAs(ctx, &statePriorityIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !sameElements(statePriorityIds, priorityIds) {
+		state.PriorityIds, _ = types.ListValueFrom(ctx, types.StringType, priorityIds)
+	}
+
+	if len(projectIds) == 0 {
+		state.ProjectIds = types.SetNull(types.StringType)
+	} else {
+		state.ProjectIds, _ = types.SetValueFrom(ctx, types.StringType, projectIds)
+	}
+
+	tflog.Debug(ctx, "Storing priority scheme into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", state),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraPrioritySchemeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating priority scheme resource")
+
+	var plan jiraPrioritySchemeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded priority scheme plan", map[string]interface{}{
+		"updatePlan": fmt.Sprintf("%+v", plan),
+	})
+
+	var state jiraPrioritySchemeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded priority scheme from state", map[string]interface{}{
+		"updateState": fmt.Sprintf("%+v", state),
+	})
+
+	defaultPriorityId, err := strconv.ParseInt(plan.DefaultPriorityId.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("default_priority_id"), "Unable to parse value of \"default_priority_id\" attribute.", "Value of \"default_priority_id\" attribute can only be a numeric string.")
+		return
+	}
+
+	var planPriorityIds, statePriorityIds, planProjectIds, stateProjectIds []string
+	resp.Diagnostics.Append(plan.PriorityIds.ElementsAs(ctx, &planPriorityIds, false)...)
+	resp.Diagnostics.Append(state.PriorityIds.ElementsAs(ctx, &statePriorityIds, false)...)
+	resp.Diagnostics.Append(plan.ProjectIds.ElementsAs(ctx, &planProjectIds, false)...)
+	resp.Diagnostics.Append(state.ProjectIds.ElementsAs(ctx, &stateProjectIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := &jiraPrioritySchemeUpdatePayload{
+		Name:              plan.Name.ValueString(),
+		Description:       plan.Description.ValueString(),
+		DefaultPriorityId: defaultPriorityId,
+	}
+	payload.Priorities, err = newJiraPrioritySchemeChangeSet(statePriorityIds, planPriorityIds)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("priority_ids"), "Unable to parse value of \"priority_ids\" attribute.", err.Error())
+		return
+	}
+	payload.Projects, err = newJiraPrioritySchemeChangeSet(stateProjectIds, planProjectIds)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("project_ids"), "Unable to parse value of \"project_ids\" attribute.", err.Error())
+		return
+	}
+
+	request, err := r.p.jira.NewRequest(ctx, http.MethodPut, fmt.Sprintf("rest/api/3/priorityscheme/%s", state.ID.ValueString()), "", payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create priority scheme request, got error: %s", err))
+		return
+	}
+
+	prioritySchemeResponse := new(jiraPrioritySchemeTaskResponse)
+	res, err := r.p.jira.Call(request, prioritySchemeResponse)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update priority scheme, got error: %s\n%s", err, resBody))
+		return
+	}
+
+	if prioritySchemeResponse.Task != nil {
+		if err := waitForJiraTask(ctx, r.p.jira, prioritySchemeResponse.Task.ID.String()); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update priority scheme, got error: %s", err))
+			return
+		}
+	}
+	tflog.Debug(ctx, "Updated priority scheme in API state")
+
+	plan.ID = state.ID
+
+	tflog.Debug(ctx, "Storing priority scheme into the state", map[string]interface{}{
+		"updateNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraPrioritySchemeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting priority scheme resource")
+
+	var state jiraPrioritySchemeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded priority scheme from state")
+
+	request, err := r.p.jira.NewRequest(ctx, http.MethodDelete, fmt.Sprintf("rest/api/3/priorityscheme/%s", state.ID.ValueString()), "", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create priority scheme request, got error: %s", err))
+		return
+	}
+
+	res, err := r.p.jira.Call(request, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete priority scheme, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Deleted priority scheme from API state")
+
+	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
+}
+
+// searchJiraPrioritySchemes returns all the priority schemes matching the query parameters.
+func searchJiraPrioritySchemes(ctx context.Context, client *jira.Client, params url.Values) ([]jiraPrioritySchemeDetails, error) {
+	var prioritySchemes []jiraPrioritySchemeDetails
+	for startAt := 0; ; {
+		params.Set("startAt", strconv.Itoa(startAt))
+		request, err := client.NewRequest(ctx, http.MethodGet, fmt.Sprintf("rest/api/3/priorityscheme?%s", params.Encode()), "", nil)
+		if err != nil {
+			return nil, fmt.Errorf("Unable to create priority scheme request, got error: %s", err)
+		}
+
+		page := new(jiraPrioritySchemePage)
+		res, err := client.Call(request, page)
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			return nil, fmt.Errorf("Unable to get priority schemes, got error: %s\n%s", err, resBody)
+		}
+
+		prioritySchemes = append(prioritySchemes, page.Values...)
+		if page.IsLast || len(page.Values) == 0 {
+			return prioritySchemes, nil
+		}
+		startAt += len(page.Values)
+	}
+}
+
+// getJiraPrioritySchemePriorities returns the IDs of the priorities of a priority scheme and the ID of its default priority.
+func getJiraPrioritySchemePriorities(ctx context.Context, client *jira.Client, prioritySchemeId string) ([]string, string, error) {
+	var priorityIds []string
+	var defaultPriorityId string
+	err := listJiraPrioritySchemeItems(ctx, client, fmt.Sprintf("rest/api/3/priorityscheme/%s/priorities", prioritySchemeId), func(page *jiraPrioritySchemeItemPage) {
+		for _, p := range page.Values {
+			priorityIds = append(priorityIds, p.ID.String())
+			if p.IsDefault {
+				defaultPriorityId = p.ID.String()
+			}
+		}
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	return priorityIds, defaultPriorityId, nil
+}
+
+// getJiraPrioritySchemeProjects returns the IDs of the projects associated with a priority scheme.
+func getJiraPrioritySchemeProjects(ctx context.Context, client *jira.Client, prioritySchemeId string) ([]string, error) {
+	var projectIds []string
+	err := listJiraPrioritySchemeItems(ctx, client, fmt.Sprintf("rest/api/3/priorityscheme/%s/projects", prioritySchemeId), func(page *jiraPrioritySchemeItemPage) {
+		for _, p := range page.Values {
+			projectIds = append(projectIds, p.ID.String())
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return projectIds, nil
+}
+
+func listJiraPrioritySchemeItems(ctx context.Context, client *jira.Client, endpoint string, f func(page *jiraPrioritySchemeItemPage)) error {
+	for startAt := 0; ; {
+		request, err := client.NewRequest(ctx, http.MethodGet, fmt.Sprintf("%s?startAt=%d", endpoint, startAt), "", nil)
+		if err != nil {
+			return fmt.Errorf("Unable to create priority scheme request, got error: %s", err)
+		}
+
+		page := new(jiraPrioritySchemeItemPage)
+		res, err := client.Call(request, page)
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			return fmt.Errorf("Unable to get priority scheme, got error: %s\n%s", err, resBody)
+		}
+
+		f(page)
+		if page.IsLast || len(page.Values) == 0 {
+			return nil
+		}
+		startAt += len(page.Values)
+	}
+}
+
+// newJiraPrioritySchemeChangeSet returns the IDs to add and remove to go from the current IDs to the desired IDs, or nil if there are no changes.
+func newJiraPrioritySchemeChangeSet(current, desired []string) (*jiraPrioritySchemeChangeSet, error) {
+	var add, remove []string
+	for _, id := range desired {
+		if !containsString(current, id) {
+			add = append(add, id)
+		}
+	}
+	for _, id := range current {
+		if !containsString(desired, id) {
+			remove = append(remove, id)
+		}
+	}
+	if len(add) == 0 && len(remove) == 0 {
+		return nil, nil
+	}
+
+	changeSet := &jiraPrioritySchemeChangeSet{}
+	if len(add) > 0 {
+		ids, err := parseJiraIds(add)
+		if err != nil {
+			return nil, err
+		}
+		changeSet.Add = &jiraPrioritySchemeIds{Ids: ids}
+	}
+	if len(remove) > 0 {
+		ids, err := parseJiraIds(remove)
+		if err != nil {
+			return nil, err
+		}
+		changeSet.Remove = &jiraPrioritySchemeIds{Ids: ids}
+	}
+
+	return changeSet, nil
+}
+
+// parseJiraIds converts a list of numeric string IDs into integers.
+func parseJiraIds(ids []string) ([]int64, error) {
+	var parsed []int64
+	for _, id := range ids {
+		v, err := strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Value %q can only be a numeric string.", id)
+		}
+		parsed = append(parsed, v)
+	}
+	return parsed, nil
+}
+
+func containsString(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
+
+// sameElements reports whether a and b contain the same elements, regardless of their order.
+func sameElements(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, e := range a {
+		if !containsString(b, e) {
+			return false
+		}
+	}
+	return true
+}
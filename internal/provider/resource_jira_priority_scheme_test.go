@@ -0,0 +1,90 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraPriorityScheme_Basic(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-priority-scheme")
+	resourceName := "atlassian_jira_priority_scheme.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrioritySchemeConfig_basic(resourceName, randomName, "3", `["2", "3"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", randomName),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "default_priority_id", "3"),
+					resource.TestCheckResourceAttr(resourceName, "priority_ids.#", "2"),
+					resource.TestCheckNoResourceAttr(resourceName, "project_ids"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPrioritySchemeConfig_basic(resourceName, randomName, "2", `["1", "2", "3"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "default_priority_id", "2"),
+					resource.TestCheckResourceAttr(resourceName, "priority_ids.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJiraPriorityScheme_Description(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-priority-scheme")
+	resourceName := "atlassian_jira_priority_scheme.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrioritySchemeConfig_description(resourceName, randomName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+				),
+			},
+			{
+				Config: testAccPrioritySchemeConfig_description(resourceName, randomName, "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPrioritySchemeConfig_basic(resourceName, name, defaultPriorityId, priorityIds string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		name                = %[3]q
+		default_priority_id = %[4]q
+		priority_ids        = %[5]s
+	}
+	`, splits[0], splits[1], name, defaultPriorityId, priorityIds)
+}
+
+func testAccPrioritySchemeConfig_description(resourceName, name, description string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		name                = %[3]q
+		description         = %[4]q
+		default_priority_id = "3"
+		priority_ids        = ["3"]
+	}
+	`, splits[0], splits[1], name, description)
+}
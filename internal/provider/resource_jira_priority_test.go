@@ -0,0 +1,87 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraPriority_Basic(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-priority")
+	resourceName := "atlassian_jira_priority.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPriorityConfig_basic(resourceName, randomName, "#009900"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", randomName),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "icon_url"),
+					resource.TestCheckResourceAttr(resourceName, "status_color", "#009900"),
+					resource.TestCheckResourceAttr(resourceName, "is_default", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPriorityConfig_basic(resourceName, randomName, "#ff0000"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "status_color", "#ff0000"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJiraPriority_Description(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-priority")
+	resourceName := "atlassian_jira_priority.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPriorityConfig_description(resourceName, randomName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+				),
+			},
+			{
+				Config: testAccPriorityConfig_description(resourceName, randomName, "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPriorityConfig_basic(resourceName, name, statusColor string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		name         = %[3]q
+		status_color = %[4]q
+	}
+	`, splits[0], splits[1], name, statusColor)
+}
+
+func testAccPriorityConfig_description(resourceName, name, description string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		name         = %[3]q
+		description  = %[4]q
+		status_color = "#009900"
+	}
+	`, splits[0], splits[1], name, description)
+}
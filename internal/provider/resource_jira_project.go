@@ -3,22 +3,29 @@ package atlassian
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"net/url"
+	"regexp"
 	"strconv"
 	"strings"
 
 	jira "github.com/ctreminiom/go-atlassian/jira/v3"
 	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openscientia/terraform-provider-atlassian/internal/provider/planmodifiers/boolmodifiers"
 	"github.com/openscientia/terraform-provider-atlassian/internal/provider/planmodifiers/stringmodifiers"
+	"github.com/openscientia/terraform-provider-atlassian/internal/provider/validators"
 )
 
 type (
@@ -32,19 +39,51 @@ type (
 		Name                     types.String `tfsdk:"name"`
 		Description              types.String `tfsdk:"description"`
 		AvatarId                 types.Int64  `tfsdk:"avatar_id"`
+		CategoryId               types.Int64  `tfsdk:"category_id"`
 		FieldConfigurationScheme types.Int64  `tfsdk:"field_configuration_scheme"`
 		IssueTypeScheme          types.Int64  `tfsdk:"issue_type_scheme"`
 		IssueTypeScreenScheme    types.Int64  `tfsdk:"issue_type_screen_scheme"`
 		WorkflowScheme           types.Int64  `tfsdk:"workflow_scheme"`
+		PermissionScheme         types.Int64  `tfsdk:"permission_scheme"`
+		NotificationScheme       types.Int64  `tfsdk:"notification_scheme"`
+		IssueSecurityScheme      types.Int64  `tfsdk:"issue_security_scheme"`
 		LeadAccountId            types.String `tfsdk:"lead_account_id"`
+		LeadEmail                types.String `tfsdk:"lead_email"`
+		AssigneeType             types.String `tfsdk:"assignee_type"`
+		ProjectTemplateKey       types.String `tfsdk:"project_template_key"`
 		ProjectTypeKey           types.String `tfsdk:"project_type_key"`
+		Style                    types.String `tfsdk:"style"`
 		URL                      types.String `tfsdk:"url"`
+		ArchiveOnDestroy         types.Bool   `tfsdk:"archive_on_destroy"`
+		DeleteMode               types.String `tfsdk:"delete_mode"`
+		DeletionProtection       types.Bool   `tfsdk:"deletion_protection"`
 	}
+
+	// jiraProjectSchemes are the IDs of the shared schemes associated with a company-managed project.
+	jiraProjectSchemes struct {
+		FieldConfigurationScheme types.Int64
+		IssueSecurityScheme      types.Int64
+		IssueTypeScheme          types.Int64
+		IssueTypeScreenScheme    types.Int64
+		NotificationScheme       types.Int64
+		PermissionScheme         types.Int64
+		WorkflowScheme           types.Int64
+	}
+)
+
+const (
+	projectStyleClassic = "classic"
+	projectStyleNextGen = "next-gen"
+
+	projectDeleteModePermanent = "permanent"
+	projectDeleteModeTrash     = "trash"
 )
 
 var (
-	_ resource.Resource                = (*jiraProjectResource)(nil)
-	_ resource.ResourceWithImportState = (*jiraProjectResource)(nil)
+	_ resource.Resource                     = (*jiraProjectResource)(nil)
+	_ resource.ResourceWithConfigValidators = (*jiraProjectResource)(nil)
+	_ resource.ResourceWithImportState      = (*jiraProjectResource)(nil)
+	_ resource.ResourceWithValidateConfig   = (*jiraProjectResource)(nil)
 )
 
 func NewJiraProjectResource() resource.Resource {
@@ -68,10 +107,12 @@ func (*jiraProjectResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				},
 			},
 			"key": schema.StringAttribute{
-				MarkdownDescription: "Project keys must be unique and start with an uppercase letter followed by one or more uppercase alphanumeric characters. The maximum length is 10 characters.",
+				MarkdownDescription: "Project keys must be unique and start with an uppercase letter followed by one or more uppercase alphanumeric characters. The maximum length is 10 characters. Project keys cannot be a JQL reserved word, e.g. `AND`. Changing the key updates the project in place, and Jira keeps the previous key as an alias for the issues of the project.",
 				Required:            true,
 				Validators: []validator.String{
 					stringvalidator.LengthAtMost(10),
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[A-Z][A-Z0-9]+$`), "value must start with an uppercase letter followed by one or more uppercase alphanumeric characters"),
+					validators.NotJqlReservedWord(),
 				},
 			},
 			"name": schema.StringAttribute{
@@ -89,33 +130,121 @@ func (*jiraProjectResource) Schema(_ context.Context, _ resource.SchemaRequest,
 			"avatar_id": schema.Int64Attribute{
 				MarkdownDescription: "An integer value for the project's avatar.",
 				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"category_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the project category of the project.",
+				Optional:            true,
 			},
 			"field_configuration_scheme": schema.Int64Attribute{
-				MarkdownDescription: "The ID of the field configuration scheme for the project.",
+				MarkdownDescription: "The ID of the field configuration scheme for the project. Defaults to the field configuration scheme assigned by Jira. Do not use together with `atlassian_jira_issue_field_configuration_scheme_project`.",
 				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"issue_type_scheme": schema.Int64Attribute{
-				MarkdownDescription: "The ID of the issue type scheme for the project. If you specify the issue type scheme you cannot specify the project template key.",
+				MarkdownDescription: "The ID of the issue type scheme for the project. If you specify the issue type scheme you cannot specify the project template key. Defaults to the issue type scheme assigned by Jira. Do not use together with `atlassian_jira_issue_type_scheme_project`.",
 				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"issue_type_screen_scheme": schema.Int64Attribute{
-				MarkdownDescription: "The ID of the issue type screen scheme for the project. If you specify the issue type screen scheme you cannot specify the project template key.",
+				MarkdownDescription: "The ID of the issue type screen scheme for the project. If you specify the issue type screen scheme you cannot specify the project template key. Defaults to the issue type screen scheme assigned by Jira. Do not use together with `atlassian_jira_issue_type_screen_scheme_project`.",
 				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"workflow_scheme": schema.Int64Attribute{
-				MarkdownDescription: "The ID of the workflow scheme for the project. If you specify the workflow scheme you cannot specify the project template key.",
+				MarkdownDescription: "The ID of the workflow scheme for the project. If you specify the workflow scheme you cannot specify the project template key. Defaults to the workflow scheme assigned by Jira. Do not use together with `atlassian_jira_project_workflow_scheme`.",
 				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"permission_scheme": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the permission scheme for the project. Defaults to the permission scheme assigned by Jira. Do not use together with `atlassian_jira_project_permission_scheme`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"notification_scheme": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the notification scheme for the project. Defaults to the notification scheme assigned by Jira. Do not use together with `atlassian_jira_project_notification_scheme`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"issue_security_scheme": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the issue security scheme for the project. Do not use together with `atlassian_jira_project_issue_security_scheme`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"lead_account_id": schema.StringAttribute{
-				MarkdownDescription: "The account ID of the project lead. Either lead or leadAccountId must be set when creating a project. Cannot be provided with lead.",
+				MarkdownDescription: "The account ID of the project lead. Either `lead_account_id` or `lead_email` must be set when creating a project. Cannot be provided with `lead_email`.",
 				Optional:            true,
 				Computed:            true,
 			},
+			"lead_email": schema.StringAttribute{
+				MarkdownDescription: "The email address of the project lead. The account ID of the user is looked up when applying the configuration, " +
+					"which requires the user to have a visible email address. Cannot be provided with `lead_account_id`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("lead_account_id")),
+				},
+			},
+			"assignee_type": schema.StringAttribute{
+				MarkdownDescription: "The default assignee when creating issues for this project. Can be one of: `PROJECT_LEAD`, `UNASSIGNED`. " +
+					"Defaults to the value chosen by Jira, which depends on whether unassigned issues are allowed on the site.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("PROJECT_LEAD", "UNASSIGNED"),
+				},
+			},
+			"project_template_key": schema.StringAttribute{
+				MarkdownDescription: "A predefined configuration for the project, e.g. `com.pyxis.greenhopper.jira:gh-simplified-agility-scrum`. " +
+					"Required to create a team-managed (`next-gen`) project. Only used when creating the project. " +
+					"Cannot be provided with `issue_type_scheme`, `issue_type_screen_scheme` or `workflow_scheme`.",
+				Optional: true,
+			},
 			"project_type_key": schema.StringAttribute{
 				MarkdownDescription: "The project type, which defines the application-specific feature set. If you don't specify the project template you have to specify the project type. Valid values: software, service_desk, business",
 				Optional:            true,
 				Computed:            true,
 			},
+			"style": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The style of the project. Can be one of: `classic` (company-managed) or `next-gen` (team-managed). " +
+					"Team-managed projects do not use shared schemes, so `field_configuration_scheme`, `issue_security_scheme`, `issue_type_scheme`, " +
+					"`issue_type_screen_scheme`, `notification_scheme`, `permission_scheme` and `workflow_scheme` cannot be set for them.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(projectStyleClassic, projectStyleNextGen),
+				},
+			},
 			"url": schema.StringAttribute{
 				MarkdownDescription: "A link to information about this project, such as project documentation.",
 				Optional:            true,
@@ -124,10 +253,87 @@ func (*jiraProjectResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					stringmodifiers.DefaultValue(""),
 				},
 			},
+			"archive_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Whether the project is archived instead of deleted when the resource is destroyed. " +
+					"Archiving projects is only available on Jira Premium and Enterprise plans. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolmodifiers.DefaultValue(false),
+				},
+			},
+			"deletion_protection": schema.BoolAttribute{
+				MarkdownDescription: "Whether Terraform is prevented from destroying the project. " +
+					"The value must be set to `false` and applied before the project can be destroyed or replaced. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolmodifiers.DefaultValue(false),
+				},
+			},
+			"delete_mode": schema.StringAttribute{
+				MarkdownDescription: "How the project is deleted when the resource is destroyed. Can be one of: `permanent`, `trash`. " +
+					"Projects moved to the trash can be restored by a Jira administrator until they are permanently deleted after 60 days. " +
+					"When set to `trash`, creating a project with the key of a project in the trash restores that project instead. " +
+					"Ignored if `archive_on_destroy` is `true`. Defaults to `permanent`.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringmodifiers.DefaultValue(projectDeleteModePermanent),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(projectDeleteModePermanent, projectDeleteModeTrash),
+				},
+			},
 		},
 	}
 }
 
+func (*jiraProjectResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	// Projects created from a template get their schemes from the template.
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(path.MatchRoot("project_template_key"), path.MatchRoot("issue_type_scheme")),
+		resourcevalidator.Conflicting(path.MatchRoot("project_template_key"), path.MatchRoot("issue_type_screen_scheme")),
+		resourcevalidator.Conflicting(path.MatchRoot("project_template_key"), path.MatchRoot("workflow_scheme")),
+	}
+}
+
+func (*jiraProjectResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config jiraProjectResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Team-managed projects are always created from a template and do not use schemes.
+	if config.Style.ValueString() != projectStyleNextGen {
+		return
+	}
+	if config.ProjectTemplateKey.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("project_template_key"),
+			"Failed to provide value for \"project_template_key\" attribute",
+			fmt.Sprintf("A value must be provided if \"style\" is: %s", projectStyleNextGen))
+	}
+	for _, attr := range []struct {
+		name  string
+		value types.Int64
+	}{
+		{"field_configuration_scheme", config.FieldConfigurationScheme},
+		{"issue_security_scheme", config.IssueSecurityScheme},
+		{"issue_type_scheme", config.IssueTypeScheme},
+		{"issue_type_screen_scheme", config.IssueTypeScreenScheme},
+		{"notification_scheme", config.NotificationScheme},
+		{"permission_scheme", config.PermissionScheme},
+		{"workflow_scheme", config.WorkflowScheme},
+	} {
+		if !attr.value.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root(attr.name),
+				fmt.Sprintf("Team-managed projects must not have a value for \"%s\" attribute", attr.name),
+				fmt.Sprintf("A value must not be provided if \"style\" is: %s", projectStyleNextGen))
+		}
+	}
+}
+
 func (r *jiraProjectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured
 	if req.ProviderData == nil {
@@ -162,31 +368,119 @@ func (r *jiraProjectResource) Create(ctx context.Context, req resource.CreateReq
 		"createPlan": fmt.Sprintf("%+v", plan),
 	})
 
+	leadAccountId, err := r.leadAccountId(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("lead_email"), "Unable to find project lead",
+			fmt.Sprintf("%s\nIf the user hides their email address, set \"lead_account_id\" instead of \"lead_email\".", err))
+		return
+	}
+
 	projectPayload := new(models.ProjectPayloadScheme)
 	projectPayload.Key = plan.Key.ValueString()
 	projectPayload.Name = plan.Name.ValueString()
 	projectPayload.Description = plan.Description.ValueString()
 	projectPayload.AvatarID = int(plan.AvatarId.ValueInt64())
+	projectPayload.CategoryID = int(plan.CategoryId.ValueInt64())
+	projectPayload.NotificationScheme = int(plan.NotificationScheme.ValueInt64())
+	projectPayload.IssueSecurityScheme = int(plan.IssueSecurityScheme.ValueInt64())
 	projectPayload.FieldConfigurationScheme = int(plan.FieldConfigurationScheme.ValueInt64())
 	projectPayload.IssueTypeScheme = int(plan.IssueTypeScheme.ValueInt64())
 	projectPayload.IssueTypeScreenScheme = int(plan.IssueTypeScreenScheme.ValueInt64())
-	projectPayload.LeadAccountID = plan.LeadAccountId.ValueString()
+	projectPayload.LeadAccountID = leadAccountId
+	projectPayload.AssigneeType = plan.AssigneeType.ValueString()
+	projectPayload.PermissionScheme = int(plan.PermissionScheme.ValueInt64())
+	projectPayload.ProjectTemplateKey = plan.ProjectTemplateKey.ValueString()
 	projectPayload.ProjectTypeKey = plan.ProjectTypeKey.ValueString()
 	projectPayload.URL = plan.URL.ValueString()
 	projectPayload.WorkflowScheme = int(plan.WorkflowScheme.ValueInt64())
 
-	returnedProject, res, err := r.p.jira.Project.Create(ctx, projectPayload)
+	// Projects in the trash keep their key, so they are restored instead of creating a project with the same key
+	var trashedProject *models.ProjectScheme
+	if plan.DeleteMode.ValueString() == projectDeleteModeTrash {
+		var diags diag.Diagnostics
+		trashedProject, diags = r.findTrashedProject(ctx, plan.Key.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if trashedProject != nil {
+		restoredProject, res, err := r.p.jira.Project.Restore(ctx, trashedProject.ID)
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to restore project, got error: %s\n%s", err, resBody))
+			return
+		}
+		tflog.Debug(ctx, "Restored project from the trash in API state")
+
+		var restoredState jiraProjectResourceModel
+		if restoredProject.Category != nil {
+			categoryID, _ := strconv.Atoi(restoredProject.Category.ID)
+			restoredState.CategoryId = types.Int64Value(int64(categoryID))
+		}
+		_, diags := r.updateProject(ctx, restoredProject.ID, leadAccountId, plan, restoredState)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		plan.ID = types.StringValue(restoredProject.ID)
+	} else {
+		returnedProject, res, err := r.p.jira.Project.Create(ctx, projectPayload)
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create project, got error: %s\n%s", err, resBody))
+			return
+		}
+		tflog.Debug(ctx, "Created project")
+
+		plan.ID = types.StringValue(strconv.Itoa(returnedProject.ID))
+	}
+
+	// The style of the project is determined by Jira from the project template
+	project, res, err := r.p.jira.Project.Get(ctx, plan.ID.ValueString(), nil)
 	if err != nil {
 		var resBody string
 		if res != nil {
 			resBody = res.Bytes.String()
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create project, got error: %s\n%s", err, resBody))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get project, got error: %s\n%s", err, resBody))
 		return
 	}
-	tflog.Debug(ctx, "Created project")
+	avatarID, err := projectAvatarId(project.AvatarUrls.One6X16)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project avatar, got error: %s", err))
+		return
+	}
+	plan.AvatarId = types.Int64Value(avatarID)
+	plan.LeadAccountId = types.StringValue(project.Lead.AccountID)
+	plan.AssigneeType = types.StringValue(project.AssigneeType)
+	plan.ProjectTypeKey = types.StringValue(project.ProjectTypeKey)
+	plan.Style = types.StringValue(project.Style)
 
-	plan.ID = types.StringValue(strconv.Itoa(returnedProject.ID))
+	if project.Style == projectStyleNextGen {
+		setProjectSchemesNull(&plan)
+	} else {
+		if trashedProject != nil {
+			// The restored project keeps its previous schemes
+			resp.Diagnostics.Append(r.assignProjectSchemes(ctx, plan.ID.ValueString(), plan, jiraProjectResourceModel{})...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+		// Jira assigns default schemes to every company-managed project, even when none are provided
+		resp.Diagnostics.Append(r.readProjectSchemes(ctx, plan.ID.ValueString(), &plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	tflog.Debug(ctx, "Storing project into the state", map[string]interface{}{
 		"createNewState": fmt.Sprintf("%+v", plan),
@@ -219,60 +513,55 @@ func (r *jiraProjectResource) Read(ctx context.Context, req resource.ReadRequest
 	state.Key = types.StringValue(project.Key)
 	state.Name = types.StringValue(project.Name)
 	state.Description = types.StringValue(project.Description)
-	avatarUrl, _ := url.Parse(project.AvatarUrls.One6X16)
-	avatarID, _ := strconv.Atoi(strings.Split(avatarUrl.Path, "/")[9])
-	state.AvatarId = types.Int64Value(int64(avatarID))
-	state.LeadAccountId = types.StringValue(project.Lead.AccountID)
-	state.ProjectTypeKey = types.StringValue(project.ProjectTypeKey)
-	state.URL = types.StringValue(project.URL)
-
-	projectIDInt, _ := strconv.Atoi(projectID)
-	issueTypesSchemes, res, err := r.p.jira.Issue.Type.Scheme.Projects(ctx, []int{projectIDInt}, 0, 1)
+	avatarID, err := projectAvatarId(project.AvatarUrls.One6X16)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get issue type schemes for project, got error: %s\n%s", err.Error(), res.Bytes.String()))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project avatar, got error: %s", err))
 		return
 	}
-
-	for _, issueTypeScheme := range issueTypesSchemes.Values {
-		tflog.Info(ctx, issueTypeScheme.IssueTypeScheme.Name)
-		for issueTypeSchemeProjectId := range issueTypeScheme.ProjectIds {
-			if issueTypeSchemeProjectId == projectIDInt {
-				issueTypeSchemeId, _ := strconv.Atoi(issueTypeScheme.IssueTypeScheme.ID)
-				state.IssueTypeScheme = types.Int64Value(int64(issueTypeSchemeId))
-				break
+	state.AvatarId = types.Int64Value(avatarID)
+	if project.Category != nil {
+		categoryID, _ := strconv.Atoi(project.Category.ID)
+		state.CategoryId = types.Int64Value(int64(categoryID))
+	} else {
+		state.CategoryId = types.Int64Null()
+	}
+	// The email address of the lead is reconciled when it is visible, otherwise a change of lead
+	// made outside Terraform is detected by the account ID and shown as a change of "lead_email".
+	if !state.LeadEmail.IsNull() {
+		switch {
+		case project.Lead.EmailAddress != "":
+			if !strings.EqualFold(project.Lead.EmailAddress, state.LeadEmail.ValueString()) {
+				state.LeadEmail = types.StringValue(project.Lead.EmailAddress)
 			}
+		case state.LeadAccountId.ValueString() != project.Lead.AccountID:
+			state.LeadEmail = types.StringNull()
 		}
 	}
-
-	issueTypeScreenSchemes, res, err := r.p.jira.Issue.Type.ScreenScheme.Projects(ctx, []int{projectIDInt}, 0, 1)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get issue type screen schemes, got error: %s\n%s", err.Error(), res.Bytes.String()))
-		return
+	state.LeadAccountId = types.StringValue(project.Lead.AccountID)
+	state.AssigneeType = types.StringValue(project.AssigneeType)
+	state.ProjectTypeKey = types.StringValue(project.ProjectTypeKey)
+	state.Style = types.StringValue(project.Style)
+	state.URL = types.StringValue(project.URL)
+	// The value is not stored by Jira, so it is only missing after importing the project
+	if state.ArchiveOnDestroy.IsNull() {
+		state.ArchiveOnDestroy = types.BoolValue(false)
 	}
-
-	for _, issueTypeScreenScheme := range issueTypeScreenSchemes.Values {
-		for issueTypeScreenSchemeProjectId := range issueTypeScreenScheme.ProjectIds {
-			if issueTypeScreenSchemeProjectId == projectIDInt {
-				issueTypeScreenSchemeId, _ := strconv.Atoi(issueTypeScreenScheme.IssueTypeScreenScheme.ID)
-				state.IssueTypeScreenScheme = types.Int64Value(int64(issueTypeScreenSchemeId))
-				break
-			}
-		}
+	if state.DeleteMode.IsNull() {
+		state.DeleteMode = types.StringValue(projectDeleteModePermanent)
+	}
+	if state.DeletionProtection.IsNull() {
+		state.DeletionProtection = types.BoolValue(false)
 	}
 
-	if state.WorkflowScheme.ValueInt64() != 0 {
-		workflowScheme, res, err := r.p.jira.Workflow.Scheme.Get(ctx, int(state.WorkflowScheme.ValueInt64()), false)
-		if err != nil {
-			if res == nil {
-				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get workflow scheme, got error: %s", err.Error()))
-			} else {
-				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get workflow scheme, got error: %s\n%s", err.Error(), res.Bytes.String()))
-			}
+	// Team-managed projects do not use shared schemes, and the scheme endpoints
+	// report Jira's internal defaults for them, which would show up as drift.
+	if project.Style == projectStyleNextGen {
+		setProjectSchemesNull(&state)
+	} else {
+		resp.Diagnostics.Append(r.readProjectSchemes(ctx, projectID, &state)...)
+		if resp.Diagnostics.HasError() {
 			return
 		}
-		if workflowScheme != nil {
-			state.WorkflowScheme = types.Int64Value(int64(workflowScheme.ID))
-		}
 	}
 
 	tflog.Debug(ctx, "Storing project into the state", map[string]interface{}{
@@ -304,60 +593,72 @@ func (r *jiraProjectResource) Update(ctx context.Context, req resource.UpdateReq
 
 	projectID := state.ID.ValueString()
 
-	projectPayload := new(models.ProjectUpdateScheme)
-	projectPayload.Key = plan.Key.ValueString()
-	projectPayload.Name = plan.Name.ValueString()
-	projectPayload.Description = plan.Description.ValueString()
-	projectPayload.AvatarID = int(plan.AvatarId.ValueInt64())
-	projectPayload.ProjectTypeKey = plan.ProjectTypeKey.ValueString()
-	projectPayload.URL = plan.URL.ValueString()
-
-	returnedProject, res, err := r.p.jira.Project.Update(ctx, projectID, projectPayload)
+	leadAccountId, err := r.leadAccountId(ctx, plan)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update issue type, got error: %s\n%s", err.Error(), res.Bytes.String()))
+		resp.Diagnostics.AddAttributeError(path.Root("lead_email"), "Unable to find project lead",
+			fmt.Sprintf("%s\nIf the user hides their email address, set \"lead_account_id\" instead of \"lead_email\".", err))
 		return
 	}
-	tflog.Debug(ctx, "Updated project in API state")
 
-	response, err := r.p.jira.Issue.Type.Scheme.Assign(ctx, plan.IssueTypeScheme.String(), returnedProject.ID)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to assign issue type scheme to project, got error: %s\n%s", err.Error(), response.Bytes.String()))
+	returnedProject, diags := r.updateProject(ctx, projectID, leadAccountId, plan, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	tflog.Debug(ctx, "Assigned issue type scheme to project")
 
-	response, err = r.p.jira.Issue.Type.ScreenScheme.Assign(ctx, plan.IssueTypeScreenScheme.String(), returnedProject.ID)
+	avatarID, err := projectAvatarId(returnedProject.AvatarUrls.One6X16)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to assign issue type scheme to project, got error: %s\n%s", err.Error(), response.Bytes.String()))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read project avatar, got error: %s", err))
 		return
 	}
-	tflog.Debug(ctx, "Assigned issue type screen scheme to project")
 
-	response, err = r.p.jira.Workflow.Scheme.Assign(ctx, plan.WorkflowScheme.String(), returnedProject.ID)
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to assign workflow scheme to project, got error: %s\n%s", err.Error(), response.Bytes.String()))
+	var result = jiraProjectResourceModel{
+		ID:                       types.StringValue(returnedProject.ID),
+		Key:                      types.StringValue(returnedProject.Key),
+		Name:                     types.StringValue(returnedProject.Name),
+		Description:              types.StringValue(returnedProject.Description),
+		AvatarId:                 types.Int64Value(avatarID),
+		CategoryId:               plan.CategoryId,
+		FieldConfigurationScheme: plan.FieldConfigurationScheme,
+		IssueTypeScheme:          plan.IssueTypeScheme,
+		IssueTypeScreenScheme:    plan.IssueTypeScreenScheme,
+		LeadAccountId:            types.StringValue(returnedProject.Lead.AccountID),
+		LeadEmail:                plan.LeadEmail,
+		AssigneeType:             types.StringValue(returnedProject.AssigneeType),
+		NotificationScheme:       plan.NotificationScheme,
+		IssueSecurityScheme:      plan.IssueSecurityScheme,
+		PermissionScheme:         plan.PermissionScheme,
+		ProjectTemplateKey:       plan.ProjectTemplateKey,
+		ProjectTypeKey:           types.StringValue(returnedProject.ProjectTypeKey),
+		Style:                    types.StringValue(returnedProject.Style),
+		URL:                      types.StringValue(returnedProject.URL),
+		ArchiveOnDestroy:         plan.ArchiveOnDestroy,
+		DeleteMode:               plan.DeleteMode,
+		DeletionProtection:       plan.DeletionProtection,
+		WorkflowScheme:           plan.WorkflowScheme,
+	}
+
+	// Team-managed projects have no shared schemes to assign
+	if returnedProject.Style == projectStyleNextGen {
+		setProjectSchemesNull(&result)
+
+		tflog.Debug(ctx, "Storing project into the state")
+		resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
+		return
+	}
+
+	resp.Diagnostics.Append(r.assignProjectSchemes(ctx, returnedProject.ID, plan, state)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	tflog.Debug(ctx, "Assigned workflow scheme to project")
 
-	avatarUrl, _ := url.Parse(returnedProject.AvatarUrls.One6X16)
-	avatarID, _ := strconv.Atoi(strings.Split(avatarUrl.Path, "/")[9])
+	// Schemes which are not configured are unknown in the plan when the project uses a default scheme
+	resp.Diagnostics.Append(r.readProjectSchemes(ctx, returnedProject.ID, &result)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	var result = jiraProjectResourceModel{
-		ID:                    types.StringValue(returnedProject.ID),
-		Key:                   types.StringValue(returnedProject.Key),
-		Name:                  types.StringValue(returnedProject.Name),
-		Description:           types.StringValue(returnedProject.Description),
-		AvatarId:              types.Int64Value(int64(avatarID)),
-		IssueTypeScheme:       types.Int64Value(plan.IssueTypeScheme.ValueInt64()),
-		IssueTypeScreenScheme: types.Int64Value(plan.IssueTypeScreenScheme.ValueInt64()),
-		LeadAccountId:         types.StringValue(returnedProject.Lead.AccountID),
-		ProjectTypeKey:        types.StringValue(returnedProject.ProjectTypeKey),
-		URL:                   types.StringValue(returnedProject.URL),
-		WorkflowScheme:        types.Int64Value(plan.WorkflowScheme.ValueInt64()),
-	}
-
-	tflog.Debug(ctx, "Storing issue type into the state")
+	tflog.Debug(ctx, "Storing project into the state")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
 }
 
@@ -371,12 +672,360 @@ func (r *jiraProjectResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 	tflog.Debug(ctx, "Loaded project from state")
 
-	res, err := r.p.jira.Project.Delete(ctx, state.ID.ValueString(), false)
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError("Deletion Protection Enabled",
+			"Unable to delete project, because \"deletion_protection\" is set to true. Set it to false and apply the configuration before destroying the project.")
+		return
+	}
+
+	if state.ArchiveOnDestroy.ValueBool() {
+		res, err := r.p.jira.Project.Archive(ctx, state.ID.ValueString())
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to archive project, got error: %s\n%s", err, resBody))
+			return
+		}
+		tflog.Debug(ctx, "Archived project in API state")
+		return
+	}
+
+	// Projects deleted with undo enabled are moved to the trash
+	enableUndo := state.DeleteMode.ValueString() == projectDeleteModeTrash
+	res, err := r.p.jira.Project.Delete(ctx, state.ID.ValueString(), enableUndo)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete project, got error: %s\n%s", err, res.Bytes.String()))
 		return
 	}
-	tflog.Debug(ctx, "Deleted project from API state")
+	if enableUndo {
+		tflog.Debug(ctx, "Moved project to the trash in API state")
+	} else {
+		tflog.Debug(ctx, "Deleted project from API state")
+	}
 
 	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
 }
+
+// leadAccountId returns the account ID of the project lead, looking up the user by email address if "lead_email" is set.
+// An empty string is returned when the account ID is not known yet.
+func (r *jiraProjectResource) leadAccountId(ctx context.Context, plan jiraProjectResourceModel) (string, error) {
+	if plan.LeadEmail.IsNull() || plan.LeadEmail.IsUnknown() {
+		return plan.LeadAccountId.ValueString(), nil
+	}
+	return findJiraUserAccountIdByEmail(ctx, r.p.jira, plan.LeadEmail.ValueString())
+}
+
+// updateProject updates the project with the values of the plan, including the project lead.
+func (r *jiraProjectResource) updateProject(ctx context.Context, projectID, leadAccountId string, plan, state jiraProjectResourceModel) (*models.ProjectScheme, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	projectPayload := new(models.ProjectUpdateScheme)
+	// Jira keeps the previous key of a project as an alias, so issue keys using it still resolve after the key is changed.
+	projectPayload.Key = plan.Key.ValueString()
+	projectPayload.Name = plan.Name.ValueString()
+	projectPayload.Description = plan.Description.ValueString()
+	projectPayload.AvatarID = int(plan.AvatarId.ValueInt64())
+	projectPayload.CategoryID = int(plan.CategoryId.ValueInt64())
+	if plan.CategoryId.IsNull() && !state.CategoryId.IsNull() {
+		// A value of -1 removes the project category from the project
+		projectPayload.CategoryID = -1
+	}
+	projectPayload.NotificationScheme = int(plan.NotificationScheme.ValueInt64())
+	projectPayload.IssueSecurityScheme = int(plan.IssueSecurityScheme.ValueInt64())
+	projectPayload.AssigneeType = plan.AssigneeType.ValueString()
+	projectPayload.ProjectTypeKey = plan.ProjectTypeKey.ValueString()
+	projectPayload.URL = plan.URL.ValueString()
+
+	returnedProject, res, err := r.p.jira.Project.Update(ctx, projectID, projectPayload)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update project, got error: %s\n%s", err, resBody))
+		return nil, diags
+	}
+	tflog.Debug(ctx, "Updated project in API state")
+
+	if leadAccountId != "" && leadAccountId != returnedProject.Lead.AccountID {
+		// models.ProjectUpdateScheme does not support "leadAccountId", so the project lead is changed with a separate request.
+		request, err := r.p.jira.NewRequest(ctx, http.MethodPut, fmt.Sprintf("rest/api/3/project/%s", projectID), "", map[string]string{"leadAccountId": leadAccountId})
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to create project lead request, got error: %s", err))
+			return nil, diags
+		}
+		res, err := r.p.jira.Call(request, nil)
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			diags.AddError("Client Error", fmt.Sprintf("Unable to update project lead, got error: %s\n%s", err, resBody))
+			return nil, diags
+		}
+		tflog.Debug(ctx, "Updated project lead in API state")
+		returnedProject.Lead.AccountID = leadAccountId
+	}
+
+	return returnedProject, diags
+}
+
+// assignProjectSchemes assigns the schemes of the plan that differ from the state to a company-managed project.
+// The schemes can also be managed by their own resources, so they are only assigned when they change.
+func (r *jiraProjectResource) assignProjectSchemes(ctx context.Context, projectID string, plan, state jiraProjectResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !plan.FieldConfigurationScheme.IsNull() && !plan.FieldConfigurationScheme.IsUnknown() && !plan.FieldConfigurationScheme.Equal(state.FieldConfigurationScheme) {
+		payload := &models.FieldConfigurationSchemeAssignPayload{
+			FieldConfigurationSchemeID: strconv.FormatInt(plan.FieldConfigurationScheme.ValueInt64(), 10),
+			ProjectID:                  projectID,
+		}
+		res, err := r.p.jira.Issue.Field.Configuration.Scheme.Assign(ctx, payload)
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			diags.AddError("Client Error", fmt.Sprintf("Unable to assign field configuration scheme to project, got error: %s\n%s", err, resBody))
+			return diags
+		}
+		tflog.Debug(ctx, "Assigned field configuration scheme to project")
+	}
+
+	if !plan.IssueTypeScheme.IsNull() && !plan.IssueTypeScheme.IsUnknown() && !plan.IssueTypeScheme.Equal(state.IssueTypeScheme) {
+		response, err := r.p.jira.Issue.Type.Scheme.Assign(ctx, plan.IssueTypeScheme.String(), projectID)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to assign issue type scheme to project, got error: %s\n%s", err.Error(), response.Bytes.String()))
+			return diags
+		}
+		tflog.Debug(ctx, "Assigned issue type scheme to project")
+	}
+
+	if !plan.IssueTypeScreenScheme.IsNull() && !plan.IssueTypeScreenScheme.IsUnknown() && !plan.IssueTypeScreenScheme.Equal(state.IssueTypeScreenScheme) {
+		response, err := r.p.jira.Issue.Type.ScreenScheme.Assign(ctx, plan.IssueTypeScreenScheme.String(), projectID)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to assign issue type screen scheme to project, got error: %s\n%s", err.Error(), response.Bytes.String()))
+			return diags
+		}
+		tflog.Debug(ctx, "Assigned issue type screen scheme to project")
+	}
+
+	// The default permission scheme has an ID of 0, which the project update payload would omit, so it is assigned separately.
+	if !plan.PermissionScheme.IsNull() && !plan.PermissionScheme.IsUnknown() && !plan.PermissionScheme.Equal(state.PermissionScheme) {
+		_, res, err := r.p.jira.Project.Permission.Assign(ctx, projectID, int(plan.PermissionScheme.ValueInt64()))
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			diags.AddError("Client Error", fmt.Sprintf("Unable to assign permission scheme to project, got error: %s\n%s", err, resBody))
+			return diags
+		}
+		tflog.Debug(ctx, "Assigned permission scheme to project")
+	}
+
+	// Switching the workflow scheme migrates the issues of the project to the statuses of the new workflows.
+	if !plan.WorkflowScheme.IsNull() && !plan.WorkflowScheme.IsUnknown() && !plan.WorkflowScheme.Equal(state.WorkflowScheme) {
+		payload := &jiraWorkflowSchemeSwitch{
+			ProjectId:      projectID,
+			TargetSchemeId: strconv.FormatInt(plan.WorkflowScheme.ValueInt64(), 10),
+		}
+		if err := switchProjectWorkflowScheme(ctx, r.p.jira, payload); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to assign workflow scheme to project, got error: %s", err))
+			return diags
+		}
+		tflog.Debug(ctx, "Assigned workflow scheme to project")
+	}
+
+	return diags
+}
+
+// findTrashedProject returns the project in the trash with the given key, or nil if there is none.
+func (r *jiraProjectResource) findTrashedProject(ctx context.Context, key string) (*models.ProjectScheme, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	options := &models.ProjectSearchOptionsScheme{
+		Keys:   []string{key},
+		Status: []string{"deleted"},
+	}
+	page, res, err := r.p.jira.Project.Search(ctx, options, 0, 1)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		diags.AddError("Client Error", fmt.Sprintf("Unable to search projects in the trash, got error: %s\n%s", err, resBody))
+		return nil, diags
+	}
+
+	for _, project := range page.Values {
+		if project.Deleted && project.Key == key {
+			return project, diags
+		}
+	}
+	return nil, diags
+}
+
+// projectAvatarId returns the ID of the avatar referenced by an avatar URL of a project.
+// Avatar URLs either end with the avatar ID, e.g. `/rest/api/3/universal_avatar/view/type/project/avatar/10400`,
+// or pass it in the `avatarId` query parameter, e.g. `/secure/projectavatar?pid=10000&avatarId=10400`.
+func projectAvatarId(avatarUrl string) (int64, error) {
+	u, err := url.Parse(avatarUrl)
+	if err != nil {
+		return 0, fmt.Errorf("unable to parse avatar URL %q: %w", avatarUrl, err)
+	}
+
+	id := u.Query().Get("avatarId")
+	if id == "" {
+		id = u.Path[strings.LastIndex(u.Path, "/")+1:]
+	}
+	avatarID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("unable to find avatar ID in URL %q", avatarUrl)
+	}
+
+	return avatarID, nil
+}
+
+// setProjectSchemesNull removes the scheme attributes, which do not apply to team-managed projects.
+func setProjectSchemesNull(model *jiraProjectResourceModel) {
+	model.FieldConfigurationScheme = types.Int64Null()
+	model.IssueSecurityScheme = types.Int64Null()
+	model.IssueTypeScheme = types.Int64Null()
+	model.IssueTypeScreenScheme = types.Int64Null()
+	model.NotificationScheme = types.Int64Null()
+	model.PermissionScheme = types.Int64Null()
+	model.WorkflowScheme = types.Int64Null()
+}
+
+// readProjectSchemes sets the scheme attributes of a company-managed project from the schemes associated with it in Jira.
+func (r *jiraProjectResource) readProjectSchemes(ctx context.Context, projectID string, model *jiraProjectResourceModel) diag.Diagnostics {
+	schemes, diags := readJiraProjectSchemes(ctx, r.p.jira, projectID)
+	if diags.HasError() {
+		return diags
+	}
+
+	model.FieldConfigurationScheme = schemes.FieldConfigurationScheme
+	model.IssueSecurityScheme = schemes.IssueSecurityScheme
+	model.IssueTypeScheme = schemes.IssueTypeScheme
+	model.IssueTypeScreenScheme = schemes.IssueTypeScreenScheme
+	model.NotificationScheme = schemes.NotificationScheme
+	model.PermissionScheme = schemes.PermissionScheme
+	model.WorkflowScheme = schemes.WorkflowScheme
+	return diags
+}
+
+// readJiraProjectSchemes returns the IDs of the schemes associated with a company-managed project in Jira.
+// Schemes that are not set are null, e.g. when the project uses the default field configuration scheme.
+func readJiraProjectSchemes(ctx context.Context, client *jira.Client, projectID string) (jiraProjectSchemes, diag.Diagnostics) {
+	var schemes jiraProjectSchemes
+	var diags diag.Diagnostics
+
+	projectIDInt, err := strconv.Atoi(projectID)
+	if err != nil {
+		diags.AddAttributeError(path.Root("id"), "Unable to parse value of \"id\" attribute.", "Value of \"id\" attribute can only be a numeric string.")
+		return schemes, diags
+	}
+
+	fieldConfigurationSchemes, res, err := client.Issue.Field.Configuration.Scheme.Project(ctx, []int{projectIDInt}, 0, 50)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		diags.AddError("Client Error", fmt.Sprintf("Unable to get field configuration scheme of project, got error: %s\n%s", err, resBody))
+		return schemes, diags
+	}
+	schemes.FieldConfigurationScheme = types.Int64Null()
+	for _, v := range fieldConfigurationSchemes.Values {
+		// Projects using the default field configuration scheme are returned without a scheme.
+		if v.FieldConfigurationScheme != nil && containsString(v.ProjectIds, projectID) {
+			schemeId, _ := strconv.ParseInt(v.FieldConfigurationScheme.ID, 10, 64)
+			schemes.FieldConfigurationScheme = types.Int64Value(schemeId)
+		}
+	}
+
+	issueTypeSchemes, res, err := client.Issue.Type.Scheme.Projects(ctx, []int{projectIDInt}, 0, 50)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		diags.AddError("Client Error", fmt.Sprintf("Unable to get issue type scheme of project, got error: %s\n%s", err, resBody))
+		return schemes, diags
+	}
+	schemes.IssueTypeScheme = types.Int64Null()
+	for _, v := range issueTypeSchemes.Values {
+		if v.IssueTypeScheme != nil && containsString(v.ProjectIds, projectID) {
+			schemeId, _ := strconv.ParseInt(v.IssueTypeScheme.ID, 10, 64)
+			schemes.IssueTypeScheme = types.Int64Value(schemeId)
+		}
+	}
+
+	issueTypeScreenSchemes, res, err := client.Issue.Type.ScreenScheme.Projects(ctx, []int{projectIDInt}, 0, 50)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		diags.AddError("Client Error", fmt.Sprintf("Unable to get issue type screen scheme of project, got error: %s\n%s", err, resBody))
+		return schemes, diags
+	}
+	schemes.IssueTypeScreenScheme = types.Int64Null()
+	for _, v := range issueTypeScreenSchemes.Values {
+		if v.IssueTypeScreenScheme != nil && containsString(v.ProjectIds, projectID) {
+			schemeId, _ := strconv.ParseInt(v.IssueTypeScreenScheme.ID, 10, 64)
+			schemes.IssueTypeScreenScheme = types.Int64Value(schemeId)
+		}
+	}
+
+	workflowSchemeId, err := getProjectWorkflowSchemeId(ctx, client, projectID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to get workflow scheme of project, got error: %s", err))
+		return schemes, diags
+	}
+	// Projects using the default workflow scheme are returned without a scheme ID.
+	schemes.WorkflowScheme = types.Int64Null()
+	if workflowSchemeId != "" {
+		schemeId, _ := strconv.ParseInt(workflowSchemeId, 10, 64)
+		schemes.WorkflowScheme = types.Int64Value(schemeId)
+	}
+
+	notificationScheme, res, err := client.Project.NotificationScheme(ctx, projectID, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		diags.AddError("Client Error", fmt.Sprintf("Unable to get project notification scheme, got error: %s\n%s", err, resBody))
+		return schemes, diags
+	}
+	schemes.NotificationScheme = types.Int64Value(int64(notificationScheme.ID))
+
+	permissionScheme, res, err := client.Project.Permission.Get(ctx, projectID, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		diags.AddError("Client Error", fmt.Sprintf("Unable to get project permission scheme, got error: %s\n%s", err, resBody))
+		return schemes, diags
+	}
+	schemes.PermissionScheme = types.Int64Value(int64(permissionScheme.ID))
+
+	issueSecuritySchemeId, err := getProjectIssueSecuritySchemeId(ctx, client, projectID)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to get project issue security scheme, got error: %s", err))
+		return schemes, diags
+	}
+	// Projects do not have an issue security scheme unless one is assigned.
+	schemes.IssueSecurityScheme = types.Int64Null()
+	if issueSecuritySchemeId != "" {
+		schemeId, _ := strconv.ParseInt(issueSecuritySchemeId, 10, 64)
+		schemes.IssueSecurityScheme = types.Int64Value(schemeId)
+	}
+
+	return schemes, diags
+}
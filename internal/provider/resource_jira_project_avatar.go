@@ -0,0 +1,345 @@
+package atlassian
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraProjectAvatarResource struct {
+		p atlassianProvider
+	}
+
+	jiraProjectAvatarResourceModel struct {
+		ID            types.String `tfsdk:"id"`
+		ProjectKey    types.String `tfsdk:"project_key"`
+		Source        types.String `tfsdk:"source"`
+		ContentBase64 types.String `tfsdk:"content_base64"`
+	}
+
+	// The go-atlassian library does not support avatars.
+	jiraAvatar struct {
+		ID             string `json:"id"`
+		IsSystemAvatar bool   `json:"isSystemAvatar"`
+		IsSelected     bool   `json:"isSelected"`
+	}
+
+	jiraAvatars struct {
+		System []jiraAvatar `json:"system"`
+		Custom []jiraAvatar `json:"custom"`
+	}
+)
+
+var (
+	_ resource.Resource                = (*jiraProjectAvatarResource)(nil)
+	_ resource.ResourceWithImportState = (*jiraProjectAvatarResource)(nil)
+)
+
+func NewJiraProjectAvatarResource() resource.Resource {
+	return &jiraProjectAvatarResource{}
+}
+
+func (*jiraProjectAvatarResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_project_avatar"
+}
+
+func (*jiraProjectAvatarResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Project Avatar Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the avatar.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_key": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The key of the project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The path to a local GIF, JPEG or PNG image file. " +
+					"Changes to the content of the file are not detected, use `content_base64` with `filebase64` instead if needed. " +
+					"Conflicts with `content_base64`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("content_base64")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content_base64": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The base64-encoded content of a GIF, JPEG or PNG image. Conflicts with `source`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *jiraProjectAvatarResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.p.jira = client
+}
+
+func (*jiraProjectAvatarResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "/")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: project_key/id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_key"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+}
+
+func (r *jiraProjectAvatarResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating project avatar resource")
+
+	var plan jiraProjectAvatarResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded project avatar plan", map[string]interface{}{
+		"createPlan": fmt.Sprintf("%+v", plan),
+	})
+
+	img, err := avatarImage(plan.Source, plan.ContentBase64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Avatar Image", fmt.Sprintf("Unable to read avatar image, got error: %s", err))
+		return
+	}
+	avatar, err := uploadAvatar(ctx, r.p.jira, fmt.Sprintf("rest/api/3/project/%s/avatar2", plan.ProjectKey.ValueString()), img)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create project avatar, got error: %s", err))
+		return
+	}
+	tflog.Debug(ctx, "Created project avatar")
+
+	plan.ID = types.StringValue(avatar.ID)
+
+	if err := r.selectAvatar(ctx, plan.ProjectKey.ValueString(), avatar.ID); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set project avatar, got error: %s", err))
+		return
+	}
+	tflog.Debug(ctx, "Set project avatar")
+
+	tflog.Debug(ctx, "Storing project avatar into the state", map[string]interface{}{
+		"createNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraProjectAvatarResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading project avatar resource")
+
+	var state jiraProjectAvatarResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded project avatar from state", map[string]interface{}{
+		"readState": fmt.Sprintf("%+v", state),
+	})
+
+	avatars, err := getAvatars(ctx, r.p.jira, fmt.Sprintf("rest/api/3/project/%s/avatars", state.ProjectKey.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get project avatars, got error: %s", err))
+		return
+	}
+	tflog.Debug(ctx, "Retrieved project avatars from API state")
+
+	if avatars == nil || findAvatar(avatars.Custom, state.ID.ValueString()) == nil {
+		tflog.Warn(ctx, "Unable to find project avatar in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	tflog.Debug(ctx, "Storing project avatar into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", state),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraProjectAvatarResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// The RequiresReplace plan modifier will trigger Terraform to destroy and recreate the resource
+	// if any of the required attributes changes, i.e. project_key, source and/or content_base64.
+	tflog.Debug(ctx, "If the value of any required attribute changes, Terraform will destroy and recreate the resource")
+}
+
+func (r *jiraProjectAvatarResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting project avatar resource")
+
+	var state jiraProjectAvatarResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded project avatar from state")
+
+	avatars, err := getAvatars(ctx, r.p.jira, fmt.Sprintf("rest/api/3/project/%s/avatars", state.ProjectKey.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get project avatars, got error: %s", err))
+		return
+	}
+	// The selected avatar cannot be deleted, so fall back to the default system avatar first.
+	if avatars != nil && len(avatars.System) > 0 {
+		if avatar := findAvatar(avatars.Custom, state.ID.ValueString()); avatar != nil && avatar.IsSelected {
+			if err := r.selectAvatar(ctx, state.ProjectKey.ValueString(), avatars.System[0].ID); err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reset project avatar, got error: %s", err))
+				return
+			}
+		}
+	}
+
+	request, err := r.p.jira.NewRequest(ctx, http.MethodDelete, fmt.Sprintf("rest/api/3/project/%s/avatar/%s", state.ProjectKey.ValueString(), state.ID.ValueString()), "", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create project avatar request, got error: %s", err))
+		return
+	}
+	res, err := r.p.jira.Call(request, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete project avatar, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Deleted project avatar from API state")
+
+	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
+}
+
+func (r *jiraProjectAvatarResource) selectAvatar(ctx context.Context, projectKey, avatarId string) error {
+	request, err := r.p.jira.NewRequest(ctx, http.MethodPut, fmt.Sprintf("rest/api/3/project/%s/avatar", projectKey), "", map[string]string{"id": avatarId})
+	if err != nil {
+		return err
+	}
+	res, err := r.p.jira.Call(request, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		return fmt.Errorf("%s\n%s", err, resBody)
+	}
+	return nil
+}
+
+// avatarImage returns the avatar image read from either a local file or base64-encoded content.
+func avatarImage(source, contentBase64 types.String) ([]byte, error) {
+	if !source.IsNull() {
+		return os.ReadFile(source.ValueString())
+	}
+	return base64.StdEncoding.DecodeString(contentBase64.ValueString())
+}
+
+// uploadAvatar loads a custom avatar image, detecting its content type from the image itself.
+// The avatar is cropped to the largest square starting at the top left corner of the image.
+func uploadAvatar(ctx context.Context, client *jira.Client, apiEndpoint string, img []byte) (*jiraAvatar, error) {
+	config, _, err := image.DecodeConfig(bytes.NewReader(img))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode avatar image: %s", err)
+	}
+	size := config.Width
+	if config.Height < size {
+		size = config.Height
+	}
+	params := url.Values{}
+	params.Add("x", "0")
+	params.Add("y", "0")
+	params.Add("size", strconv.Itoa(size))
+	request, err := client.NewRequest(ctx, http.MethodPost, fmt.Sprintf("%s?%s", apiEndpoint, params.Encode()), http.DetectContentType(img), bytes.NewBuffer(img))
+	if err != nil {
+		return nil, err
+	}
+	avatar := new(jiraAvatar)
+	res, err := client.Call(request, avatar)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		return nil, fmt.Errorf("%s\n%s", err, resBody)
+	}
+	return avatar, nil
+}
+
+// getAvatars returns the system and custom avatars of an entity, or nil if the entity does not exist.
+func getAvatars(ctx context.Context, client *jira.Client, apiEndpoint string) (*jiraAvatars, error) {
+	request, err := client.NewRequest(ctx, http.MethodGet, apiEndpoint, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	avatars := new(jiraAvatars)
+	res, err := client.Call(request, avatars)
+	if res != nil && res.Code == http.StatusNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		return nil, fmt.Errorf("%s\n%s", err, resBody)
+	}
+	return avatars, nil
+}
+
+func findAvatar(avatars []jiraAvatar, id string) *jiraAvatar {
+	for i := range avatars {
+		if avatars[i].ID == id {
+			return &avatars[i]
+		}
+	}
+	return nil
+}
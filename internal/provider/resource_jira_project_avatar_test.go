@@ -0,0 +1,66 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// testAccAvatarImage is a base64-encoded 1x1 PNG image.
+const testAccAvatarImage = "iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAYAAAAfFcSJAAAADUlEQVR42mNkYPhfDwAChwGA60e6kgAAAABJRU5ErkJggg=="
+
+func TestAccJiraProjectAvatar_Basic(t *testing.T) {
+	randomKey := strings.ToUpper(acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
+	randomName := acctest.RandomWithPrefix("tf-test-project-avatar")
+	resourceName := "atlassian_jira_project_avatar.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectAvatarConfig_basic(resourceName, randomKey, randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "project_key", randomKey),
+					resource.TestCheckResourceAttr(resourceName, "content_base64", testAccAvatarImage),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccProjectAvatarImportConfig,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"content_base64"},
+			},
+		},
+	})
+}
+
+func testAccProjectAvatarImportConfig(s *terraform.State) (string, error) {
+	projectKey := s.RootModule().Resources["atlassian_jira_project_avatar.test"].Primary.Attributes["project_key"]
+	id := s.RootModule().Resources["atlassian_jira_project_avatar.test"].Primary.Attributes["id"]
+	return fmt.Sprintf("%s/%s", projectKey, id), nil
+}
+
+func testAccProjectAvatarConfig_basic(resourceName, key, name string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	data "atlassian_jira_myself" "test" {}
+
+	resource "atlassian_jira_project" "test" {
+		key              = %[3]q
+		name             = %[4]q
+		lead_account_id  = data.atlassian_jira_myself.test.account_id
+		project_type_key = "software"
+	}
+
+	resource %[1]q %[2]q {
+		project_key    = atlassian_jira_project.test.key
+		content_base64 = %[5]q
+	}
+	`, splits[0], splits[1], key, name, testAccAvatarImage)
+}
@@ -0,0 +1,300 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openscientia/terraform-provider-atlassian/internal/provider/planmodifiers/stringmodifiers"
+)
+
+type (
+	jiraProjectComponentResource struct {
+		p atlassianProvider
+	}
+
+	jiraProjectComponentResourceModel struct {
+		ID            types.String `tfsdk:"id"`
+		ProjectKey    types.String `tfsdk:"project_key"`
+		Name          types.String `tfsdk:"name"`
+		Description   types.String `tfsdk:"description"`
+		LeadAccountId types.String `tfsdk:"lead_account_id"`
+		AssigneeType  types.String `tfsdk:"assignee_type"`
+	}
+)
+
+var (
+	_ resource.Resource                = (*jiraProjectComponentResource)(nil)
+	_ resource.ResourceWithImportState = (*jiraProjectComponentResource)(nil)
+)
+
+func NewJiraProjectComponentResource() resource.Resource {
+	return &jiraProjectComponentResource{}
+}
+
+func (*jiraProjectComponentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_project_component"
+}
+
+func (*jiraProjectComponentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Project Component Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the component.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_key": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The key of the project the component is assigned to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The unique name for the component in the project. The maximum length is 255 characters.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the component.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringmodifiers.DefaultValue(""),
+				},
+			},
+			"lead_account_id": schema.StringAttribute{
+				MarkdownDescription: "The account ID of the component's lead user.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringmodifiers.DefaultValue(""),
+				},
+			},
+			"assignee_type": schema.StringAttribute{
+				MarkdownDescription: "The nominal user type used to determine the assignee for issues created with this component. " +
+					"Can be one of: `PROJECT_DEFAULT`, `COMPONENT_LEAD`, `PROJECT_LEAD`, `UNASSIGNED`. Defaults to `PROJECT_DEFAULT`.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringmodifiers.DefaultValue("PROJECT_DEFAULT"),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("PROJECT_DEFAULT", "COMPONENT_LEAD", "PROJECT_LEAD", "UNASSIGNED"),
+				},
+			},
+		},
+	}
+}
+
+func (r *jiraProjectComponentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.p.jira = client
+}
+
+func (*jiraProjectComponentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError("Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: project_key/id. Got: %q", req.ID))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Importing project component with import identifier: %+v", idParts))
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_key"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+}
+
+func (r *jiraProjectComponentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating project component resource")
+
+	var plan jiraProjectComponentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded project component plan", map[string]interface{}{
+		"createPlan": fmt.Sprintf("%+v", plan),
+	})
+
+	payload := &models.ComponentPayloadScheme{
+		Name:          plan.Name.ValueString(),
+		Description:   plan.Description.ValueString(),
+		Project:       plan.ProjectKey.ValueString(),
+		AssigneeType:  plan.AssigneeType.ValueString(),
+		LeadAccountID: plan.LeadAccountId.ValueString(),
+	}
+	component, res, err := r.p.jira.Project.Component.Create(ctx, payload)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create project component, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Created project component in API state")
+
+	plan.ID = types.StringValue(component.ID)
+
+	tflog.Debug(ctx, "Storing project component into the state", map[string]interface{}{
+		"createNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraProjectComponentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading project component resource")
+
+	var state jiraProjectComponentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded project component from state", map[string]interface{}{
+		"readState": fmt.Sprintf("%+v", state),
+	})
+
+	component, res, err := r.p.jira.Project.Component.Get(ctx, state.ID.ValueString())
+	if res != nil && res.Code == http.StatusNotFound {
+		tflog.Warn(ctx, "Unable to find project component in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get project component, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Retrieved project component from API state")
+
+	state.ProjectKey = types.StringValue(component.Project)
+	state.Name = types.StringValue(component.Name)
+	state.Description = types.StringValue(component.Description)
+	state.AssigneeType = types.StringValue(component.AssigneeType)
+	if component.Lead != nil {
+		state.LeadAccountId = types.StringValue(component.Lead.AccountID)
+	} else {
+		state.LeadAccountId = types.StringValue("")
+	}
+
+	tflog.Debug(ctx, "Storing project component into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", state),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraProjectComponentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating project component resource")
+
+	var plan jiraProjectComponentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded project component plan", map[string]interface{}{
+		"updatePlan": fmt.Sprintf("%+v", plan),
+	})
+
+	var state jiraProjectComponentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded project component from state", map[string]interface{}{
+		"updateState": fmt.Sprintf("%+v", state),
+	})
+
+	// models.ComponentPayloadScheme omits empty values, which prevents removing the description or the lead.
+	payload := map[string]interface{}{
+		"name":          plan.Name.ValueString(),
+		"description":   plan.Description.ValueString(),
+		"assigneeType":  plan.AssigneeType.ValueString(),
+		"leadAccountId": nil,
+	}
+	if plan.LeadAccountId.ValueString() != "" {
+		payload["leadAccountId"] = plan.LeadAccountId.ValueString()
+	}
+	request, err := r.p.jira.NewRequest(ctx, http.MethodPut, fmt.Sprintf("rest/api/3/component/%s", state.ID.ValueString()), "", payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create project component request, got error: %s", err))
+		return
+	}
+
+	res, err := r.p.jira.Call(request, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update project component, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Updated project component in API state")
+
+	plan.ID = state.ID
+
+	tflog.Debug(ctx, "Storing project component into the state", map[string]interface{}{
+		"updateNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraProjectComponentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting project component resource")
+
+	var state jiraProjectComponentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded project component from state")
+
+	res, err := r.p.jira.Project.Component.Delete(ctx, state.ID.ValueString())
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete project component, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Deleted project component from API state")
+
+	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
+}
@@ -0,0 +1,107 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccJiraProjectComponent_Basic(t *testing.T) {
+	randomKey := strings.ToUpper(acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
+	randomName := acctest.RandomWithPrefix("tf-test-project-component")
+	resourceName := "atlassian_jira_project_component.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectComponentConfig_basic(resourceName, randomKey, randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "project_key", randomKey),
+					resource.TestCheckResourceAttr(resourceName, "name", randomName),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "lead_account_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "assignee_type", "PROJECT_DEFAULT"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccProjectComponentImportConfig,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccJiraProjectComponent_Lead(t *testing.T) {
+	randomKey := strings.ToUpper(acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
+	randomName := acctest.RandomWithPrefix("tf-test-project-component")
+	resourceName := "atlassian_jira_project_component.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectComponentConfig_lead(resourceName, randomKey, randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "lead_account_id", "data.atlassian_jira_myself.test", "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "assignee_type", "COMPONENT_LEAD"),
+				),
+			},
+			{
+				Config: testAccProjectComponentConfig_basic(resourceName, randomKey, randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "lead_account_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "assignee_type", "PROJECT_DEFAULT"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProjectComponentImportConfig(s *terraform.State) (string, error) {
+	projectKey := s.RootModule().Resources["atlassian_jira_project_component.test"].Primary.Attributes["project_key"]
+	id := s.RootModule().Resources["atlassian_jira_project_component.test"].Primary.Attributes["id"]
+	return fmt.Sprintf("%s/%s", projectKey, id), nil
+}
+
+func testAccProjectComponentConfig_project(key, name string) string {
+	return fmt.Sprintf(`
+	data "atlassian_jira_myself" "test" {}
+
+	resource "atlassian_jira_project" "test" {
+		key              = %[1]q
+		name             = %[2]q
+		lead_account_id  = data.atlassian_jira_myself.test.account_id
+		project_type_key = "software"
+	}
+	`, key, name)
+}
+
+func testAccProjectComponentConfig_basic(resourceName, key, name string) string {
+	splits := strings.Split(resourceName, ".")
+	return testAccProjectComponentConfig_project(key, name) + fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		project_key = atlassian_jira_project.test.key
+		name        = %[3]q
+	}
+	`, splits[0], splits[1], name)
+}
+
+func testAccProjectComponentConfig_lead(resourceName, key, name string) string {
+	splits := strings.Split(resourceName, ".")
+	return testAccProjectComponentConfig_project(key, name) + fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		project_key     = atlassian_jira_project.test.key
+		name            = %[3]q
+		lead_account_id = data.atlassian_jira_myself.test.account_id
+		assignee_type   = "COMPONENT_LEAD"
+	}
+	`, splits[0], splits[1], name)
+}
@@ -0,0 +1,60 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraProjectEmail_Basic(t *testing.T) {
+	randomKey := strings.ToUpper(acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
+	randomName := acctest.RandomWithPrefix("tf-test-project-email")
+	resourceName := "atlassian_jira_project_email.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectEmailConfig_basic(resourceName, randomKey, randomName, "jira@example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", "atlassian_jira_project.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "project_id", "atlassian_jira_project.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "email_address", "jira@example.com"),
+				),
+			},
+			{
+				Config: testAccProjectEmailConfig_basic(resourceName, randomKey, randomName, "support@example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "email_address", "support@example.com"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccProjectEmailConfig_basic(resourceName, key, name, email string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	data "atlassian_jira_myself" "test" {}
+
+	resource "atlassian_jira_project" "test" {
+		key              = %[3]q
+		name             = %[4]q
+		lead_account_id  = data.atlassian_jira_myself.test.account_id
+		project_type_key = "software"
+	}
+
+	resource %[1]q %[2]q {
+		project_id    = atlassian_jira_project.test.id
+		email_address = %[5]q
+	}
+	`, splits[0], splits[1], key, name, email)
+}
@@ -0,0 +1,245 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraProjectFeatureResource struct {
+		p atlassianProvider
+	}
+
+	jiraProjectFeatureResourceModel struct {
+		ID         types.String `tfsdk:"id"`
+		ProjectKey types.String `tfsdk:"project_key"`
+		Feature    types.String `tfsdk:"feature"`
+		Enabled    types.Bool   `tfsdk:"enabled"`
+	}
+)
+
+var (
+	_ resource.Resource                = (*jiraProjectFeatureResource)(nil)
+	_ resource.ResourceWithImportState = (*jiraProjectFeatureResource)(nil)
+)
+
+func NewJiraProjectFeatureResource() resource.Resource {
+	return &jiraProjectFeatureResource{}
+}
+
+func (*jiraProjectFeatureResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_project_feature"
+}
+
+func (*jiraProjectFeatureResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Project Feature Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project feature. It is computed using `project_key` and `feature` separated by a slash (`/`).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_key": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The key of the project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"feature": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The key of the feature, e.g. `jsw.agility.backlog`, `jsw.agility.sprints`, " +
+					"`jsw.classic.reports` or `jsw.agility.releases`.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the feature is enabled for the project.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *jiraProjectFeatureResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.p.jira = client
+}
+
+func (*jiraProjectFeatureResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "/")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: project_key/feature. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_key"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("feature"), idParts[1])...)
+}
+
+func (r *jiraProjectFeatureResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating project feature resource")
+
+	var plan jiraProjectFeatureResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded project feature plan", map[string]interface{}{
+		"createPlan": fmt.Sprintf("%+v", plan),
+	})
+
+	_, res, err := r.p.jira.Project.Feature.Set(ctx, plan.ProjectKey.ValueString(), plan.Feature.ValueString(), projectFeatureState(plan.Enabled.ValueBool()))
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create project feature, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Created project feature")
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s/%s", plan.ProjectKey.ValueString(), plan.Feature.ValueString()))
+
+	tflog.Debug(ctx, "Storing project feature into the state", map[string]interface{}{
+		"createNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraProjectFeatureResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading project feature resource")
+
+	var state jiraProjectFeatureResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded project feature from state", map[string]interface{}{
+		"readState": fmt.Sprintf("%+v", state),
+	})
+
+	features, res, err := r.p.jira.Project.Feature.Gets(ctx, state.ProjectKey.ValueString())
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get project features, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Retrieved project features from API state")
+
+	found := false
+	for _, f := range features.Features {
+		if f.Feature == state.Feature.ValueString() {
+			state.Enabled = types.BoolValue(f.State == projectFeatureState(true))
+			found = true
+			break
+		}
+	}
+	if !found {
+		tflog.Warn(ctx, "Unable to find project feature in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.ID = types.StringValue(fmt.Sprintf("%s/%s", state.ProjectKey.ValueString(), state.Feature.ValueString()))
+
+	tflog.Debug(ctx, "Storing project feature into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", state),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraProjectFeatureResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating project feature resource")
+
+	var plan jiraProjectFeatureResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded project feature plan", map[string]interface{}{
+		"updatePlan": fmt.Sprintf("%+v", plan),
+	})
+
+	var state jiraProjectFeatureResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded project feature from state", map[string]interface{}{
+		"updateState": fmt.Sprintf("%+v", state),
+	})
+
+	_, res, err := r.p.jira.Project.Feature.Set(ctx, plan.ProjectKey.ValueString(), plan.Feature.ValueString(), projectFeatureState(plan.Enabled.ValueBool()))
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update project feature, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Updated project feature in API state")
+
+	plan.ID = state.ID
+
+	tflog.Debug(ctx, "Storing project feature into the state", map[string]interface{}{
+		"updateNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraProjectFeatureResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Project features cannot be deleted, so the feature is left in its current state.
+	tflog.Debug(ctx, "Project features cannot be deleted, removing project feature from state only")
+
+	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
+}
+
+func projectFeatureState(enabled bool) string {
+	if enabled {
+		return "ENABLED"
+	}
+	return "DISABLED"
+}
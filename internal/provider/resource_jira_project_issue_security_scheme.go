@@ -0,0 +1,374 @@
+package atlassian
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraProjectIssueSecuritySchemeResource struct {
+		p atlassianProvider
+	}
+
+	jiraProjectIssueSecuritySchemeResourceModel struct {
+		ID                    types.String `tfsdk:"id"`
+		ProjectId             types.String `tfsdk:"project_id"`
+		IssueSecuritySchemeId types.String `tfsdk:"issue_security_scheme_id"`
+		LevelMappings         types.Map    `tfsdk:"level_mappings"`
+	}
+
+	// The go-atlassian library does not support associating issue security schemes with projects.
+	jiraProjectIssueSecuritySchemeAssociation struct {
+		ProjectId                     string                          `json:"projectId"`
+		SchemeId                      *string                         `json:"schemeId"`
+		OldToNewSecurityLevelMappings []jiraIssueSecurityLevelMapping `json:"oldToNewSecurityLevelMappings,omitempty"`
+	}
+
+	jiraIssueSecurityLevelMapping struct {
+		OldLevelId string `json:"oldLevelId"`
+		NewLevelId string `json:"newLevelId"`
+	}
+)
+
+var (
+	_ resource.Resource                = (*jiraProjectIssueSecuritySchemeResource)(nil)
+	_ resource.ResourceWithImportState = (*jiraProjectIssueSecuritySchemeResource)(nil)
+)
+
+func NewJiraProjectIssueSecuritySchemeResource() resource.Resource {
+	return &jiraProjectIssueSecuritySchemeResource{}
+}
+
+func (*jiraProjectIssueSecuritySchemeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_project_issue_security_scheme"
+}
+
+func (*jiraProjectIssueSecuritySchemeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	numericId := stringvalidator.RegexMatches(regexp.MustCompile(`^\d+$`), "value must be a numeric ID")
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Project Issue Security Scheme Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project issue security scheme association. It is the same as `project_id`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The ID of the project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"issue_security_scheme_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the issue security scheme assigned to the project.",
+				Required:            true,
+				Validators: []validator.String{
+					numericId,
+				},
+			},
+			"level_mappings": schema.MapAttribute{
+				MarkdownDescription: "A map of the issue security levels of the previously assigned scheme to the levels of the new scheme. " +
+					"Issues of the project with a security level set are migrated according to this map. " +
+					"It is required when the project contains such issues.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(numericId),
+					mapvalidator.ValueStringsAre(numericId),
+				},
+			},
+		},
+	}
+}
+
+func (r *jiraProjectIssueSecuritySchemeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.p.jira = client
+}
+
+func (*jiraProjectIssueSecuritySchemeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), req.ID)...)
+}
+
+func (r *jiraProjectIssueSecuritySchemeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating project issue security scheme resource")
+
+	var plan jiraProjectIssueSecuritySchemeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded project issue security scheme plan", map[string]interface{}{
+		"createPlan": fmt.Sprintf("%+v", plan),
+	})
+
+	schemeId, err := getProjectIssueSecuritySchemeId(ctx, r.p.jira, plan.ProjectId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get project issue security scheme, got error: %s", err))
+		return
+	}
+	if schemeId != plan.IssueSecuritySchemeId.ValueString() {
+		resp.Diagnostics.Append(r.associate(ctx, &plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	tflog.Debug(ctx, "Created project issue security scheme in API state")
+
+	plan.ID = plan.ProjectId
+
+	tflog.Debug(ctx, "Storing project issue security scheme into the state", map[string]interface{}{
+		"createNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraProjectIssueSecuritySchemeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading project issue security scheme resource")
+
+	var state jiraProjectIssueSecuritySchemeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded project issue security scheme from state", map[string]interface{}{
+		"readState": fmt.Sprintf("%+v", state),
+	})
+
+	schemeId, err := getProjectIssueSecuritySchemeId(ctx, r.p.jira, state.ProjectId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get project issue security scheme, got error: %s", err))
+		return
+	}
+	if schemeId == "" {
+		tflog.Warn(ctx, "Unable to find project issue security scheme in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	tflog.Debug(ctx, "Retrieved project issue security scheme from API state")
+
+	state.ID = state.ProjectId
+	state.IssueSecuritySchemeId = types.StringValue(schemeId)
+
+	tflog.Debug(ctx, "Storing project issue security scheme into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", state),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraProjectIssueSecuritySchemeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating project issue security scheme resource")
+
+	var plan jiraProjectIssueSecuritySchemeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded project issue security scheme plan", map[string]interface{}{
+		"updatePlan": fmt.Sprintf("%+v", plan),
+	})
+
+	var state jiraProjectIssueSecuritySchemeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded project issue security scheme from state", map[string]interface{}{
+		"updateState": fmt.Sprintf("%+v", state),
+	})
+
+	// Changes to "level_mappings" alone only apply to future associations.
+	if !plan.IssueSecuritySchemeId.Equal(state.IssueSecuritySchemeId) {
+		resp.Diagnostics.Append(r.associate(ctx, &plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		tflog.Debug(ctx, "Updated project issue security scheme in API state")
+	}
+
+	plan.ID = state.ID
+
+	tflog.Debug(ctx, "Storing project issue security scheme into the state", map[string]interface{}{
+		"updateNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraProjectIssueSecuritySchemeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting project issue security scheme resource")
+
+	var state jiraProjectIssueSecuritySchemeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded project issue security scheme from state")
+
+	// A null scheme ID clears the association, removing the security level from all issues of the project.
+	payload := &jiraProjectIssueSecuritySchemeAssociation{
+		ProjectId: state.ProjectId.ValueString(),
+	}
+	if err := r.putAssociation(ctx, payload); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to unassign issue security scheme from project, got error: %s", err))
+		return
+	}
+	tflog.Debug(ctx, "Deleted project issue security scheme from API state")
+
+	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
+}
+
+// associate assigns the planned issue security scheme to the project, after checking that
+// issues with a security level can be migrated to the new scheme.
+func (r *jiraProjectIssueSecuritySchemeResource) associate(ctx context.Context, plan *jiraProjectIssueSecuritySchemeResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	mappings := map[string]string{}
+	if !plan.LevelMappings.IsNull() && !plan.LevelMappings.IsUnknown() {
+		diags.Append(plan.LevelMappings.ElementsAs(ctx, &mappings, false)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if len(mappings) == 0 {
+		count, err := r.countIssuesWithSecurityLevel(ctx, plan.ProjectId.ValueString())
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to count issues with a security level, got error: %s", err))
+			return diags
+		}
+		if count > 0 {
+			diags.AddAttributeError(
+				path.Root("level_mappings"),
+				"Missing Issue Security Level Mappings",
+				fmt.Sprintf("The project contains %d issue(s) with a security level. "+
+					"Configure \"level_mappings\" to migrate them to the levels of the new issue security scheme.", count),
+			)
+			return diags
+		}
+	}
+
+	schemeId := plan.IssueSecuritySchemeId.ValueString()
+	payload := &jiraProjectIssueSecuritySchemeAssociation{
+		ProjectId: plan.ProjectId.ValueString(),
+		SchemeId:  &schemeId,
+	}
+	for oldLevelId, newLevelId := range mappings {
+		payload.OldToNewSecurityLevelMappings = append(payload.OldToNewSecurityLevelMappings, jiraIssueSecurityLevelMapping{
+			OldLevelId: oldLevelId,
+			NewLevelId: newLevelId,
+		})
+	}
+	if err := r.putAssociation(ctx, payload); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to assign issue security scheme to project, got error: %s", err))
+	}
+	return diags
+}
+
+func (r *jiraProjectIssueSecuritySchemeResource) putAssociation(ctx context.Context, payload *jiraProjectIssueSecuritySchemeAssociation) error {
+	request, err := r.p.jira.NewRequest(ctx, http.MethodPut, "rest/api/3/issuesecurityschemes/project", "", payload)
+	if err != nil {
+		return err
+	}
+	res, err := r.p.jira.Call(request, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		return fmt.Errorf("%s\n%s", err, resBody)
+	}
+
+	// Associating an issue security scheme with a project is an asynchronous operation.
+	if taskId := taskIdFromResponse(res); taskId != "" {
+		return waitForJiraTask(ctx, r.p.jira, taskId)
+	}
+	return nil
+}
+
+// getProjectIssueSecuritySchemeId returns the ID of the issue security scheme of a project, or an empty string if there is none.
+func getProjectIssueSecuritySchemeId(ctx context.Context, client *jira.Client, projectId string) (string, error) {
+	request, err := client.NewRequest(ctx, http.MethodGet, fmt.Sprintf("rest/api/3/project/%s/issuesecuritylevelscheme", projectId), "", nil)
+	if err != nil {
+		return "", err
+	}
+	res, err := client.Call(request, nil)
+	if res != nil && res.Code == http.StatusNotFound {
+		return "", nil
+	}
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		return "", fmt.Errorf("%s\n%s", err, resBody)
+	}
+
+	var scheme jiraIssueSecurityScheme
+	if res.Bytes.Len() > 0 {
+		if err := json.Unmarshal(res.Bytes.Bytes(), &scheme); err != nil {
+			return "", err
+		}
+	}
+	if scheme.ID == 0 {
+		return "", nil
+	}
+	return strconv.FormatInt(scheme.ID, 10), nil
+}
+
+// countIssuesWithSecurityLevel returns the approximate number of issues of a project that have a security level set.
+func (r *jiraProjectIssueSecuritySchemeResource) countIssuesWithSecurityLevel(ctx context.Context, projectId string) (int, error) {
+	payload := map[string]string{
+		"jql": fmt.Sprintf("project = %s AND level IS NOT EMPTY", projectId),
+	}
+	request, err := r.p.jira.NewRequest(ctx, http.MethodPost, "rest/api/3/search/approximate-count", "", payload)
+	if err != nil {
+		return 0, err
+	}
+	var count struct {
+		Count int `json:"count"`
+	}
+	res, err := r.p.jira.Call(request, &count)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		return 0, fmt.Errorf("%s\n%s", err, resBody)
+	}
+	return count.Count, nil
+}
@@ -0,0 +1,239 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraProjectNotificationSchemeResource struct {
+		p atlassianProvider
+	}
+
+	jiraProjectNotificationSchemeResourceModel struct {
+		ID                   types.String `tfsdk:"id"`
+		ProjectId            types.String `tfsdk:"project_id"`
+		NotificationSchemeId types.String `tfsdk:"notification_scheme_id"`
+	}
+)
+
+// defaultNotificationSchemeId is the ID of the "Default Notification Scheme", which every Jira Cloud site is created with.
+const defaultNotificationSchemeId = 10000
+
+var (
+	_ resource.Resource                = (*jiraProjectNotificationSchemeResource)(nil)
+	_ resource.ResourceWithImportState = (*jiraProjectNotificationSchemeResource)(nil)
+)
+
+func NewJiraProjectNotificationSchemeResource() resource.Resource {
+	return &jiraProjectNotificationSchemeResource{}
+}
+
+func (*jiraProjectNotificationSchemeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_project_notification_scheme"
+}
+
+func (*jiraProjectNotificationSchemeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Project Notification Scheme Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project notification scheme association. It is the same as `project_id`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The ID of the project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"notification_scheme_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the notification scheme assigned to the project.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *jiraProjectNotificationSchemeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.p.jira = client
+}
+
+func (*jiraProjectNotificationSchemeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), req.ID)...)
+}
+
+func (r *jiraProjectNotificationSchemeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating project notification scheme resource")
+
+	var plan jiraProjectNotificationSchemeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded project notification scheme plan", map[string]interface{}{
+		"createPlan": fmt.Sprintf("%+v", plan),
+	})
+
+	schemeId, err := strconv.Atoi(plan.NotificationSchemeId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("notification_scheme_id"), "Unable to parse value of \"notification_scheme_id\" attribute.", "Value of \"notification_scheme_id\" attribute can only be a numeric string.")
+		return
+	}
+	_, res, err := r.p.jira.Project.Update(ctx, plan.ProjectId.ValueString(), &models.ProjectUpdateScheme{NotificationScheme: schemeId})
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to assign notification scheme to project, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Created project notification scheme in API state")
+
+	plan.ID = plan.ProjectId
+
+	tflog.Debug(ctx, "Storing project notification scheme into the state", map[string]interface{}{
+		"createNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraProjectNotificationSchemeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading project notification scheme resource")
+
+	var state jiraProjectNotificationSchemeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded project notification scheme from state", map[string]interface{}{
+		"readState": fmt.Sprintf("%+v", state),
+	})
+
+	scheme, res, err := r.p.jira.Project.NotificationScheme(ctx, state.ProjectId.ValueString(), nil)
+	if res != nil && res.Code == http.StatusNotFound {
+		tflog.Warn(ctx, "Unable to find project notification scheme in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get project notification scheme, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Retrieved project notification scheme from API state")
+
+	state.ID = state.ProjectId
+	state.NotificationSchemeId = types.StringValue(strconv.Itoa(scheme.ID))
+
+	tflog.Debug(ctx, "Storing project notification scheme into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", state),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraProjectNotificationSchemeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating project notification scheme resource")
+
+	var plan jiraProjectNotificationSchemeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded project notification scheme plan", map[string]interface{}{
+		"updatePlan": fmt.Sprintf("%+v", plan),
+	})
+
+	var state jiraProjectNotificationSchemeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded project notification scheme from state", map[string]interface{}{
+		"updateState": fmt.Sprintf("%+v", state),
+	})
+
+	schemeId, err := strconv.Atoi(plan.NotificationSchemeId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("notification_scheme_id"), "Unable to parse value of \"notification_scheme_id\" attribute.", "Value of \"notification_scheme_id\" attribute can only be a numeric string.")
+		return
+	}
+	_, res, err := r.p.jira.Project.Update(ctx, state.ProjectId.ValueString(), &models.ProjectUpdateScheme{NotificationScheme: schemeId})
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to assign notification scheme to project, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Updated project notification scheme in API state")
+
+	plan.ID = state.ID
+
+	tflog.Debug(ctx, "Storing project notification scheme into the state", map[string]interface{}{
+		"updateNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraProjectNotificationSchemeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting project notification scheme resource")
+
+	var state jiraProjectNotificationSchemeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded project notification scheme from state")
+
+	// A project always has a notification scheme, so the default notification scheme is restored.
+	_, res, err := r.p.jira.Project.Update(ctx, state.ProjectId.ValueString(), &models.ProjectUpdateScheme{NotificationScheme: defaultNotificationSchemeId})
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to unassign notification scheme from project, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Deleted project notification scheme from API state")
+
+	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
+}
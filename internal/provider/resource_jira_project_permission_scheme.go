@@ -0,0 +1,238 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraProjectPermissionSchemeResource struct {
+		p atlassianProvider
+	}
+
+	jiraProjectPermissionSchemeResourceModel struct {
+		ID                 types.String `tfsdk:"id"`
+		ProjectId          types.String `tfsdk:"project_id"`
+		PermissionSchemeId types.String `tfsdk:"permission_scheme_id"`
+	}
+)
+
+// defaultPermissionSchemeId is the ID of the "Default Permission Scheme", which cannot be deleted.
+const defaultPermissionSchemeId = 0
+
+var (
+	_ resource.Resource                = (*jiraProjectPermissionSchemeResource)(nil)
+	_ resource.ResourceWithImportState = (*jiraProjectPermissionSchemeResource)(nil)
+)
+
+func NewJiraProjectPermissionSchemeResource() resource.Resource {
+	return &jiraProjectPermissionSchemeResource{}
+}
+
+func (*jiraProjectPermissionSchemeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_project_permission_scheme"
+}
+
+func (*jiraProjectPermissionSchemeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Project Permission Scheme Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project permission scheme association. It is the same as `project_id`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The ID of the project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"permission_scheme_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the permission scheme assigned to the project.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *jiraProjectPermissionSchemeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.p.jira = client
+}
+
+func (*jiraProjectPermissionSchemeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), req.ID)...)
+}
+
+func (r *jiraProjectPermissionSchemeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating project permission scheme resource")
+
+	var plan jiraProjectPermissionSchemeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded project permission scheme plan", map[string]interface{}{
+		"createPlan": fmt.Sprintf("%+v", plan),
+	})
+
+	schemeId, err := strconv.Atoi(plan.PermissionSchemeId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("permission_scheme_id"), "Unable to parse value of \"permission_scheme_id\" attribute.", "Value of \"permission_scheme_id\" attribute can only be a numeric string.")
+		return
+	}
+	_, res, err := r.p.jira.Project.Permission.Assign(ctx, plan.ProjectId.ValueString(), schemeId)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to assign permission scheme to project, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Created project permission scheme in API state")
+
+	plan.ID = plan.ProjectId
+
+	tflog.Debug(ctx, "Storing project permission scheme into the state", map[string]interface{}{
+		"createNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraProjectPermissionSchemeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading project permission scheme resource")
+
+	var state jiraProjectPermissionSchemeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded project permission scheme from state", map[string]interface{}{
+		"readState": fmt.Sprintf("%+v", state),
+	})
+
+	scheme, res, err := r.p.jira.Project.Permission.Get(ctx, state.ProjectId.ValueString(), nil)
+	if res != nil && res.Code == http.StatusNotFound {
+		tflog.Warn(ctx, "Unable to find project permission scheme in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get project permission scheme, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Retrieved project permission scheme from API state")
+
+	state.ID = state.ProjectId
+	state.PermissionSchemeId = types.StringValue(strconv.Itoa(scheme.ID))
+
+	tflog.Debug(ctx, "Storing project permission scheme into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", state),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraProjectPermissionSchemeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating project permission scheme resource")
+
+	var plan jiraProjectPermissionSchemeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded project permission scheme plan", map[string]interface{}{
+		"updatePlan": fmt.Sprintf("%+v", plan),
+	})
+
+	var state jiraProjectPermissionSchemeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded project permission scheme from state", map[string]interface{}{
+		"updateState": fmt.Sprintf("%+v", state),
+	})
+
+	schemeId, err := strconv.Atoi(plan.PermissionSchemeId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("permission_scheme_id"), "Unable to parse value of \"permission_scheme_id\" attribute.", "Value of \"permission_scheme_id\" attribute can only be a numeric string.")
+		return
+	}
+	_, res, err := r.p.jira.Project.Permission.Assign(ctx, state.ProjectId.ValueString(), schemeId)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to assign permission scheme to project, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Updated project permission scheme in API state")
+
+	plan.ID = state.ID
+
+	tflog.Debug(ctx, "Storing project permission scheme into the state", map[string]interface{}{
+		"updateNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraProjectPermissionSchemeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting project permission scheme resource")
+
+	var state jiraProjectPermissionSchemeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded project permission scheme from state")
+
+	// A project always has a permission scheme, so the default permission scheme is restored.
+	_, res, err := r.p.jira.Project.Permission.Assign(ctx, state.ProjectId.ValueString(), defaultPermissionSchemeId)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to unassign permission scheme from project, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Deleted project permission scheme from API state")
+
+	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
+}
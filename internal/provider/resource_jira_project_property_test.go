@@ -0,0 +1,64 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraProjectProperty_Basic(t *testing.T) {
+	randomProjectKey := strings.ToUpper(acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
+	randomKey := acctest.RandomWithPrefix("tf-test-project-property")
+	resourceName := "atlassian_jira_project_property.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectPropertyConfig_basic(resourceName, randomProjectKey, randomKey, "foo"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "project_id", "atlassian_jira_project.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "key", randomKey),
+					resource.TestCheckResourceAttr(resourceName, "value", `{"enabled":true,"name":"foo"}`),
+				),
+			},
+			{
+				Config: testAccProjectPropertyConfig_basic(resourceName, randomProjectKey, randomKey, "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "value", `{"enabled":true,"name":"bar"}`),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccProjectPropertyConfig_basic(resourceName, projectKey, key, name string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	data "atlassian_jira_myself" "test" {}
+
+	resource "atlassian_jira_project" "test" {
+		key              = %[3]q
+		name             = %[4]q
+		lead_account_id  = data.atlassian_jira_myself.test.account_id
+		project_type_key = "software"
+	}
+
+	resource %[1]q %[2]q {
+		project_id = atlassian_jira_project.test.id
+		key        = %[4]q
+		value      = jsonencode({
+			enabled = true
+			name    = %[5]q
+		})
+	}
+	`, splits[0], splits[1], projectKey, key, name)
+}
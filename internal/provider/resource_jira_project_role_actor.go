@@ -0,0 +1,381 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraProjectRoleActorResource struct {
+		p atlassianProvider
+	}
+
+	jiraProjectRoleActorResourceModel struct {
+		ID        types.String `tfsdk:"id"`
+		ProjectId types.String `tfsdk:"project_id"`
+		RoleId    types.String `tfsdk:"role_id"`
+		Users     types.Set    `tfsdk:"users"`
+		Groups    types.Set    `tfsdk:"groups"`
+	}
+)
+
+const (
+	projectRoleActorTypeUser  = "atlassian-user-role-actor"
+	projectRoleActorTypeGroup = "atlassian-group-role-actor"
+)
+
+var (
+	_ resource.Resource                = (*jiraProjectRoleActorResource)(nil)
+	_ resource.ResourceWithImportState = (*jiraProjectRoleActorResource)(nil)
+)
+
+func NewJiraProjectRoleActorResource() resource.Resource {
+	return &jiraProjectRoleActorResource{}
+}
+
+func (*jiraProjectRoleActorResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_project_role_actor"
+}
+
+func (*jiraProjectRoleActorResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Project Role Actor Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project role actors, i.e. `project_id` and `role_id` separated by a slash (`/`).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The ID or key of the project.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role_id": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The ID of the project role.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"users": schema.SetAttribute{
+				MarkdownDescription: "The account IDs of the users assigned to the project role. " +
+					"If not set, the users of the project role are not managed.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"groups": schema.SetAttribute{
+				MarkdownDescription: "The names of the groups assigned to the project role. " +
+					"If not set, the groups of the project role are not managed.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+func (r *jiraProjectRoleActorResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.p.jira = client
+}
+
+func (*jiraProjectRoleActorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError("Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: project_id/role_id. Got: %q", req.ID))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Importing project role actors with import identifier: %+v", idParts))
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("role_id"), idParts[1])...)
+	// Manage both users and groups of imported project role actors.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("users"), []string{})...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("groups"), []string{})...)
+}
+
+func (r *jiraProjectRoleActorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating project role actor resource")
+
+	var plan jiraProjectRoleActorResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded project role actor plan", map[string]interface{}{
+		"createPlan": fmt.Sprintf("%+v", plan),
+	})
+
+	roleId, err := strconv.Atoi(plan.RoleId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("role_id"), "Unable to parse value of \"role_id\" attribute.", "Value of \"role_id\" attribute can only be a numeric string.")
+		return
+	}
+
+	err = r.updateActors(ctx, plan.ProjectId.ValueString(), roleId, plan.Users, plan.Groups)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	tflog.Debug(ctx, "Created project role actor in API state")
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s/%s", plan.ProjectId.ValueString(), plan.RoleId.ValueString()))
+
+	tflog.Debug(ctx, "Storing project role actor into the state", map[string]interface{}{
+		"createNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraProjectRoleActorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading project role actor resource")
+
+	var state jiraProjectRoleActorResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded project role actor from state", map[string]interface{}{
+		"readState": fmt.Sprintf("%+v", state),
+	})
+
+	roleId, err := strconv.Atoi(state.RoleId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("role_id"), "Unable to parse value of \"role_id\" attribute.", "Value of \"role_id\" attribute can only be a numeric string.")
+		return
+	}
+
+	projectRole, res, err := r.p.jira.Project.Role.Get(ctx, state.ProjectId.ValueString(), roleId)
+	if res != nil && res.Code == http.StatusNotFound {
+		tflog.Warn(ctx, "Unable to find project role in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get project role, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Retrieved project role from API state")
+
+	users, groups := flattenProjectRoleActors(projectRole)
+	if !state.Users.IsNull() {
+		state.Users, _ = types.SetValueFrom(ctx, types.StringType, users)
+	}
+	if !state.Groups.IsNull() {
+		state.Groups, _ = types.SetValueFrom(ctx, types.StringType, groups)
+	}
+
+	tflog.Debug(ctx, "Storing project role actor into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", state),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraProjectRoleActorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating project role actor resource")
+
+	var plan jiraProjectRoleActorResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded project role actor plan", map[string]interface{}{
+		"updatePlan": fmt.Sprintf("%+v", plan),
+	})
+
+	var state jiraProjectRoleActorResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded project role actor from state", map[string]interface{}{
+		"updateState": fmt.Sprintf("%+v", state),
+	})
+
+	roleId, err := strconv.Atoi(plan.RoleId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("role_id"), "Unable to parse value of \"role_id\" attribute.", "Value of \"role_id\" attribute can only be a numeric string.")
+		return
+	}
+
+	// Actors of a kind which is no longer managed are left untouched.
+	err = r.updateActors(ctx, plan.ProjectId.ValueString(), roleId, plan.Users, plan.Groups)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	tflog.Debug(ctx, "Updated project role actor in API state")
+
+	plan.ID = state.ID
+
+	tflog.Debug(ctx, "Storing project role actor into the state", map[string]interface{}{
+		"updateNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraProjectRoleActorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting project role actor resource")
+
+	var state jiraProjectRoleActorResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded project role actor from state")
+
+	roleId, err := strconv.Atoi(state.RoleId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("role_id"), "Unable to parse value of \"role_id\" attribute.", "Value of \"role_id\" attribute can only be a numeric string.")
+		return
+	}
+
+	// Removing all the managed actors, i.e. setting empty users and groups if they are managed.
+	users, groups := types.SetNull(types.StringType), types.SetNull(types.StringType)
+	if !state.Users.IsNull() {
+		users = types.SetValueMust(types.StringType, nil)
+	}
+	if !state.Groups.IsNull() {
+		groups = types.SetValueMust(types.StringType, nil)
+	}
+	err = r.updateActors(ctx, state.ProjectId.ValueString(), roleId, users, groups)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+	tflog.Debug(ctx, "Deleted project role actor from API state")
+
+	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
+}
+
+// updateActors reconciles the actors of a project role with the desired users and groups.
+// A null set means that the corresponding kind of actors is not managed.
+func (r *jiraProjectRoleActorResource) updateActors(ctx context.Context, projectId string, roleId int, users, groups types.Set) error {
+	projectRole, res, err := r.p.jira.Project.Role.Get(ctx, projectId, roleId)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		return fmt.Errorf("Unable to get project role, got error: %s\n%s", err, resBody)
+	}
+	currentUsers, currentGroups := flattenProjectRoleActors(projectRole)
+
+	var desiredUsers, desiredGroups []string
+	users.ElementsAs(ctx, &desiredUsers, false)
+	groups.ElementsAs(ctx, &desiredGroups, false)
+
+	var addUsers, addGroups []string
+	if !users.IsNull() {
+		for _, u := range currentUsers {
+			if containsString(desiredUsers, u) {
+				continue
+			}
+			res, err := r.p.jira.Project.Role.Actor.Delete(ctx, projectId, roleId, u, "")
+			if err != nil {
+				var resBody string
+				if res != nil {
+					resBody = res.Bytes.String()
+				}
+				return fmt.Errorf("Unable to remove user from project role, got error: %s\n%s", err, resBody)
+			}
+		}
+		for _, u := range desiredUsers {
+			if !containsString(currentUsers, u) {
+				addUsers = append(addUsers, u)
+			}
+		}
+	}
+	if !groups.IsNull() {
+		for _, g := range currentGroups {
+			if containsString(desiredGroups, g) {
+				continue
+			}
+			res, err := r.p.jira.Project.Role.Actor.Delete(ctx, projectId, roleId, "", g)
+			if err != nil {
+				var resBody string
+				if res != nil {
+					resBody = res.Bytes.String()
+				}
+				return fmt.Errorf("Unable to remove group from project role, got error: %s\n%s", err, resBody)
+			}
+		}
+		for _, g := range desiredGroups {
+			if !containsString(currentGroups, g) {
+				addGroups = append(addGroups, g)
+			}
+		}
+	}
+
+	if len(addUsers) == 0 && len(addGroups) == 0 {
+		return nil
+	}
+
+	_, res, err = r.p.jira.Project.Role.Actor.Add(ctx, projectId, roleId, addUsers, addGroups)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		return fmt.Errorf("Unable to add actors to project role, got error: %s\n%s", err, resBody)
+	}
+
+	return nil
+}
+
+// flattenProjectRoleActors returns the account IDs of the users and the names of the groups of a project role.
+func flattenProjectRoleActors(projectRole *models.ProjectRoleScheme) ([]string, []string) {
+	users, groups := []string{}, []string{}
+	for _, actor := range projectRole.Actors {
+		switch actor.Type {
+		case projectRoleActorTypeUser:
+			if actor.ActorUser != nil {
+				users = append(users, actor.ActorUser.AccountID)
+			}
+		case projectRoleActorTypeGroup:
+			if actor.ActorGroup != nil && actor.ActorGroup.Name != "" {
+				groups = append(groups, actor.ActorGroup.Name)
+			} else {
+				groups = append(groups, actor.Name)
+			}
+		}
+	}
+	return users, groups
+}
@@ -0,0 +1,101 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraProjectRoleActor_Basic(t *testing.T) {
+	randomKey := acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+	randomName := acctest.RandomWithPrefix("tf-test-project-role-actor")
+	resourceName := "atlassian_jira_project_role_actor.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectRoleActorConfig_basic(resourceName, strings.ToUpper(randomKey), randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "project_id", "atlassian_jira_project.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_id", "atlassian_jira_project_role.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "users.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "groups.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "groups.*", "atlassian_jira_group.test", "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccJiraProjectRoleActor_GroupsOnly(t *testing.T) {
+	randomKey := acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+	randomName := acctest.RandomWithPrefix("tf-test-project-role-actor")
+	resourceName := "atlassian_jira_project_role_actor.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectRoleActorConfig_groupsOnly(resourceName, strings.ToUpper(randomKey), randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(resourceName, "users"),
+					resource.TestCheckResourceAttr(resourceName, "groups.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProjectRoleActorConfig_dependencies(key, name string) string {
+	return fmt.Sprintf(`
+	data "atlassian_jira_myself" "test" {}
+
+	resource "atlassian_jira_project" "test" {
+		key              = %[1]q
+		name             = %[2]q
+		lead_account_id  = data.atlassian_jira_myself.test.account_id
+		project_type_key = "software"
+	}
+
+	resource "atlassian_jira_project_role" "test" {
+		name = %[2]q
+	}
+
+	resource "atlassian_jira_group" "test" {
+		name = %[2]q
+	}
+	`, key, name)
+}
+
+func testAccProjectRoleActorConfig_basic(resourceName, key, name string) string {
+	splits := strings.Split(resourceName, ".")
+	return testAccProjectRoleActorConfig_dependencies(key, name) + fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		project_id = atlassian_jira_project.test.id
+		role_id    = atlassian_jira_project_role.test.id
+		users      = [data.atlassian_jira_myself.test.account_id]
+		groups     = [atlassian_jira_group.test.name]
+	}
+	`, splits[0], splits[1])
+}
+
+func testAccProjectRoleActorConfig_groupsOnly(resourceName, key, name string) string {
+	splits := strings.Split(resourceName, ".")
+	return testAccProjectRoleActorConfig_dependencies(key, name) + fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		project_id = atlassian_jira_project.test.id
+		role_id    = atlassian_jira_project_role.test.id
+		groups     = [atlassian_jira_group.test.name]
+	}
+	`, splits[0], splits[1])
+}
@@ -0,0 +1,754 @@
+package atlassian
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraProject_DefaultSchemes(t *testing.T) {
+	randomKey := acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+	randomName := acctest.RandomWithPrefix("tf-test-project")
+	resourceName := "atlassian_jira_project.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectConfig_assigneeType(resourceName, strings.ToUpper(randomKey), randomName, "PROJECT_LEAD"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "issue_type_scheme"),
+					resource.TestCheckResourceAttrSet(resourceName, "issue_type_screen_scheme"),
+					resource.TestCheckResourceAttrSet(resourceName, "notification_scheme"),
+					resource.TestCheckResourceAttrSet(resourceName, "permission_scheme"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProjectConfig_assigneeType(resourceName, strings.ToUpper(randomKey), randomName+"-renamed", "PROJECT_LEAD"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", randomName+"-renamed"),
+					resource.TestCheckNoResourceAttr(resourceName, "issue_security_scheme"),
+					resource.TestCheckResourceAttrSet(resourceName, "issue_type_scheme"),
+					resource.TestCheckResourceAttrSet(resourceName, "permission_scheme"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJiraProject_Schemes(t *testing.T) {
+	randomKey := acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+	randomName := acctest.RandomWithPrefix("tf-test-project")
+	resourceName := "atlassian_jira_project.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectConfig_schemes(resourceName, strings.ToUpper(randomKey), randomName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "field_configuration_scheme", "atlassian_jira_issue_field_configuration_scheme.first", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "workflow_scheme", "atlassian_jira_workflow_scheme.first", "id"),
+				),
+			},
+			{
+				Config: testAccProjectConfig_schemes(resourceName, strings.ToUpper(randomKey), randomName, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "field_configuration_scheme", "atlassian_jira_issue_field_configuration_scheme.second", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "workflow_scheme", "atlassian_jira_workflow_scheme.second", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccJiraProject_TeamManaged(t *testing.T) {
+	randomKey := acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+	randomName := acctest.RandomWithPrefix("tf-test-project")
+	resourceName := "atlassian_jira_project.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectConfig_teamManaged(resourceName, strings.ToUpper(randomKey), randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "style", "next-gen"),
+					resource.TestCheckNoResourceAttr(resourceName, "issue_type_scheme"),
+					resource.TestCheckNoResourceAttr(resourceName, "issue_type_screen_scheme"),
+					resource.TestCheckNoResourceAttr(resourceName, "field_configuration_scheme"),
+					resource.TestCheckNoResourceAttr(resourceName, "workflow_scheme"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"project_template_key"},
+			},
+		},
+	})
+}
+
+func TestAccJiraProject_TeamManagedWithScheme(t *testing.T) {
+	randomKey := acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+	randomName := acctest.RandomWithPrefix("tf-test-project")
+	resourceName := "atlassian_jira_project.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProjectConfig_teamManagedWithScheme(resourceName, strings.ToUpper(randomKey), randomName),
+				ExpectError: regexp.MustCompile(`Team-managed projects must not have a value for "field_configuration_scheme"`),
+			},
+		},
+	})
+}
+
+func TestAccJiraProject_TemplateWithScheme(t *testing.T) {
+	randomKey := acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+	randomName := acctest.RandomWithPrefix("tf-test-project")
+	resourceName := "atlassian_jira_project.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProjectConfig_templateWithScheme(resourceName, strings.ToUpper(randomKey), randomName),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func TestAccJiraProject_InvalidKey(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-project")
+	resourceName := "atlassian_jira_project.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProjectConfig_assigneeType(resourceName, "1FOO", randomName, "PROJECT_LEAD"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`value must start with an uppercase letter`),
+			},
+			{
+				Config:      testAccProjectConfig_assigneeType(resourceName, "foo", randomName, "PROJECT_LEAD"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`value must start with an uppercase letter`),
+			},
+			{
+				Config:      testAccProjectConfig_assigneeType(resourceName, "SELECT", randomName, "PROJECT_LEAD"),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`is a JQL reserved word`),
+			},
+		},
+	})
+}
+
+func TestAccJiraProject_Key(t *testing.T) {
+	randomKey := acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+	randomName := acctest.RandomWithPrefix("tf-test-project")
+	resourceName := "atlassian_jira_project.test"
+	var projectId string
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectConfig_basic(resourceName, strings.ToUpper(randomKey), randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "key", strings.ToUpper(randomKey)),
+					resource.TestCheckResourceAttrWith(resourceName, "id", func(value string) error {
+						projectId = value
+						return nil
+					}),
+				),
+			},
+			{
+				Config: testAccProjectConfig_basic(resourceName, strings.ToUpper(randomKey)+"2", randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "key", strings.ToUpper(randomKey)+"2"),
+					resource.TestCheckResourceAttrWith(resourceName, "id", func(value string) error {
+						if value != projectId {
+							return fmt.Errorf("expected project %s to be updated in place, got project %s", projectId, value)
+						}
+						return nil
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJiraProject_Category(t *testing.T) {
+	randomKey := acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+	randomName := acctest.RandomWithPrefix("tf-test-project")
+	resourceName := "atlassian_jira_project.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectConfig_category(resourceName, strings.ToUpper(randomKey), randomName, "atlassian_jira_project_category.test1.id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "category_id", "atlassian_jira_project_category.test1", "id"),
+				),
+			},
+			{
+				Config: testAccProjectConfig_category(resourceName, strings.ToUpper(randomKey), randomName, "atlassian_jira_project_category.test2.id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "category_id", "atlassian_jira_project_category.test2", "id"),
+				),
+			},
+			{
+				Config: testAccProjectConfig_category(resourceName, strings.ToUpper(randomKey), randomName, "null"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(resourceName, "category_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJiraProject_PermissionScheme(t *testing.T) {
+	randomKey := acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+	randomName := acctest.RandomWithPrefix("tf-test-project")
+	resourceName := "atlassian_jira_project.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectConfig_permissionScheme(resourceName, strings.ToUpper(randomKey), randomName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "permission_scheme", "atlassian_jira_permission_scheme.first", "id"),
+				),
+			},
+			{
+				Config: testAccProjectConfig_permissionScheme(resourceName, strings.ToUpper(randomKey), randomName, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "permission_scheme", "atlassian_jira_permission_scheme.second", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccJiraProject_NotificationScheme(t *testing.T) {
+	randomKey := acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+	randomName := acctest.RandomWithPrefix("tf-test-project")
+	resourceName := "atlassian_jira_project.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectConfig_notificationScheme(resourceName, strings.ToUpper(randomKey), randomName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "notification_scheme", "atlassian_jira_notification_scheme.first", "id"),
+				),
+			},
+			{
+				Config: testAccProjectConfig_notificationScheme(resourceName, strings.ToUpper(randomKey), randomName, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "notification_scheme", "atlassian_jira_notification_scheme.second", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccJiraProject_IssueSecurityScheme(t *testing.T) {
+	randomKey := acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+	randomName := acctest.RandomWithPrefix("tf-test-project")
+	resourceName := "atlassian_jira_project.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectConfig_issueSecurityScheme(resourceName, strings.ToUpper(randomKey), randomName, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "issue_security_scheme", "atlassian_jira_issue_security_scheme.first", "id"),
+				),
+			},
+			{
+				Config: testAccProjectConfig_issueSecurityScheme(resourceName, strings.ToUpper(randomKey), randomName, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "issue_security_scheme", "atlassian_jira_issue_security_scheme.second", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccJiraProject_AssigneeType(t *testing.T) {
+	randomKey := acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+	randomName := acctest.RandomWithPrefix("tf-test-project")
+	resourceName := "atlassian_jira_project.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectConfig_assigneeType(resourceName, strings.ToUpper(randomKey), randomName, "UNASSIGNED"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "assignee_type", "UNASSIGNED"),
+				),
+			},
+			{
+				Config: testAccProjectConfig_assigneeType(resourceName, strings.ToUpper(randomKey), randomName, "PROJECT_LEAD"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "assignee_type", "PROJECT_LEAD"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccJiraProject_LeadEmail(t *testing.T) {
+	randomKey := acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+	randomName := acctest.RandomWithPrefix("tf-test-project")
+	resourceName := "atlassian_jira_project.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectConfig_leadEmail(resourceName, strings.ToUpper(randomKey), randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "lead_email", "data.atlassian_jira_myself.test", "email_address"),
+					resource.TestCheckResourceAttrPair(resourceName, "lead_account_id", "data.atlassian_jira_myself.test", "account_id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"lead_email"},
+			},
+		},
+	})
+}
+
+func TestAccJiraProject_LeadEmailConflict(t *testing.T) {
+	randomKey := acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+	randomName := acctest.RandomWithPrefix("tf-test-project")
+	resourceName := "atlassian_jira_project.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProjectConfig_leadEmailConflict(resourceName, strings.ToUpper(randomKey), randomName),
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func TestAccJiraProject_ArchiveOnDestroy(t *testing.T) {
+	randomKey := acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+	randomName := acctest.RandomWithPrefix("tf-test-project")
+	resourceName := "atlassian_jira_project.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectConfig_archiveOnDestroy(resourceName, strings.ToUpper(randomKey), randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "archive_on_destroy", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"archive_on_destroy"},
+			},
+		},
+	})
+}
+
+func TestAccJiraProject_DeleteMode(t *testing.T) {
+	randomKey := acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+	randomName := acctest.RandomWithPrefix("tf-test-project")
+	resourceName := "atlassian_jira_project.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectConfig_deleteMode(resourceName, strings.ToUpper(randomKey), randomName, "trash"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "delete_mode", "trash"),
+				),
+			},
+			{
+				Config:  testAccProjectConfig_deleteMode(resourceName, strings.ToUpper(randomKey), randomName, "trash"),
+				Destroy: true,
+			},
+			{
+				Config: testAccProjectConfig_deleteMode(resourceName, strings.ToUpper(randomKey), randomName+"-restored", "trash"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "key", strings.ToUpper(randomKey)),
+					resource.TestCheckResourceAttr(resourceName, "name", randomName+"-restored"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"delete_mode"},
+			},
+			{
+				Config: testAccProjectConfig_deleteMode(resourceName, strings.ToUpper(randomKey), randomName, "permanent"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "delete_mode", "permanent"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJiraProject_DeletionProtection(t *testing.T) {
+	randomKey := acctest.RandStringFromCharSet(8, acctest.CharSetAlpha)
+	randomName := acctest.RandomWithPrefix("tf-test-project")
+	resourceName := "atlassian_jira_project.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectConfig_deletionProtection(resourceName, strings.ToUpper(randomKey), randomName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "true"),
+				),
+			},
+			{
+				Config:      testAccProjectConfig_deletionProtection(resourceName, strings.ToUpper(randomKey), randomName, true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("Deletion Protection Enabled"),
+			},
+			{
+				Config: testAccProjectConfig_deletionProtection(resourceName, strings.ToUpper(randomKey), randomName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProjectConfig_basic(resourceName, key, name string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	data "atlassian_jira_myself" "test" {}
+
+	resource %[1]q %[2]q {
+		key              = %[3]q
+		name             = %[4]q
+		lead_account_id  = data.atlassian_jira_myself.test.account_id
+		project_type_key = "software"
+	}
+	`, splits[0], splits[1], key, name)
+}
+
+func testAccProjectConfig_teamManaged(resourceName, key, name string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	data "atlassian_jira_myself" "test" {}
+
+	resource %[1]q %[2]q {
+		key                  = %[3]q
+		name                 = %[4]q
+		lead_account_id      = data.atlassian_jira_myself.test.account_id
+		project_template_key = "com.pyxis.greenhopper.jira:gh-simplified-agility-kanban"
+		style                = "next-gen"
+	}
+	`, splits[0], splits[1], key, name)
+}
+
+func testAccProjectConfig_teamManagedWithScheme(resourceName, key, name string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	data "atlassian_jira_myself" "test" {}
+
+	resource %[1]q %[2]q {
+		key                        = %[3]q
+		name                       = %[4]q
+		lead_account_id            = data.atlassian_jira_myself.test.account_id
+		project_template_key       = "com.pyxis.greenhopper.jira:gh-simplified-agility-kanban"
+		style                      = "next-gen"
+		field_configuration_scheme = 10000
+	}
+	`, splits[0], splits[1], key, name)
+}
+
+func testAccProjectConfig_templateWithScheme(resourceName, key, name string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	data "atlassian_jira_myself" "test" {}
+
+	resource %[1]q %[2]q {
+		key                  = %[3]q
+		name                 = %[4]q
+		lead_account_id      = data.atlassian_jira_myself.test.account_id
+		project_template_key = "com.pyxis.greenhopper.jira:gh-simplified-agility-scrum"
+		workflow_scheme      = 10000
+	}
+	`, splits[0], splits[1], key, name)
+}
+
+func testAccProjectConfig_category(resourceName, key, name, categoryId string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	data "atlassian_jira_myself" "test" {}
+
+	resource "atlassian_jira_project_category" "test1" {
+		name = "%[4]s-1"
+	}
+
+	resource "atlassian_jira_project_category" "test2" {
+		name = "%[4]s-2"
+	}
+
+	resource %[1]q %[2]q {
+		key              = %[3]q
+		name             = %[4]q
+		lead_account_id  = data.atlassian_jira_myself.test.account_id
+		project_type_key = "software"
+		category_id      = %[5]s
+	}
+	`, splits[0], splits[1], key, name, categoryId)
+}
+
+func testAccProjectConfig_permissionScheme(resourceName, key, name, scheme string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	data "atlassian_jira_myself" "test" {}
+
+	resource "atlassian_jira_permission_scheme" "first" {
+		name = "%[4]s-first"
+	}
+
+	resource "atlassian_jira_permission_scheme" "second" {
+		name = "%[4]s-second"
+	}
+
+	resource %[1]q %[2]q {
+		key               = %[3]q
+		name              = %[4]q
+		lead_account_id   = data.atlassian_jira_myself.test.account_id
+		project_type_key  = "software"
+		permission_scheme = atlassian_jira_permission_scheme.%[5]s.id
+	}
+	`, splits[0], splits[1], key, name, scheme)
+}
+
+func testAccProjectConfig_notificationScheme(resourceName, key, name, scheme string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	data "atlassian_jira_myself" "test" {}
+
+	resource "atlassian_jira_notification_scheme" "first" {
+		name = "%[4]s-first"
+	}
+
+	resource "atlassian_jira_notification_scheme" "second" {
+		name = "%[4]s-second"
+	}
+
+	resource %[1]q %[2]q {
+		key                 = %[3]q
+		name                = %[4]q
+		lead_account_id     = data.atlassian_jira_myself.test.account_id
+		project_type_key    = "software"
+		notification_scheme = atlassian_jira_notification_scheme.%[5]s.id
+	}
+	`, splits[0], splits[1], key, name, scheme)
+}
+
+func testAccProjectConfig_issueSecurityScheme(resourceName, key, name, scheme string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	data "atlassian_jira_myself" "test" {}
+
+	resource "atlassian_jira_issue_security_scheme" "first" {
+		name = "%[4]s-first"
+	}
+
+	resource "atlassian_jira_issue_security_scheme" "second" {
+		name = "%[4]s-second"
+	}
+
+	resource %[1]q %[2]q {
+		key                   = %[3]q
+		name                  = %[4]q
+		lead_account_id       = data.atlassian_jira_myself.test.account_id
+		project_type_key      = "software"
+		issue_security_scheme = atlassian_jira_issue_security_scheme.%[5]s.id
+	}
+	`, splits[0], splits[1], key, name, scheme)
+}
+
+func testAccProjectConfig_assigneeType(resourceName, key, name, assigneeType string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	data "atlassian_jira_myself" "test" {}
+
+	resource %[1]q %[2]q {
+		key              = %[3]q
+		name             = %[4]q
+		lead_account_id  = data.atlassian_jira_myself.test.account_id
+		project_type_key = "software"
+		assignee_type    = %[5]q
+	}
+	`, splits[0], splits[1], key, name, assigneeType)
+}
+
+func testAccProjectConfig_leadEmail(resourceName, key, name string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	data "atlassian_jira_myself" "test" {}
+
+	resource %[1]q %[2]q {
+		key              = %[3]q
+		name             = %[4]q
+		lead_email       = data.atlassian_jira_myself.test.email_address
+		project_type_key = "software"
+	}
+	`, splits[0], splits[1], key, name)
+}
+
+func testAccProjectConfig_leadEmailConflict(resourceName, key, name string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	data "atlassian_jira_myself" "test" {}
+
+	resource %[1]q %[2]q {
+		key              = %[3]q
+		name             = %[4]q
+		lead_account_id  = data.atlassian_jira_myself.test.account_id
+		lead_email       = data.atlassian_jira_myself.test.email_address
+		project_type_key = "software"
+	}
+	`, splits[0], splits[1], key, name)
+}
+
+func testAccProjectConfig_archiveOnDestroy(resourceName, key, name string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	data "atlassian_jira_myself" "test" {}
+
+	resource %[1]q %[2]q {
+		key                = %[3]q
+		name               = %[4]q
+		lead_account_id    = data.atlassian_jira_myself.test.account_id
+		project_type_key   = "software"
+		archive_on_destroy = true
+	}
+	`, splits[0], splits[1], key, name)
+}
+
+func testAccProjectConfig_deleteMode(resourceName, key, name, mode string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	data "atlassian_jira_myself" "test" {}
+
+	resource %[1]q %[2]q {
+		key              = %[3]q
+		name             = %[4]q
+		lead_account_id  = data.atlassian_jira_myself.test.account_id
+		project_type_key = "software"
+		delete_mode      = %[5]q
+	}
+	`, splits[0], splits[1], key, name, mode)
+}
+
+func testAccProjectConfig_deletionProtection(resourceName, key, name string, deletionProtection bool) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	data "atlassian_jira_myself" "test" {}
+
+	resource %[1]q %[2]q {
+		key                 = %[3]q
+		name                = %[4]q
+		lead_account_id     = data.atlassian_jira_myself.test.account_id
+		project_type_key    = "software"
+		deletion_protection = %[5]t
+	}
+	`, splits[0], splits[1], key, name, deletionProtection)
+}
+
+func testAccProjectConfig_schemes(resourceName, key, name, scheme string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	data "atlassian_jira_myself" "test" {}
+
+	resource "atlassian_jira_issue_field_configuration_scheme" "first" {
+		name = "%[4]s-first"
+	}
+
+	resource "atlassian_jira_issue_field_configuration_scheme" "second" {
+		name = "%[4]s-second"
+	}
+
+	resource "atlassian_jira_workflow_scheme" "first" {
+		name = "%[4]s-first"
+	}
+
+	resource "atlassian_jira_workflow_scheme" "second" {
+		name = "%[4]s-second"
+	}
+
+	resource %[1]q %[2]q {
+		key                        = %[3]q
+		name                       = %[4]q
+		lead_account_id            = data.atlassian_jira_myself.test.account_id
+		project_type_key           = "software"
+		field_configuration_scheme = atlassian_jira_issue_field_configuration_scheme.%[5]s.id
+		workflow_scheme            = atlassian_jira_workflow_scheme.%[5]s.id
+	}
+	`, splits[0], splits[1], key, name, scheme)
+}
@@ -0,0 +1,363 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openscientia/terraform-provider-atlassian/internal/provider/planmodifiers/boolmodifiers"
+	"github.com/openscientia/terraform-provider-atlassian/internal/provider/planmodifiers/stringmodifiers"
+)
+
+type (
+	jiraProjectVersionResource struct {
+		p atlassianProvider
+	}
+
+	jiraProjectVersionResourceModel struct {
+		ID          types.String `tfsdk:"id"`
+		ProjectId   types.String `tfsdk:"project_id"`
+		Name        types.String `tfsdk:"name"`
+		Description types.String `tfsdk:"description"`
+		StartDate   types.String `tfsdk:"start_date"`
+		ReleaseDate types.String `tfsdk:"release_date"`
+		Released    types.Bool   `tfsdk:"released"`
+		Archived    types.Bool   `tfsdk:"archived"`
+	}
+
+	// models.VersionPayloadScheme omits false and empty values, which prevents unreleasing, unarchiving
+	// and removing dates, and models.VersionScheme does not include the start date, so the following
+	// types are used to call the "Project versions" endpoints.
+	jiraProjectVersionPayload struct {
+		ProjectId   int64   `json:"projectId,omitempty"`
+		Name        string  `json:"name"`
+		Description string  `json:"description"`
+		StartDate   *string `json:"startDate"`
+		ReleaseDate *string `json:"releaseDate"`
+		Released    bool    `json:"released"`
+		Archived    bool    `json:"archived"`
+	}
+
+	jiraProjectVersionDetails struct {
+		ID          string `json:"id,omitempty"`
+		ProjectId   int64  `json:"projectId,omitempty"`
+		Name        string `json:"name,omitempty"`
+		Description string `json:"description,omitempty"`
+		StartDate   string `json:"startDate,omitempty"`
+		ReleaseDate string `json:"releaseDate,omitempty"`
+		Released    bool   `json:"released,omitempty"`
+		Archived    bool   `json:"archived,omitempty"`
+	}
+)
+
+var (
+	_ resource.Resource                = (*jiraProjectVersionResource)(nil)
+	_ resource.ResourceWithImportState = (*jiraProjectVersionResource)(nil)
+)
+
+func NewJiraProjectVersionResource() resource.Resource {
+	return &jiraProjectVersionResource{}
+}
+
+func (*jiraProjectVersionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_project_version"
+}
+
+func (*jiraProjectVersionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	dateValidators := []validator.String{
+		stringvalidator.RegexMatches(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`), "value must be a date in ISO 8601 format (yyyy-mm-dd)"),
+	}
+
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Project Version Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the version.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The ID of the project to which the version is attached.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The unique name of the version in the project. The maximum length is 255 characters.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the version.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringmodifiers.DefaultValue(""),
+				},
+			},
+			"start_date": schema.StringAttribute{
+				MarkdownDescription: "The start date of the version in ISO 8601 format (yyyy-mm-dd).",
+				Optional:            true,
+				Validators:          dateValidators,
+			},
+			"release_date": schema.StringAttribute{
+				MarkdownDescription: "The release date of the version in ISO 8601 format (yyyy-mm-dd).",
+				Optional:            true,
+				Validators:          dateValidators,
+			},
+			"released": schema.BoolAttribute{
+				MarkdownDescription: "Whether the version is released. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolmodifiers.DefaultValue(false),
+				},
+			},
+			"archived": schema.BoolAttribute{
+				MarkdownDescription: "Whether the version is archived. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolmodifiers.DefaultValue(false),
+				},
+			},
+		},
+	}
+}
+
+func (r *jiraProjectVersionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.p.jira = client
+}
+
+func (*jiraProjectVersionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *jiraProjectVersionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating project version resource")
+
+	var plan jiraProjectVersionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded project version plan", map[string]interface{}{
+		"createPlan": fmt.Sprintf("%+v", plan),
+	})
+
+	projectId, err := strconv.ParseInt(plan.ProjectId.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("project_id"), "Unable to parse value of \"project_id\" attribute.", "Value of \"project_id\" attribute can only be a numeric string.")
+		return
+	}
+
+	payload := expandProjectVersion(&plan)
+	payload.ProjectId = projectId
+	request, err := r.p.jira.NewRequest(ctx, http.MethodPost, "rest/api/3/version", "", payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create project version request, got error: %s", err))
+		return
+	}
+
+	version := new(jiraProjectVersionDetails)
+	res, err := r.p.jira.Call(request, version)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create project version, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Created project version in API state")
+
+	plan.ID = types.StringValue(version.ID)
+
+	tflog.Debug(ctx, "Storing project version into the state", map[string]interface{}{
+		"createNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraProjectVersionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading project version resource")
+
+	var state jiraProjectVersionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded project version from state", map[string]interface{}{
+		"readState": fmt.Sprintf("%+v", state),
+	})
+
+	request, err := r.p.jira.NewRequest(ctx, http.MethodGet, fmt.Sprintf("rest/api/3/version/%s", state.ID.ValueString()), "", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create project version request, got error: %s", err))
+		return
+	}
+
+	version := new(jiraProjectVersionDetails)
+	res, err := r.p.jira.Call(request, version)
+	if res != nil && res.Code == http.StatusNotFound {
+		tflog.Warn(ctx, "Unable to find project version in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get project version, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Retrieved project version from API state")
+
+	state.ProjectId = types.StringValue(strconv.FormatInt(version.ProjectId, 10))
+	state.Name = types.StringValue(version.Name)
+	state.Description = types.StringValue(version.Description)
+	state.StartDate = types.StringNull()
+	if version.StartDate != "" {
+		state.StartDate = types.StringValue(version.StartDate)
+	}
+	state.ReleaseDate = types.StringNull()
+	if version.ReleaseDate != "" {
+		state.ReleaseDate = types.StringValue(version.ReleaseDate)
+	}
+	state.Released = types.BoolValue(version.Released)
+	state.Archived = types.BoolValue(version.Archived)
+
+	tflog.Debug(ctx, "Storing project version into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", state),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraProjectVersionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating project version resource")
+
+	var plan jiraProjectVersionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded project version plan", map[string]interface{}{
+		"updatePlan": fmt.Sprintf("%+v", plan),
+	})
+
+	var state jiraProjectVersionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded project version from state", map[string]interface{}{
+		"updateState": fmt.Sprintf("%+v", state),
+	})
+
+	payload := expandProjectVersion(&plan)
+	request, err := r.p.jira.NewRequest(ctx, http.MethodPut, fmt.Sprintf("rest/api/3/version/%s", state.ID.ValueString()), "", payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create project version request, got error: %s", err))
+		return
+	}
+
+	res, err := r.p.jira.Call(request, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update project version, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Updated project version in API state")
+
+	plan.ID = state.ID
+
+	tflog.Debug(ctx, "Storing project version into the state", map[string]interface{}{
+		"updateNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraProjectVersionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting project version resource")
+
+	var state jiraProjectVersionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded project version from state")
+
+	// Without any replacement version, references to the version are removed from the issues.
+	request, err := r.p.jira.NewRequest(ctx, http.MethodPost, fmt.Sprintf("rest/api/3/version/%s/removeAndSwap", state.ID.ValueString()), "", map[string]interface{}{})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create project version request, got error: %s", err))
+		return
+	}
+
+	res, err := r.p.jira.Call(request, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete project version, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Deleted project version from API state")
+
+	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
+}
+
+func expandProjectVersion(m *jiraProjectVersionResourceModel) *jiraProjectVersionPayload {
+	payload := &jiraProjectVersionPayload{
+		Name:        m.Name.ValueString(),
+		Description: m.Description.ValueString(),
+		Released:    m.Released.ValueBool(),
+		Archived:    m.Archived.ValueBool(),
+	}
+	if !m.StartDate.IsNull() {
+		startDate := m.StartDate.ValueString()
+		payload.StartDate = &startDate
+	}
+	if !m.ReleaseDate.IsNull() {
+		releaseDate := m.ReleaseDate.ValueString()
+		payload.ReleaseDate = &releaseDate
+	}
+	return payload
+}
@@ -0,0 +1,109 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraProjectVersion_Basic(t *testing.T) {
+	randomKey := strings.ToUpper(acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
+	randomName := acctest.RandomWithPrefix("tf-test-project-version")
+	resourceName := "atlassian_jira_project_version.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectVersionConfig_basic(resourceName, randomKey, randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "project_id", "atlassian_jira_project.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", randomName),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckNoResourceAttr(resourceName, "start_date"),
+					resource.TestCheckNoResourceAttr(resourceName, "release_date"),
+					resource.TestCheckResourceAttr(resourceName, "released", "false"),
+					resource.TestCheckResourceAttr(resourceName, "archived", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccJiraProjectVersion_Release(t *testing.T) {
+	randomKey := strings.ToUpper(acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
+	randomName := acctest.RandomWithPrefix("tf-test-project-version")
+	resourceName := "atlassian_jira_project_version.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectVersionConfig_release(resourceName, randomKey, randomName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "start_date", "2024-01-08"),
+					resource.TestCheckResourceAttr(resourceName, "release_date", "2024-03-29"),
+					resource.TestCheckResourceAttr(resourceName, "released", "true"),
+				),
+			},
+			{
+				Config: testAccProjectVersionConfig_release(resourceName, randomKey, randomName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "released", "false"),
+				),
+			},
+			{
+				Config: testAccProjectVersionConfig_basic(resourceName, randomKey, randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(resourceName, "start_date"),
+					resource.TestCheckNoResourceAttr(resourceName, "release_date"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProjectVersionConfig_project(key, name string) string {
+	return fmt.Sprintf(`
+	data "atlassian_jira_myself" "test" {}
+
+	resource "atlassian_jira_project" "test" {
+		key              = %[1]q
+		name             = %[2]q
+		lead_account_id  = data.atlassian_jira_myself.test.account_id
+		project_type_key = "software"
+	}
+	`, key, name)
+}
+
+func testAccProjectVersionConfig_basic(resourceName, key, name string) string {
+	splits := strings.Split(resourceName, ".")
+	return testAccProjectVersionConfig_project(key, name) + fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		project_id = atlassian_jira_project.test.id
+		name       = %[3]q
+	}
+	`, splits[0], splits[1], name)
+}
+
+func testAccProjectVersionConfig_release(resourceName, key, name string, released bool) string {
+	splits := strings.Split(resourceName, ".")
+	return testAccProjectVersionConfig_project(key, name) + fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		project_id   = atlassian_jira_project.test.id
+		name         = %[3]q
+		start_date   = "2024-01-08"
+		release_date = "2024-03-29"
+		released     = %[4]t
+	}
+	`, splits[0], splits[1], name, released)
+}
@@ -0,0 +1,366 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraProjectWorkflowSchemeResource struct {
+		p atlassianProvider
+	}
+
+	jiraProjectWorkflowSchemeResourceModel struct {
+		ID               types.String                       `tfsdk:"id"`
+		ProjectId        types.String                       `tfsdk:"project_id"`
+		WorkflowSchemeId types.String                       `tfsdk:"workflow_scheme_id"`
+		StatusMappings   []jiraProjectWorkflowStatusMapping `tfsdk:"status_mappings"`
+	}
+
+	jiraProjectWorkflowStatusMapping struct {
+		IssueTypeId types.String `tfsdk:"issue_type_id"`
+		OldStatusId types.String `tfsdk:"old_status_id"`
+		NewStatusId types.String `tfsdk:"new_status_id"`
+	}
+
+	// The go-atlassian library does not support switching the workflow scheme of a project with issues.
+	jiraWorkflowSchemeSwitch struct {
+		ProjectId                   string                              `json:"projectId"`
+		TargetSchemeId              string                              `json:"targetSchemeId"`
+		MappingsByIssueTypeOverride []jiraWorkflowSchemeSwitchIssueType `json:"mappingsByIssueTypeOverride,omitempty"`
+	}
+
+	jiraWorkflowSchemeSwitchIssueType struct {
+		IssueTypeId    string                           `json:"issueTypeId"`
+		StatusMappings []jiraWorkflowSchemeSwitchStatus `json:"statusMappings"`
+	}
+
+	jiraWorkflowSchemeSwitchStatus struct {
+		OldStatusId string `json:"oldStatusId"`
+		NewStatusId string `json:"newStatusId"`
+	}
+)
+
+var (
+	_ resource.Resource                = (*jiraProjectWorkflowSchemeResource)(nil)
+	_ resource.ResourceWithImportState = (*jiraProjectWorkflowSchemeResource)(nil)
+)
+
+func NewJiraProjectWorkflowSchemeResource() resource.Resource {
+	return &jiraProjectWorkflowSchemeResource{}
+}
+
+func (*jiraProjectWorkflowSchemeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_project_workflow_scheme"
+}
+
+func (*jiraProjectWorkflowSchemeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	numericId := stringvalidator.RegexMatches(regexp.MustCompile(`^\d+$`), "value must be a numeric ID")
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Project Workflow Scheme Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project workflow scheme association. It is the same as `project_id`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The ID of the project.",
+				Required:            true,
+				Validators: []validator.String{
+					numericId,
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"workflow_scheme_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the workflow scheme assigned to the project.",
+				Required:            true,
+				Validators: []validator.String{
+					numericId,
+				},
+			},
+			"status_mappings": schema.ListNestedAttribute{
+				MarkdownDescription: "The mappings of the statuses of the current workflows to the statuses of the new workflows, used to migrate the issues of the project. " +
+					"A mapping is required for every status, with issues, that is missing from the new workflow of its issue type.",
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"issue_type_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the issue type the mapping applies to.",
+							Required:            true,
+							Validators: []validator.String{
+								numericId,
+							},
+						},
+						"old_status_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the status in the current workflow.",
+							Required:            true,
+							Validators: []validator.String{
+								numericId,
+							},
+						},
+						"new_status_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the status in the new workflow.",
+							Required:            true,
+							Validators: []validator.String{
+								numericId,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *jiraProjectWorkflowSchemeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.p.jira = client
+}
+
+func (*jiraProjectWorkflowSchemeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), req.ID)...)
+}
+
+func (r *jiraProjectWorkflowSchemeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating project workflow scheme resource")
+
+	var plan jiraProjectWorkflowSchemeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded project workflow scheme plan", map[string]interface{}{
+		"createPlan": fmt.Sprintf("%+v", plan),
+	})
+
+	schemeId, err := getProjectWorkflowSchemeId(ctx, r.p.jira, plan.ProjectId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get project workflow scheme, got error: %s", err))
+		return
+	}
+	if schemeId != plan.WorkflowSchemeId.ValueString() {
+		if err := r.switchWorkflowScheme(ctx, &plan); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to assign workflow scheme to project, got error: %s", err))
+			return
+		}
+	}
+	tflog.Debug(ctx, "Created project workflow scheme in API state")
+
+	plan.ID = plan.ProjectId
+
+	tflog.Debug(ctx, "Storing project workflow scheme into the state", map[string]interface{}{
+		"createNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraProjectWorkflowSchemeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading project workflow scheme resource")
+
+	var state jiraProjectWorkflowSchemeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded project workflow scheme from state", map[string]interface{}{
+		"readState": fmt.Sprintf("%+v", state),
+	})
+
+	schemeId, err := getProjectWorkflowSchemeId(ctx, r.p.jira, state.ProjectId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get project workflow scheme, got error: %s", err))
+		return
+	}
+	if schemeId == "" {
+		tflog.Warn(ctx, "Unable to find project workflow scheme in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	tflog.Debug(ctx, "Retrieved project workflow scheme from API state")
+
+	state.ID = state.ProjectId
+	state.WorkflowSchemeId = types.StringValue(schemeId)
+
+	tflog.Debug(ctx, "Storing project workflow scheme into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", state),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraProjectWorkflowSchemeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating project workflow scheme resource")
+
+	var plan jiraProjectWorkflowSchemeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded project workflow scheme plan", map[string]interface{}{
+		"updatePlan": fmt.Sprintf("%+v", plan),
+	})
+
+	var state jiraProjectWorkflowSchemeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded project workflow scheme from state", map[string]interface{}{
+		"updateState": fmt.Sprintf("%+v", state),
+	})
+
+	// Changes to "status_mappings" alone only apply to future migrations.
+	if !plan.WorkflowSchemeId.Equal(state.WorkflowSchemeId) {
+		if err := r.switchWorkflowScheme(ctx, &plan); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to assign workflow scheme to project, got error: %s", err))
+			return
+		}
+		tflog.Debug(ctx, "Updated project workflow scheme in API state")
+	}
+
+	plan.ID = state.ID
+
+	tflog.Debug(ctx, "Storing project workflow scheme into the state", map[string]interface{}{
+		"updateNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraProjectWorkflowSchemeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting project workflow scheme resource")
+
+	var state jiraProjectWorkflowSchemeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded project workflow scheme from state")
+
+	// The default workflow scheme has no ID, so a null ID assigns it to the project.
+	payload := map[string]interface{}{
+		"workflowSchemeId": nil,
+		"projectId":        state.ProjectId.ValueString(),
+	}
+	request, err := r.p.jira.NewRequest(ctx, http.MethodPut, "rest/api/3/workflowscheme/project", "", payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create project workflow scheme request, got error: %s", err))
+		return
+	}
+	res, err := r.p.jira.Call(request, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to unassign workflow scheme from project, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Deleted project workflow scheme from API state")
+
+	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
+}
+
+// switchWorkflowScheme assigns the planned workflow scheme to the project and waits for the issues to be migrated.
+func (r *jiraProjectWorkflowSchemeResource) switchWorkflowScheme(ctx context.Context, plan *jiraProjectWorkflowSchemeResourceModel) error {
+	payload := &jiraWorkflowSchemeSwitch{
+		ProjectId:      plan.ProjectId.ValueString(),
+		TargetSchemeId: plan.WorkflowSchemeId.ValueString(),
+	}
+	issueTypes := map[string]int{}
+	for _, m := range plan.StatusMappings {
+		status := jiraWorkflowSchemeSwitchStatus{
+			OldStatusId: m.OldStatusId.ValueString(),
+			NewStatusId: m.NewStatusId.ValueString(),
+		}
+		i, ok := issueTypes[m.IssueTypeId.ValueString()]
+		if !ok {
+			i = len(payload.MappingsByIssueTypeOverride)
+			issueTypes[m.IssueTypeId.ValueString()] = i
+			payload.MappingsByIssueTypeOverride = append(payload.MappingsByIssueTypeOverride, jiraWorkflowSchemeSwitchIssueType{
+				IssueTypeId: m.IssueTypeId.ValueString(),
+			})
+		}
+		payload.MappingsByIssueTypeOverride[i].StatusMappings = append(payload.MappingsByIssueTypeOverride[i].StatusMappings, status)
+	}
+
+	return switchProjectWorkflowScheme(ctx, r.p.jira, payload)
+}
+
+// switchProjectWorkflowScheme assigns a workflow scheme to a project and waits for its issues to be migrated.
+// Statuses that are not mapped in the payload are migrated to the status with the same ID in the new workflow.
+func switchProjectWorkflowScheme(ctx context.Context, client *jira.Client, payload *jiraWorkflowSchemeSwitch) error {
+	request, err := client.NewRequest(ctx, http.MethodPost, "rest/api/3/workflowscheme/project/switch", "", payload)
+	if err != nil {
+		return err
+	}
+	res, err := client.Call(request, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		return fmt.Errorf("%s\n%s", err, resBody)
+	}
+
+	// Migrating the issues of a project to a new workflow scheme is an asynchronous operation.
+	if taskId := taskIdFromResponse(res); taskId != "" {
+		return waitForJiraTask(ctx, client, taskId)
+	}
+	return nil
+}
+
+// getProjectWorkflowSchemeId returns the ID of the workflow scheme of a project,
+// or an empty string if the project uses the default workflow scheme.
+func getProjectWorkflowSchemeId(ctx context.Context, client *jira.Client, projectId string) (string, error) {
+	id, err := strconv.Atoi(projectId)
+	if err != nil {
+		return "", err
+	}
+	associations, res, err := client.Workflow.Scheme.Associations(ctx, []int{id})
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		return "", fmt.Errorf("%s\n%s", err, resBody)
+	}
+	for _, association := range associations.Values {
+		if association.WorkflowScheme != nil && association.WorkflowScheme.ID != 0 {
+			return strconv.Itoa(association.WorkflowScheme.ID), nil
+		}
+	}
+	return "", nil
+}
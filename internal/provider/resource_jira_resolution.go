@@ -0,0 +1,356 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openscientia/terraform-provider-atlassian/internal/provider/planmodifiers/boolmodifiers"
+	"github.com/openscientia/terraform-provider-atlassian/internal/provider/planmodifiers/stringmodifiers"
+)
+
+type (
+	jiraResolutionResource struct {
+		p atlassianProvider
+	}
+
+	jiraResolutionResourceModel struct {
+		ID                      types.String `tfsdk:"id"`
+		Name                    types.String `tfsdk:"name"`
+		Description             types.String `tfsdk:"description"`
+		IsDefault               types.Bool   `tfsdk:"is_default"`
+		ReplacementResolutionId types.String `tfsdk:"replacement_resolution_id"`
+	}
+
+	// The go-atlassian library only supports reading resolutions, so the following types
+	// are used to call the "Create resolution", "Update resolution" and "Search resolutions" endpoints.
+	jiraResolutionPayload struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	}
+
+	jiraResolutionDetails struct {
+		ID          string `json:"id,omitempty"`
+		Name        string `json:"name,omitempty"`
+		Description string `json:"description,omitempty"`
+		IsDefault   bool   `json:"isDefault,omitempty"`
+	}
+
+	jiraResolutionPage struct {
+		IsLast bool                    `json:"isLast,omitempty"`
+		Values []jiraResolutionDetails `json:"values,omitempty"`
+	}
+)
+
+var (
+	_ resource.Resource                = (*jiraResolutionResource)(nil)
+	_ resource.ResourceWithImportState = (*jiraResolutionResource)(nil)
+)
+
+func NewJiraResolutionResource() resource.Resource {
+	return &jiraResolutionResource{}
+}
+
+func (*jiraResolutionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_resolution"
+}
+
+func (*jiraResolutionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Resolution Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the resolution.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the resolution. Must be unique. The maximum length is 60 characters.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(60),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the resolution. The maximum length is 255 characters.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringmodifiers.DefaultValue(""),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+				},
+			},
+			"is_default": schema.BoolAttribute{
+				MarkdownDescription: "Whether the resolution is the default resolution. Defaults to `false`. " +
+					"A default resolution can only be unset by setting another resolution as the default resolution.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolmodifiers.DefaultValue(false),
+				},
+			},
+			"replacement_resolution_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the resolution that replaces this resolution in all issues when the resolution is deleted.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *jiraResolutionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.p.jira = client
+}
+
+func (*jiraResolutionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *jiraResolutionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating resolution resource")
+
+	var plan jiraResolutionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded resolution plan", map[string]interface{}{
+		"createPlan": fmt.Sprintf("%+v", plan),
+	})
+
+	payload := &jiraResolutionPayload{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+	}
+	request, err := r.p.jira.NewRequest(ctx, http.MethodPost, "rest/api/3/resolution", "", payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create resolution request, got error: %s", err))
+		return
+	}
+
+	resolution := new(jiraResolutionDetails)
+	res, err := r.p.jira.Call(request, resolution)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create resolution, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Created resolution in API state")
+
+	plan.ID = types.StringValue(resolution.ID)
+
+	if plan.IsDefault.ValueBool() {
+		if err := r.setDefaultResolution(ctx, resolution.ID); err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+		tflog.Debug(ctx, "Set resolution as default resolution")
+	}
+
+	tflog.Debug(ctx, "Storing resolution into the state", map[string]interface{}{
+		"createNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraResolutionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading resolution resource")
+
+	var state jiraResolutionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded resolution from state", map[string]interface{}{
+		"readState": fmt.Sprintf("%+v", state),
+	})
+
+	params := url.Values{}
+	params.Add("id", state.ID.ValueString())
+	request, err := r.p.jira.NewRequest(ctx, http.MethodGet, fmt.Sprintf("rest/api/3/resolution/search?%s", params.Encode()), "", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create resolution request, got error: %s", err))
+		return
+	}
+
+	page := new(jiraResolutionPage)
+	res, err := r.p.jira.Call(request, page)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get resolution, got error: %s\n%s", err, resBody))
+		return
+	}
+	if len(page.Values) == 0 {
+		tflog.Warn(ctx, "Unable to find resolution in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resolution := page.Values[0]
+	tflog.Debug(ctx, "Retrieved resolution from API state")
+
+	state.Name = types.StringValue(resolution.Name)
+	state.Description = types.StringValue(resolution.Description)
+	state.IsDefault = types.BoolValue(resolution.IsDefault)
+
+	tflog.Debug(ctx, "Storing resolution into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", state),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraResolutionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating resolution resource")
+
+	var plan jiraResolutionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded resolution plan", map[string]interface{}{
+		"updatePlan": fmt.Sprintf("%+v", plan),
+	})
+
+	var state jiraResolutionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded resolution from state", map[string]interface{}{
+		"updateState": fmt.Sprintf("%+v", state),
+	})
+
+	if state.IsDefault.ValueBool() && !plan.IsDefault.ValueBool() {
+		resp.Diagnostics.AddAttributeError(path.Root("is_default"), "Unable to unset default resolution.", "The default resolution can only be changed by setting another resolution as the default resolution.")
+		return
+	}
+
+	payload := &jiraResolutionPayload{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+	}
+	request, err := r.p.jira.NewRequest(ctx, http.MethodPut, fmt.Sprintf("rest/api/3/resolution/%s", state.ID.ValueString()), "", payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create resolution request, got error: %s", err))
+		return
+	}
+
+	res, err := r.p.jira.Call(request, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update resolution, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Updated resolution in API state")
+
+	if plan.IsDefault.ValueBool() && !state.IsDefault.ValueBool() {
+		if err := r.setDefaultResolution(ctx, state.ID.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+		tflog.Debug(ctx, "Set resolution as default resolution")
+	}
+
+	plan.ID = state.ID
+
+	tflog.Debug(ctx, "Storing resolution into the state", map[string]interface{}{
+		"updateNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraResolutionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting resolution resource")
+
+	var state jiraResolutionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded resolution from state")
+
+	params := url.Values{}
+	params.Add("replaceWith", state.ReplacementResolutionId.ValueString())
+	request, err := r.p.jira.NewRequest(ctx, http.MethodDelete, fmt.Sprintf("rest/api/3/resolution/%s?%s", state.ID.ValueString(), params.Encode()), "", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create resolution request, got error: %s", err))
+		return
+	}
+
+	res, err := r.p.jira.Call(request, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete resolution, got error: %s\n%s", err, resBody))
+		return
+	}
+
+	// Issues are moved to the replacement resolution by a long-running task.
+	if taskId := taskIdFromResponse(res); taskId != "" {
+		if err := waitForJiraTask(ctx, r.p.jira, taskId); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete resolution, got error: %s", err))
+			return
+		}
+	}
+	tflog.Debug(ctx, "Deleted resolution from API state")
+
+	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
+}
+
+func (r *jiraResolutionResource) setDefaultResolution(ctx context.Context, resolutionId string) error {
+	payload := map[string]string{"id": resolutionId}
+	request, err := r.p.jira.NewRequest(ctx, http.MethodPut, "rest/api/3/resolution/default", "", payload)
+	if err != nil {
+		return fmt.Errorf("Unable to create default resolution request, got error: %s", err)
+	}
+
+	res, err := r.p.jira.Call(request, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		return fmt.Errorf("Unable to set default resolution, got error: %s\n%s", err, resBody)
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraResolution_Basic(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-resolution")
+	resourceName := "atlassian_jira_resolution.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResolutionConfig_basic(resourceName, randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", randomName),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "is_default", "false"),
+					resource.TestCheckResourceAttrPair(resourceName, "replacement_resolution_id", "atlassian_jira_resolution.replacement", "id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"replacement_resolution_id"},
+			},
+		},
+	})
+}
+
+func TestAccJiraResolution_Description(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-resolution")
+	resourceName := "atlassian_jira_resolution.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResolutionConfig_description(resourceName, randomName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+				),
+			},
+			{
+				Config: testAccResolutionConfig_description(resourceName, randomName, "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResolutionConfig_basic(resourceName, name string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	resource %[1]q "replacement" {
+		name                      = "%[3]s-replacement"
+		replacement_resolution_id = "10000"
+	}
+
+	resource %[1]q %[2]q {
+		name                      = %[3]q
+		replacement_resolution_id = %[1]s.replacement.id
+	}
+	`, splits[0], splits[1], name)
+}
+
+func testAccResolutionConfig_description(resourceName, name, description string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		name                      = %[3]q
+		description               = %[4]q
+		replacement_resolution_id = "10000"
+	}
+	`, splits[0], splits[1], name, description)
+}
@@ -3,6 +3,7 @@ package atlassian
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strconv"
 
 	jira "github.com/ctreminiom/go-atlassian/jira/v3"
@@ -12,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -60,6 +62,9 @@ func (*jiraScreenSchemeResource) Schema(_ context.Context, _ resource.SchemaRequ
 			"id": schema.StringAttribute{
 				MarkdownDescription: "The ID of the screen scheme.",
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "The name of the screen scheme. " +
@@ -210,6 +215,11 @@ func (r *jiraScreenSchemeResource) Read(ctx context.Context, req resource.ReadRe
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get screen scheme, got error: %s\n%s", err, resBody))
 		return
 	}
+	if len(resScreenScheme.Values) == 0 {
+		tflog.Warn(ctx, "Unable to find screen scheme in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	tflog.Debug(ctx, "Retrieved screen scheme from API state")
 
 	state.Name = types.StringValue(resScreenScheme.Values[0].Name)
@@ -247,24 +257,35 @@ func (r *jiraScreenSchemeResource) Update(ctx context.Context, req resource.Upda
 		"updateState": fmt.Sprintf("%+v", state),
 	})
 
-	updateRequestPayload := models.ScreenSchemePayloadScheme{
-		Name:        plan.Name.ValueString(),
-		Description: plan.Description.ValueString(),
-		Screens: &models.ScreenTypesScheme{
-			Create:  int(plan.Screens.Create.ValueInt64()),
-			Default: int(plan.Screens.Default.ValueInt64()),
-			View:    int(plan.Screens.View.ValueInt64()),
-			Edit:    int(plan.Screens.Edit.ValueInt64()),
-		},
+	// models.ScreenSchemePayloadScheme omits empty values, which prevents removing the description or a screen.
+	// A null screen ID makes the screen type use the default screen.
+	screens := map[string]interface{}{
+		"default": plan.Screens.Default.ValueInt64(),
 	}
-
-	res, err := r.p.jira.Screen.Scheme.Update(ctx, state.ID.ValueString(), &updateRequestPayload)
+	for screenType, screenId := range map[string]types.Int64{"create": plan.Screens.Create, "view": plan.Screens.View, "edit": plan.Screens.Edit} {
+		screens[screenType] = nil
+		if screenId.ValueInt64() != 0 {
+			screens[screenType] = screenId.ValueInt64()
+		}
+	}
+	payload := map[string]interface{}{
+		"name":        plan.Name.ValueString(),
+		"description": plan.Description.ValueString(),
+		"screens":     screens,
+	}
+	request, err := r.p.jira.NewRequest(ctx, http.MethodPut, fmt.Sprintf("rest/api/3/screenscheme/%s", state.ID.ValueString()), "", payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create screen scheme request, got error: %s", err))
+		return
+	}
+	res, err := r.p.jira.Call(request, nil)
 	if err != nil {
 		var resBody string
 		if res != nil {
 			resBody = res.Bytes.String()
 		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update screen scheme, got error: %s\n%s", err, resBody))
+		return
 	}
 	tflog.Debug(ctx, "Updated screen scheme in API state")
 
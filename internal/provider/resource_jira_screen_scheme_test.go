@@ -88,6 +88,16 @@ func TestAccJiraScreenScheme_Screens(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "screens.default", "1"),
 				),
 			},
+			{
+				Config: testAccScreenSchemeConfig_basic(resourceName, resourceAttributeName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "screens.edit", "0"),
+					resource.TestCheckResourceAttr(resourceName, "screens.create", "0"),
+					resource.TestCheckResourceAttr(resourceName, "screens.view", "0"),
+					resource.TestCheckResourceAttr(resourceName, "screens.default", "1"),
+				),
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -0,0 +1,334 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraScreenTabResource struct {
+		p atlassianProvider
+	}
+
+	jiraScreenTabResourceModel struct {
+		ID       types.String `tfsdk:"id"`
+		ScreenId types.String `tfsdk:"screen_id"`
+		Name     types.String `tfsdk:"name"`
+		Position types.Int64  `tfsdk:"position"`
+	}
+)
+
+var (
+	_ resource.Resource                = (*jiraScreenTabResource)(nil)
+	_ resource.ResourceWithImportState = (*jiraScreenTabResource)(nil)
+)
+
+func NewJiraScreenTabResource() resource.Resource {
+	return &jiraScreenTabResource{}
+}
+
+func (*jiraScreenTabResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_screen_tab"
+}
+
+func (*jiraScreenTabResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Screen Tab Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the screen tab.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"screen_id": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The ID of the screen.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the screen tab. The name must be unique within the screen.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"position": schema.Int64Attribute{
+				MarkdownDescription: "The position of the screen tab, starting at `0` for the first tab. " +
+					"If not set, the tab is added after the existing tabs and its position is not managed.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *jiraScreenTabResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.p.jira = client
+}
+
+func (*jiraScreenTabResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError("Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: screen_id/id. Got: %q", req.ID))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Importing screen tab with import identifier: %+v", idParts))
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("screen_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+}
+
+func (r *jiraScreenTabResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating screen tab resource")
+
+	var plan jiraScreenTabResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded screen tab plan", map[string]interface{}{
+		"createPlan": fmt.Sprintf("%+v", plan),
+	})
+
+	screenId, err := strconv.Atoi(plan.ScreenId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("screen_id"), "Unable to parse value of \"screen_id\" attribute.", "Value of \"screen_id\" attribute can only be a numeric string.")
+		return
+	}
+
+	tab, res, err := r.p.jira.Screen.Tab.Create(ctx, screenId, plan.Name.ValueString())
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create screen tab, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Created screen tab")
+
+	plan.ID = types.StringValue(strconv.Itoa(tab.ID))
+
+	if !plan.Position.IsUnknown() {
+		if err := r.move(ctx, screenId, tab.ID, int(plan.Position.ValueInt64())); err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+	} else {
+		position, err := r.getPosition(ctx, screenId, tab.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+		plan.Position = types.Int64Value(int64(position))
+	}
+
+	tflog.Debug(ctx, "Storing screen tab into the state", map[string]interface{}{
+		"createNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraScreenTabResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading screen tab resource")
+
+	var state jiraScreenTabResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded screen tab from state", map[string]interface{}{
+		"readState": fmt.Sprintf("%+v", state),
+	})
+
+	screenId, err := strconv.Atoi(state.ScreenId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("screen_id"), "Unable to parse value of \"screen_id\" attribute.", "Value of \"screen_id\" attribute can only be a numeric string.")
+		return
+	}
+
+	tabs, res, err := r.p.jira.Screen.Tab.Gets(ctx, screenId, "")
+	if res != nil && res.Code == http.StatusNotFound {
+		tflog.Warn(ctx, "Unable to find screen in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get screen tabs, got error: %s\n%s", err, resBody))
+		return
+	}
+
+	found := false
+	for i, tab := range tabs {
+		if strconv.Itoa(tab.ID) == state.ID.ValueString() {
+			state.Name = types.StringValue(tab.Name)
+			state.Position = types.Int64Value(int64(i))
+			found = true
+		}
+	}
+	if !found {
+		tflog.Warn(ctx, "Unable to find screen tab in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	tflog.Debug(ctx, "Retrieved screen tab from API state")
+
+	tflog.Debug(ctx, "Storing screen tab into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", state),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraScreenTabResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating screen tab resource")
+
+	var plan jiraScreenTabResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded screen tab plan", map[string]interface{}{
+		"updatePlan": fmt.Sprintf("%+v", plan),
+	})
+
+	var state jiraScreenTabResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded screen tab from state", map[string]interface{}{
+		"updateState": fmt.Sprintf("%+v", state),
+	})
+
+	screenId, _ := strconv.Atoi(state.ScreenId.ValueString())
+	tabId, _ := strconv.Atoi(state.ID.ValueString())
+
+	if !plan.Name.Equal(state.Name) {
+		_, res, err := r.p.jira.Screen.Tab.Update(ctx, screenId, tabId, plan.Name.ValueString())
+		if err != nil {
+			var resBody string
+			if res != nil {
+				resBody = res.Bytes.String()
+			}
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update screen tab, got error: %s\n%s", err, resBody))
+			return
+		}
+	}
+
+	if !plan.Position.Equal(state.Position) {
+		if err := r.move(ctx, screenId, tabId, int(plan.Position.ValueInt64())); err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+	}
+	tflog.Debug(ctx, "Updated screen tab in API state")
+
+	plan.ID = state.ID
+
+	tflog.Debug(ctx, "Storing screen tab into the state", map[string]interface{}{
+		"updateNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraScreenTabResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting screen tab resource")
+
+	var state jiraScreenTabResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded screen tab from state")
+
+	screenId, _ := strconv.Atoi(state.ScreenId.ValueString())
+	tabId, _ := strconv.Atoi(state.ID.ValueString())
+
+	res, err := r.p.jira.Screen.Tab.Delete(ctx, screenId, tabId)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete screen tab, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Deleted screen tab from API state")
+
+	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
+}
+
+func (r *jiraScreenTabResource) move(ctx context.Context, screenId, tabId, position int) error {
+	res, err := r.p.jira.Screen.Tab.Move(ctx, screenId, tabId, position)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		return fmt.Errorf("Unable to move screen tab, got error: %s\n%s", err, resBody)
+	}
+	return nil
+}
+
+func (r *jiraScreenTabResource) getPosition(ctx context.Context, screenId, tabId int) (int, error) {
+	tabs, res, err := r.p.jira.Screen.Tab.Gets(ctx, screenId, "")
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		return 0, fmt.Errorf("Unable to get screen tabs, got error: %s\n%s", err, resBody)
+	}
+	for i, tab := range tabs {
+		if tab.ID == tabId {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("Unable to find screen tab %d on screen %d", tabId, screenId)
+}
@@ -0,0 +1,348 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraScreenTabFieldResource struct {
+		p atlassianProvider
+	}
+
+	jiraScreenTabFieldResourceModel struct {
+		ID       types.String `tfsdk:"id"`
+		ScreenId types.String `tfsdk:"screen_id"`
+		TabId    types.String `tfsdk:"tab_id"`
+		FieldId  types.String `tfsdk:"field_id"`
+		After    types.String `tfsdk:"after"`
+		Position types.String `tfsdk:"position"`
+	}
+)
+
+var (
+	_ resource.Resource                = (*jiraScreenTabFieldResource)(nil)
+	_ resource.ResourceWithImportState = (*jiraScreenTabFieldResource)(nil)
+)
+
+func NewJiraScreenTabFieldResource() resource.Resource {
+	return &jiraScreenTabFieldResource{}
+}
+
+func (*jiraScreenTabFieldResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_screen_tab_field"
+}
+
+func (*jiraScreenTabFieldResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Screen Tab Field Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the screen tab field, in the format `screen_id/tab_id/field_id`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"screen_id": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The ID of the screen.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tab_id": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The ID of the screen tab.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"field_id": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The ID of the field, e.g. `summary` or `customfield_10000`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"after": schema.StringAttribute{
+				MarkdownDescription: "The ID of the field after which this field is placed on the screen tab. " +
+					"Conflicts with `position`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("position")),
+				},
+			},
+			"position": schema.StringAttribute{
+				MarkdownDescription: "The position of the field on the screen tab. Can be one of: `First` or `Last`. " +
+					"Conflicts with `after`. If neither `after` nor `position` are set, the field is added last and its position is not managed.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("First", "Last"),
+				},
+			},
+		},
+	}
+}
+
+func (r *jiraScreenTabFieldResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.p.jira = client
+}
+
+func (*jiraScreenTabFieldResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "/")
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError("Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: screen_id/tab_id/field_id. Got: %q", req.ID))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Importing screen tab field with import identifier: %+v", idParts))
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("screen_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tab_id"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("field_id"), idParts[2])...)
+}
+
+func (r *jiraScreenTabFieldResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating screen tab field resource")
+
+	var plan jiraScreenTabFieldResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded screen tab field plan", map[string]interface{}{
+		"createPlan": fmt.Sprintf("%+v", plan),
+	})
+
+	screenId, err := strconv.Atoi(plan.ScreenId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("screen_id"), "Unable to parse value of \"screen_id\" attribute.", "Value of \"screen_id\" attribute can only be a numeric string.")
+		return
+	}
+	tabId, err := strconv.Atoi(plan.TabId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("tab_id"), "Unable to parse value of \"tab_id\" attribute.", "Value of \"tab_id\" attribute can only be a numeric string.")
+		return
+	}
+
+	_, res, err := r.p.jira.Screen.Tab.Field.Add(ctx, screenId, tabId, plan.FieldId.ValueString())
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add field to screen tab, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Created screen tab field")
+
+	plan.ID = types.StringValue(fmt.Sprintf("%d/%d/%s", screenId, tabId, plan.FieldId.ValueString()))
+
+	if !plan.After.IsNull() || !plan.Position.IsNull() {
+		if err := moveScreenTabField(ctx, r.p.jira, screenId, tabId, plan.FieldId.ValueString(), plan.After.ValueString(), plan.Position.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "Storing screen tab field into the state", map[string]interface{}{
+		"createNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraScreenTabFieldResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading screen tab field resource")
+
+	var state jiraScreenTabFieldResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded screen tab field from state", map[string]interface{}{
+		"readState": fmt.Sprintf("%+v", state),
+	})
+
+	screenId, err := strconv.Atoi(state.ScreenId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("screen_id"), "Unable to parse value of \"screen_id\" attribute.", "Value of \"screen_id\" attribute can only be a numeric string.")
+		return
+	}
+	tabId, err := strconv.Atoi(state.TabId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("tab_id"), "Unable to parse value of \"tab_id\" attribute.", "Value of \"tab_id\" attribute can only be a numeric string.")
+		return
+	}
+
+	fields, res, err := r.p.jira.Screen.Tab.Field.Gets(ctx, screenId, tabId)
+	if res != nil && res.Code == http.StatusNotFound {
+		tflog.Warn(ctx, "Unable to find screen tab in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get screen tab fields, got error: %s\n%s", err, resBody))
+		return
+	}
+
+	index := -1
+	for i, f := range fields {
+		if f.ID == state.FieldId.ValueString() {
+			index = i
+		}
+	}
+	if index == -1 {
+		tflog.Warn(ctx, "Unable to find screen tab field in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	tflog.Debug(ctx, "Retrieved screen tab field from API state")
+
+	// The position of the field is only stored when managed, and cleared when the field was moved elsewhere.
+	if !state.After.IsNull() {
+		if index == 0 {
+			state.After = types.StringNull()
+		} else {
+			state.After = types.StringValue(fields[index-1].ID)
+		}
+	}
+	if !state.Position.IsNull() {
+		isFirst := state.Position.ValueString() == "First" && index == 0
+		isLast := state.Position.ValueString() == "Last" && index == len(fields)-1
+		if !isFirst && !isLast {
+			state.Position = types.StringNull()
+		}
+	}
+
+	tflog.Debug(ctx, "Storing screen tab field into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", state),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraScreenTabFieldResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating screen tab field resource")
+
+	var plan jiraScreenTabFieldResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded screen tab field plan", map[string]interface{}{
+		"updatePlan": fmt.Sprintf("%+v", plan),
+	})
+
+	var state jiraScreenTabFieldResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded screen tab field from state", map[string]interface{}{
+		"updateState": fmt.Sprintf("%+v", state),
+	})
+
+	screenId, _ := strconv.Atoi(state.ScreenId.ValueString())
+	tabId, _ := strconv.Atoi(state.TabId.ValueString())
+
+	if (!plan.After.IsNull() || !plan.Position.IsNull()) && (!plan.After.Equal(state.After) || !plan.Position.Equal(state.Position)) {
+		if err := moveScreenTabField(ctx, r.p.jira, screenId, tabId, state.FieldId.ValueString(), plan.After.ValueString(), plan.Position.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+	}
+	tflog.Debug(ctx, "Updated screen tab field in API state")
+
+	plan.ID = state.ID
+
+	tflog.Debug(ctx, "Storing screen tab field into the state", map[string]interface{}{
+		"updateNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraScreenTabFieldResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting screen tab field resource")
+
+	var state jiraScreenTabFieldResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded screen tab field from state")
+
+	screenId, _ := strconv.Atoi(state.ScreenId.ValueString())
+	tabId, _ := strconv.Atoi(state.TabId.ValueString())
+
+	res, err := r.p.jira.Screen.Tab.Field.Remove(ctx, screenId, tabId, state.FieldId.ValueString())
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove field from screen tab, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Deleted screen tab field from API state")
+
+	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
+}
+
+// moveScreenTabField moves a field on a screen tab either after another field or to a position.
+// The go-atlassian library always sends both "after" and "position", even when one of them is empty.
+func moveScreenTabField(ctx context.Context, client *jira.Client, screenId, tabId int, fieldId, after, position string) error {
+	payload := map[string]interface{}{}
+	if after != "" {
+		payload["after"] = after
+	} else {
+		payload["position"] = position
+	}
+	request, err := client.NewRequest(ctx, http.MethodPost, fmt.Sprintf("rest/api/3/screens/%d/tabs/%d/fields/%s/move", screenId, tabId, fieldId), "", payload)
+	if err != nil {
+		return fmt.Errorf("Unable to create screen tab field request, got error: %s", err)
+	}
+	res, err := client.Call(request, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		return fmt.Errorf("Unable to move field %s on screen tab, got error: %s\n%s", fieldId, err, resBody)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccJiraScreenTabField_Basic(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-screen-tab-field")
+	resourceName := "atlassian_jira_screen_tab_field.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScreenTabFieldConfig_basic(resourceName, randomName, `position = "First"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "field_id", "description"),
+					resource.TestCheckResourceAttr(resourceName, "position", "First"),
+				),
+			},
+			{
+				Config: testAccScreenTabFieldConfig_basic(resourceName, randomName, `after = atlassian_jira_screen_tab_field.summary.field_id`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "after", "summary"),
+					resource.TestCheckNoResourceAttr(resourceName, "position"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       testAccScreenTabFieldImportConfig,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"after"},
+			},
+		},
+	})
+}
+
+func testAccScreenTabFieldImportConfig(s *terraform.State) (string, error) {
+	attributes := s.RootModule().Resources["atlassian_jira_screen_tab_field.test"].Primary.Attributes
+	return attributes["id"], nil
+}
+
+func testAccScreenTabFieldConfig_basic(resourceName, name, placement string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	resource "atlassian_jira_issue_screen" "test" {
+		name = %[3]q
+	}
+
+	resource "atlassian_jira_screen_tab" "test" {
+		screen_id = atlassian_jira_issue_screen.test.id
+		name      = %[3]q
+	}
+
+	resource "atlassian_jira_screen_tab_field" "summary" {
+		screen_id = atlassian_jira_issue_screen.test.id
+		tab_id    = atlassian_jira_screen_tab.test.id
+		field_id  = "summary"
+	}
+
+	resource %[1]q %[2]q {
+		screen_id = atlassian_jira_issue_screen.test.id
+		tab_id    = atlassian_jira_screen_tab.test.id
+		field_id  = "description"
+		%[4]s
+
+		depends_on = [atlassian_jira_screen_tab_field.summary]
+	}
+	`, splits[0], splits[1], name, placement)
+}
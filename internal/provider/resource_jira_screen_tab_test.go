@@ -0,0 +1,70 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccJiraScreenTab_Basic(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-screen-tab")
+	resourceName := "atlassian_jira_screen_tab.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScreenTabConfig_basic(resourceName, randomName, "first", 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "screen_id", "atlassian_jira_issue_screen.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", "first"),
+					resource.TestCheckResourceAttr(resourceName, "position", "1"),
+				),
+			},
+			{
+				Config: testAccScreenTabConfig_basic(resourceName, randomName, "second", 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", "second"),
+					resource.TestCheckResourceAttr(resourceName, "position", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccScreenTabImportConfig,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccScreenTabImportConfig(s *terraform.State) (string, error) {
+	attributes := s.RootModule().Resources["atlassian_jira_screen_tab.test"].Primary.Attributes
+	return fmt.Sprintf("%s/%s", attributes["screen_id"], attributes["id"]), nil
+}
+
+func testAccScreenTabConfig_basic(resourceName, screenName, name string, position int) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	resource "atlassian_jira_issue_screen" "test" {
+		name = %[3]q
+	}
+
+	resource "atlassian_jira_screen_tab" "other" {
+		screen_id = atlassian_jira_issue_screen.test.id
+		name      = "other"
+	}
+
+	resource %[1]q %[2]q {
+		screen_id  = atlassian_jira_issue_screen.test.id
+		name       = %[4]q
+		position   = %[5]d
+		depends_on = [atlassian_jira_screen_tab.other]
+	}
+	`, splits[0], splits[1], screenName, name, position)
+}
@@ -0,0 +1,408 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openscientia/terraform-provider-atlassian/internal/provider/planmodifiers/stringmodifiers"
+	"github.com/openscientia/terraform-provider-atlassian/internal/provider/validators"
+)
+
+type (
+	jiraSprintResource struct {
+		p atlassianProvider
+	}
+
+	jiraSprintResourceModel struct {
+		ID        types.String `tfsdk:"id"`
+		BoardId   types.String `tfsdk:"board_id"`
+		Name      types.String `tfsdk:"name"`
+		Goal      types.String `tfsdk:"goal"`
+		StartDate types.String `tfsdk:"start_date"`
+		EndDate   types.String `tfsdk:"end_date"`
+		State     types.String `tfsdk:"state"`
+	}
+
+	// The go-atlassian Jira client does not support the Agile REST API.
+	jiraSprint struct {
+		ID            int64   `json:"id,omitempty"`
+		OriginBoardID int64   `json:"originBoardId,omitempty"`
+		Name          string  `json:"name,omitempty"`
+		Goal          *string `json:"goal,omitempty"`
+		StartDate     string  `json:"startDate,omitempty"`
+		EndDate       string  `json:"endDate,omitempty"`
+		State         string  `json:"state,omitempty"`
+	}
+)
+
+var (
+	_ resource.Resource                = (*jiraSprintResource)(nil)
+	_ resource.ResourceWithImportState = (*jiraSprintResource)(nil)
+)
+
+func NewJiraSprintResource() resource.Resource {
+	return &jiraSprintResource{}
+}
+
+func (*jiraSprintResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_sprint"
+}
+
+func (*jiraSprintResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Sprint Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the sprint.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"board_id": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The ID of the board the sprint is created on.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the sprint. The maximum length is 30 characters.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 30),
+				},
+			},
+			"goal": schema.StringAttribute{
+				MarkdownDescription: "The goal of the sprint.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringmodifiers.DefaultValue(""),
+				},
+			},
+			"start_date": schema.StringAttribute{
+				MarkdownDescription: "The start date of the sprint in RFC 3339 format, e.g. `2006-01-02T15:04:05Z`. " +
+					"Required if `state` is `active` or `closed`.",
+				Optional: true,
+				Validators: []validator.String{
+					validators.Rfc3339(),
+				},
+			},
+			"end_date": schema.StringAttribute{
+				MarkdownDescription: "The end date of the sprint in RFC 3339 format, e.g. `2006-01-02T15:04:05Z`. " +
+					"Required if `state` is `active` or `closed`.",
+				Optional: true,
+				Validators: []validator.String{
+					validators.Rfc3339(),
+				},
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "The state of the sprint. Can be one of: `future`, `active` or `closed`. Defaults to `future`. " +
+					"A sprint can only transition from `future` to `active` and from `active` to `closed`.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("future", "active", "closed"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringmodifiers.DefaultValue("future"),
+				},
+			},
+		},
+	}
+}
+
+func (r *jiraSprintResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.p.jira = client
+}
+
+func (*jiraSprintResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *jiraSprintResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating sprint resource")
+
+	var plan jiraSprintResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded sprint plan", map[string]interface{}{
+		"createPlan": fmt.Sprintf("%+v", plan),
+	})
+
+	if !validateSprintDates(plan, resp.Diagnostics.AddAttributeError) {
+		return
+	}
+
+	boardId, err := strconv.ParseInt(plan.BoardId.ValueString(), 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("board_id"), "Unable to parse value of \"board_id\" attribute.", "Value of \"board_id\" attribute can only be a numeric string.")
+		return
+	}
+	payload := &jiraSprint{
+		OriginBoardID: boardId,
+		Name:          plan.Name.ValueString(),
+		Goal:          plan.Goal.ValueStringPointer(),
+		StartDate:     plan.StartDate.ValueString(),
+		EndDate:       plan.EndDate.ValueString(),
+	}
+	request, err := r.p.jira.NewRequest(ctx, http.MethodPost, "rest/agile/1.0/sprint", "", payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create sprint request, got error: %s", err))
+		return
+	}
+	sprint := new(jiraSprint)
+	res, err := r.p.jira.Call(request, sprint)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create sprint, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Created sprint")
+
+	plan.ID = types.StringValue(strconv.FormatInt(sprint.ID, 10))
+
+	// New sprints are always created in the future state, so walk them through the remaining transitions.
+	for _, state := range sprintTransitions(sprint.State, plan.State.ValueString()) {
+		if err := r.updateSprint(ctx, plan.ID.ValueString(), &jiraSprint{State: state}); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to transition sprint to %q, got error: %s", state, err))
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "Storing sprint into the state", map[string]interface{}{
+		"createNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraSprintResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading sprint resource")
+
+	var state jiraSprintResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded sprint from state", map[string]interface{}{
+		"readState": fmt.Sprintf("%+v", state),
+	})
+
+	request, err := r.p.jira.NewRequest(ctx, http.MethodGet, fmt.Sprintf("rest/agile/1.0/sprint/%s", state.ID.ValueString()), "", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create sprint request, got error: %s", err))
+		return
+	}
+	sprint := new(jiraSprint)
+	res, err := r.p.jira.Call(request, sprint)
+	if res != nil && res.Code == http.StatusNotFound {
+		tflog.Warn(ctx, "Unable to find sprint in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get sprint, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Retrieved sprint from API state")
+
+	state.BoardId = types.StringValue(strconv.FormatInt(sprint.OriginBoardID, 10))
+	state.Name = types.StringValue(sprint.Name)
+	state.Goal = types.StringValue("")
+	if sprint.Goal != nil {
+		state.Goal = types.StringValue(*sprint.Goal)
+	}
+	state.StartDate = sprintDate(state.StartDate, sprint.StartDate)
+	state.EndDate = sprintDate(state.EndDate, sprint.EndDate)
+	state.State = types.StringValue(sprint.State)
+
+	tflog.Debug(ctx, "Storing sprint into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", state),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraSprintResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating sprint resource")
+
+	var plan jiraSprintResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded sprint plan", map[string]interface{}{
+		"updatePlan": fmt.Sprintf("%+v", plan),
+	})
+
+	var state jiraSprintResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded sprint from state", map[string]interface{}{
+		"updateState": fmt.Sprintf("%+v", state),
+	})
+
+	if !validateSprintDates(plan, resp.Diagnostics.AddAttributeError) {
+		return
+	}
+
+	payload := &jiraSprint{
+		Name:      plan.Name.ValueString(),
+		Goal:      plan.Goal.ValueStringPointer(),
+		StartDate: plan.StartDate.ValueString(),
+		EndDate:   plan.EndDate.ValueString(),
+	}
+	if err := r.updateSprint(ctx, state.ID.ValueString(), payload); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update sprint, got error: %s", err))
+		return
+	}
+	for _, s := range sprintTransitions(state.State.ValueString(), plan.State.ValueString()) {
+		if err := r.updateSprint(ctx, state.ID.ValueString(), &jiraSprint{State: s}); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to transition sprint to %q, got error: %s", s, err))
+			return
+		}
+	}
+	tflog.Debug(ctx, "Updated sprint in API state")
+
+	plan.ID = state.ID
+
+	tflog.Debug(ctx, "Storing sprint into the state", map[string]interface{}{
+		"updateNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraSprintResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting sprint resource")
+
+	var state jiraSprintResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded sprint from state")
+
+	request, err := r.p.jira.NewRequest(ctx, http.MethodDelete, fmt.Sprintf("rest/agile/1.0/sprint/%s", state.ID.ValueString()), "", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create sprint request, got error: %s", err))
+		return
+	}
+	res, err := r.p.jira.Call(request, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete sprint, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Deleted sprint from API state")
+
+	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
+}
+
+// updateSprint partially updates a sprint, i.e. only the fields set in the payload are changed.
+func (r *jiraSprintResource) updateSprint(ctx context.Context, sprintId string, payload *jiraSprint) error {
+	request, err := r.p.jira.NewRequest(ctx, http.MethodPost, fmt.Sprintf("rest/agile/1.0/sprint/%s", sprintId), "", payload)
+	if err != nil {
+		return err
+	}
+	res, err := r.p.jira.Call(request, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		return fmt.Errorf("%s\n%s", err, resBody)
+	}
+	return nil
+}
+
+// sprintTransitions returns the states a sprint has to go through to reach the target state.
+func sprintTransitions(from, to string) []string {
+	states := []string{"future", "active", "closed"}
+	var fromIndex, toIndex int
+	for i, s := range states {
+		if s == from {
+			fromIndex = i
+		}
+		if s == to {
+			toIndex = i
+		}
+	}
+	if toIndex <= fromIndex {
+		return nil
+	}
+	return states[fromIndex+1 : toIndex+1]
+}
+
+// sprintDate keeps the configured formatting of a sprint date unless it refers to a different instant.
+func sprintDate(current types.String, value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return types.StringValue(value)
+	}
+	if c, err := time.Parse(time.RFC3339, current.ValueString()); err == nil && c.Equal(t) {
+		return current
+	}
+	return types.StringValue(t.Format(time.RFC3339))
+}
+
+// validateSprintDates checks that the dates required by active and closed sprints are provided.
+func validateSprintDates(plan jiraSprintResourceModel, addError func(path.Path, string, string)) bool {
+	if plan.State.ValueString() == "future" {
+		return true
+	}
+	valid := true
+	for name, value := range map[string]types.String{"start_date": plan.StartDate, "end_date": plan.EndDate} {
+		if value.IsNull() {
+			addError(path.Root(name),
+				fmt.Sprintf("Failed to provide a value for %q attribute", name),
+				fmt.Sprintf("Value must be provided if \"state\" is: %s", plan.State.ValueString()))
+			valid = false
+		}
+	}
+	return valid
+}
@@ -0,0 +1,87 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraSprint_Basic(t *testing.T) {
+	randomKey := strings.ToUpper(acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
+	randomName := acctest.RandomWithPrefix("tf-test-sprint")
+	resourceName := "atlassian_jira_sprint.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSprintConfig_basic(resourceName, randomKey, randomName, "foo", "future"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "board_id", "atlassian_jira_board.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", "Sprint 1"),
+					resource.TestCheckResourceAttr(resourceName, "goal", "foo"),
+					resource.TestCheckResourceAttr(resourceName, "start_date", "2024-01-08T09:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "end_date", "2024-01-22T17:00:00Z"),
+					resource.TestCheckResourceAttr(resourceName, "state", "future"),
+				),
+			},
+			{
+				Config: testAccSprintConfig_basic(resourceName, randomKey, randomName, "", "active"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "goal", ""),
+					resource.TestCheckResourceAttr(resourceName, "state", "active"),
+				),
+			},
+			{
+				Config: testAccSprintConfig_basic(resourceName, randomKey, randomName, "", "closed"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "state", "closed"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSprintConfig_basic(resourceName, key, name, goal, state string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	data "atlassian_jira_myself" "test" {}
+
+	resource "atlassian_jira_project" "test" {
+		key              = %[3]q
+		name             = %[4]q
+		lead_account_id  = data.atlassian_jira_myself.test.account_id
+		project_type_key = "software"
+	}
+
+	resource "atlassian_jira_filter" "test" {
+		name = %[4]q
+		jql  = "project = ${atlassian_jira_project.test.key} ORDER BY Rank ASC"
+	}
+
+	resource "atlassian_jira_board" "test" {
+		name       = %[4]q
+		type       = "scrum"
+		filter_id  = atlassian_jira_filter.test.id
+		project_id = atlassian_jira_project.test.id
+	}
+
+	resource %[1]q %[2]q {
+		board_id   = atlassian_jira_board.test.id
+		name       = "Sprint 1"
+		goal       = %[5]q
+		start_date = "2024-01-08T09:00:00Z"
+		end_date   = "2024-01-22T17:00:00Z"
+		state      = %[6]q
+	}
+	`, splits[0], splits[1], key, name, goal, state)
+}
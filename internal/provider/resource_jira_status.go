@@ -228,6 +228,11 @@ func (r *jiraStatusResource) Read(ctx context.Context, req resource.ReadRequest,
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get status, got error: %s\n%s", err, resBody))
 		return
 	}
+	if len(status) == 0 {
+		tflog.Warn(ctx, "Unable to find status in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	tflog.Debug(ctx, "Retrieved status from API state", map[string]interface{}{
 		"status": fmt.Sprintf("%v", &status[0].Scope.Type),
 	})
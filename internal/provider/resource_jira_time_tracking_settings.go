@@ -0,0 +1,329 @@
+package atlassian
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openscientia/terraform-provider-atlassian/internal/provider/planmodifiers/float64modifiers"
+	"github.com/openscientia/terraform-provider-atlassian/internal/provider/planmodifiers/stringmodifiers"
+)
+
+type (
+	jiraTimeTrackingSettingsResource struct {
+		p atlassianProvider
+	}
+
+	jiraTimeTrackingSettingsResourceModel struct {
+		ID                 types.String  `tfsdk:"id"`
+		ProviderKey        types.String  `tfsdk:"provider_key"`
+		WorkingHoursPerDay types.Float64 `tfsdk:"working_hours_per_day"`
+		WorkingDaysPerWeek types.Float64 `tfsdk:"working_days_per_week"`
+		TimeFormat         types.String  `tfsdk:"time_format"`
+		DefaultUnit        types.String  `tfsdk:"default_unit"`
+	}
+
+	// The go-atlassian library does not support the time tracking API.
+	jiraTimeTrackingProvider struct {
+		Key  string `json:"key"`
+		Name string `json:"name,omitempty"`
+		URL  string `json:"url,omitempty"`
+	}
+
+	jiraTimeTrackingOptions struct {
+		WorkingHoursPerDay float64 `json:"workingHoursPerDay"`
+		WorkingDaysPerWeek float64 `json:"workingDaysPerWeek"`
+		TimeFormat         string  `json:"timeFormat"`
+		DefaultUnit        string  `json:"defaultUnit"`
+	}
+)
+
+var (
+	_ resource.Resource                = (*jiraTimeTrackingSettingsResource)(nil)
+	_ resource.ResourceWithImportState = (*jiraTimeTrackingSettingsResource)(nil)
+)
+
+func NewJiraTimeTrackingSettingsResource() resource.Resource {
+	return &jiraTimeTrackingSettingsResource{}
+}
+
+func (*jiraTimeTrackingSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_time_tracking_settings"
+}
+
+func (*jiraTimeTrackingSettingsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Time Tracking Settings Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the time tracking settings. Defaults to the host of the Jira site, as there is a single set of settings per site.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"provider_key": schema.StringAttribute{
+				MarkdownDescription: "The key of the time tracking provider. Defaults to `JIRA`, the time tracking provided by Jira. " +
+					"Other providers are added by apps, e.g. Tempo.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringmodifiers.DefaultValue("JIRA"),
+				},
+			},
+			"working_hours_per_day": schema.Float64Attribute{
+				MarkdownDescription: "The number of hours in a working day. Must be between 1 and 24. Defaults to `8`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Float64{
+					float64validator.Between(1, 24),
+				},
+				PlanModifiers: []planmodifier.Float64{
+					float64modifiers.DefaultValue(8),
+				},
+			},
+			"working_days_per_week": schema.Float64Attribute{
+				MarkdownDescription: "The number of days in a working week. Must be between 1 and 7. Defaults to `5`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Float64{
+					float64validator.Between(1, 7),
+				},
+				PlanModifiers: []planmodifier.Float64{
+					float64modifiers.DefaultValue(5),
+				},
+			},
+			"time_format": schema.StringAttribute{
+				MarkdownDescription: "The format that is used to display time tracking information. Can be one of: `pretty` (e.g. `1w 2d 3h`), " +
+					"`days` (e.g. `2.4d`) or `hours` (e.g. `19h`). Defaults to `pretty`.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("pretty", "days", "hours"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringmodifiers.DefaultValue("pretty"),
+				},
+			},
+			"default_unit": schema.StringAttribute{
+				MarkdownDescription: "The unit that is used when a time value is entered without a unit. Can be one of: `minute`, `hour`, `day` or `week`. " +
+					"Defaults to `minute`.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("minute", "hour", "day", "week"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringmodifiers.DefaultValue("minute"),
+				},
+			},
+		},
+	}
+}
+
+func (r *jiraTimeTrackingSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.p.jira = client
+}
+
+func (*jiraTimeTrackingSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *jiraTimeTrackingSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating time tracking settings resource")
+
+	var plan jiraTimeTrackingSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded time tracking settings plan", map[string]interface{}{
+		"createPlan": fmt.Sprintf("%+v", plan),
+	})
+
+	if err := r.updateTimeTracking(ctx, "rest/api/3/configuration/timetracking", &jiraTimeTrackingProvider{Key: plan.ProviderKey.ValueString()}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to select time tracking provider, got error: %s", err))
+		return
+	}
+	if err := r.updateTimeTracking(ctx, "rest/api/3/configuration/timetracking/options", timeTrackingOptionsPayload(&plan)); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set time tracking options, got error: %s", err))
+		return
+	}
+	tflog.Debug(ctx, "Created time tracking settings")
+
+	plan.ID = types.StringValue(r.p.jira.Site.Host)
+
+	tflog.Debug(ctx, "Storing time tracking settings into the state", map[string]interface{}{
+		"createNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraTimeTrackingSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading time tracking settings resource")
+
+	var state jiraTimeTrackingSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded time tracking settings from state", map[string]interface{}{
+		"readState": fmt.Sprintf("%+v", state),
+	})
+
+	request, err := r.p.jira.NewRequest(ctx, http.MethodGet, "rest/api/3/configuration/timetracking", "", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create time tracking provider request, got error: %s", err))
+		return
+	}
+	// The response has no content if time tracking is disabled, so it cannot be decoded by the client.
+	res, err := r.p.jira.Call(request, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get time tracking provider, got error: %s\n%s", err, resBody))
+		return
+	}
+	var provider jiraTimeTrackingProvider
+	if res.Bytes.Len() > 0 {
+		if err := json.Unmarshal(res.Bytes.Bytes(), &provider); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to decode time tracking provider, got error: %s", err))
+			return
+		}
+	}
+	tflog.Debug(ctx, "Retrieved time tracking provider from API state")
+
+	request, err = r.p.jira.NewRequest(ctx, http.MethodGet, "rest/api/3/configuration/timetracking/options", "", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create time tracking options request, got error: %s", err))
+		return
+	}
+	var options jiraTimeTrackingOptions
+	res, err = r.p.jira.Call(request, &options)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get time tracking options, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Retrieved time tracking options from API state")
+
+	state.ID = types.StringValue(r.p.jira.Site.Host)
+	state.ProviderKey = types.StringValue(provider.Key)
+	state.WorkingHoursPerDay = types.Float64Value(options.WorkingHoursPerDay)
+	state.WorkingDaysPerWeek = types.Float64Value(options.WorkingDaysPerWeek)
+	state.TimeFormat = types.StringValue(options.TimeFormat)
+	state.DefaultUnit = types.StringValue(options.DefaultUnit)
+
+	tflog.Debug(ctx, "Storing time tracking settings into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", state),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraTimeTrackingSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating time tracking settings resource")
+
+	var plan jiraTimeTrackingSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded time tracking settings plan", map[string]interface{}{
+		"updatePlan": fmt.Sprintf("%+v", plan),
+	})
+
+	var state jiraTimeTrackingSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded time tracking settings from state", map[string]interface{}{
+		"updateState": fmt.Sprintf("%+v", state),
+	})
+
+	if !plan.ProviderKey.Equal(state.ProviderKey) {
+		if err := r.updateTimeTracking(ctx, "rest/api/3/configuration/timetracking", &jiraTimeTrackingProvider{Key: plan.ProviderKey.ValueString()}); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to select time tracking provider, got error: %s", err))
+			return
+		}
+	}
+	if err := r.updateTimeTracking(ctx, "rest/api/3/configuration/timetracking/options", timeTrackingOptionsPayload(&plan)); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set time tracking options, got error: %s", err))
+		return
+	}
+	tflog.Debug(ctx, "Updated time tracking settings in API state")
+
+	plan.ID = state.ID
+
+	tflog.Debug(ctx, "Storing time tracking settings into the state", map[string]interface{}{
+		"updateNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraTimeTrackingSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Time tracking settings cannot be deleted, so the settings are left in their current state.
+	tflog.Debug(ctx, "Time tracking settings cannot be deleted, removing time tracking settings from state only")
+
+	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
+}
+
+func (r *jiraTimeTrackingSettingsResource) updateTimeTracking(ctx context.Context, endpoint string, payload interface{}) error {
+	request, err := r.p.jira.NewRequest(ctx, http.MethodPut, endpoint, "", payload)
+	if err != nil {
+		return err
+	}
+	res, err := r.p.jira.Call(request, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		return fmt.Errorf("%s\n%s", err, resBody)
+	}
+	return nil
+}
+
+func timeTrackingOptionsPayload(m *jiraTimeTrackingSettingsResourceModel) *jiraTimeTrackingOptions {
+	return &jiraTimeTrackingOptions{
+		WorkingHoursPerDay: m.WorkingHoursPerDay.ValueFloat64(),
+		WorkingDaysPerWeek: m.WorkingDaysPerWeek.ValueFloat64(),
+		TimeFormat:         m.TimeFormat.ValueString(),
+		DefaultUnit:        m.DefaultUnit.ValueString(),
+	}
+}
@@ -0,0 +1,67 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraTimeTrackingSettings_Basic(t *testing.T) {
+	resourceName := "atlassian_jira_time_tracking_settings.test"
+	// There is a single set of time tracking settings per site, so the test must not run in parallel.
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTimeTrackingSettingsConfig_basic(resourceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "provider_key", "JIRA"),
+					resource.TestCheckResourceAttr(resourceName, "working_hours_per_day", "8"),
+					resource.TestCheckResourceAttr(resourceName, "working_days_per_week", "5"),
+					resource.TestCheckResourceAttr(resourceName, "time_format", "pretty"),
+					resource.TestCheckResourceAttr(resourceName, "default_unit", "minute"),
+				),
+			},
+			{
+				Config: testAccTimeTrackingSettingsConfig_update(resourceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "working_hours_per_day", "7.5"),
+					resource.TestCheckResourceAttr(resourceName, "working_days_per_week", "4"),
+					resource.TestCheckResourceAttr(resourceName, "time_format", "hours"),
+					resource.TestCheckResourceAttr(resourceName, "default_unit", "hour"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTimeTrackingSettingsConfig_basic(resourceName),
+			},
+		},
+	})
+}
+
+func testAccTimeTrackingSettingsConfig_basic(resourceName string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	resource %[1]q %[2]q {}
+	`, splits[0], splits[1])
+}
+
+func testAccTimeTrackingSettingsConfig_update(resourceName string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		working_hours_per_day = 7.5
+		working_days_per_week = 4
+		time_format           = "hours"
+		default_unit          = "hour"
+	}
+	`, splits[0], splits[1])
+}
@@ -0,0 +1,271 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type (
+	jiraUserResource struct {
+		p atlassianProvider
+	}
+
+	jiraUserResourceModel struct {
+		ID           types.String `tfsdk:"id"`
+		AccountID    types.String `tfsdk:"account_id"`
+		EmailAddress types.String `tfsdk:"email_address"`
+		Products     types.Set    `tfsdk:"products"`
+		DisplayName  types.String `tfsdk:"display_name"`
+		Active       types.Bool   `tfsdk:"active"`
+	}
+)
+
+var (
+	_ resource.Resource                = (*jiraUserResource)(nil)
+	_ resource.ResourceWithImportState = (*jiraUserResource)(nil)
+)
+
+func NewJiraUserResource() resource.Resource {
+	return &jiraUserResource{}
+}
+
+func (*jiraUserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_user"
+}
+
+func (*jiraUserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira User Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the user. Defaults to `account_id`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_id": schema.StringAttribute{
+				MarkdownDescription: "The account ID of the user, which uniquely identifies the user across all Atlassian products.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"email_address": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The email address of the user. An invitation is sent to this address.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"products": schema.SetAttribute{
+				MarkdownDescription: "(Forces new) The products the user is given access to. " +
+					"Can contain: `jira-core`, `jira-servicedesk`, `jira-product-discovery` or `jira-software`. " +
+					"If empty, the user is created without access to any product.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.OneOf("jira-core", "jira-servicedesk", "jira-product-discovery", "jira-software"),
+					),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				MarkdownDescription: "The display name of the user. Depending on the user’s privacy settings, this may return an alternative value.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user is active.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *jiraUserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.p.jira = client
+}
+
+func (*jiraUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *jiraUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating user resource")
+
+	var plan jiraUserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded user plan", map[string]interface{}{
+		"createPlan": fmt.Sprintf("%+v", plan),
+	})
+
+	products := []string{}
+	resp.Diagnostics.Append(plan.Products.ElementsAs(ctx, &products, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The go-atlassian library does not support the "products" field, which is required by the API.
+	payload := map[string]interface{}{
+		"emailAddress": plan.EmailAddress.ValueString(),
+		"products":     products,
+	}
+	request, err := r.p.jira.NewRequest(ctx, http.MethodPost, "rest/api/3/user", "", payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create user request, got error: %s", err))
+		return
+	}
+	user := new(struct {
+		AccountID   string `json:"accountId"`
+		DisplayName string `json:"displayName"`
+		Active      bool   `json:"active"`
+	})
+	res, err := r.p.jira.Call(request, user)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create user, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Created user")
+
+	plan.ID = types.StringValue(user.AccountID)
+	plan.AccountID = types.StringValue(user.AccountID)
+	plan.DisplayName = types.StringValue(user.DisplayName)
+	plan.Active = types.BoolValue(user.Active)
+
+	tflog.Debug(ctx, "Storing user into the state", map[string]interface{}{
+		"createNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading user resource")
+
+	var state jiraUserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded user from state", map[string]interface{}{
+		"readState": fmt.Sprintf("%+v", state),
+	})
+
+	user, res, err := r.p.jira.User.Get(ctx, state.ID.ValueString(), []string{"applicationRoles"})
+	if res != nil && res.Code == http.StatusNotFound {
+		tflog.Warn(ctx, "Unable to find user in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get user, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Retrieved user from API state")
+
+	state.AccountID = types.StringValue(user.AccountID)
+	state.DisplayName = types.StringValue(user.DisplayName)
+	state.Active = types.BoolValue(user.Active)
+	// Depending on the user's privacy settings, the email address may not be returned.
+	if state.EmailAddress.IsNull() && user.EmailAddress != "" {
+		state.EmailAddress = types.StringValue(user.EmailAddress)
+	}
+	// The products are only read on import, as access to products can also be granted through groups.
+	if state.Products.IsNull() {
+		products := []attr.Value{}
+		if user.ApplicationRoles != nil {
+			for _, role := range user.ApplicationRoles.Items {
+				products = append(products, types.StringValue(role.Key))
+			}
+		}
+		state.Products = types.SetValueMust(types.StringType, products)
+	}
+
+	tflog.Debug(ctx, "Storing user into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", state),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// The RequiresReplace plan modifier will trigger Terraform to destroy and recreate the resource
+	// if any of the required attributes changes, i.e. email_address and/or products.
+	tflog.Debug(ctx, "If the value of any required attribute changes, Terraform will destroy and recreate the resource")
+}
+
+func (r *jiraUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting user resource")
+
+	var state jiraUserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded user from state")
+
+	res, err := r.p.jira.User.Delete(ctx, state.ID.ValueString())
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete user, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Deleted user from API state")
+
+	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
+}
@@ -0,0 +1,306 @@
+package atlassian
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openscientia/terraform-provider-atlassian/internal/provider/validators"
+)
+
+type (
+	jiraUserPropertyResource struct {
+		p atlassianProvider
+	}
+
+	jiraUserPropertyResourceModel struct {
+		ID        types.String `tfsdk:"id"`
+		AccountID types.String `tfsdk:"account_id"`
+		Key       types.String `tfsdk:"key"`
+		Value     types.String `tfsdk:"value"`
+	}
+
+	// The go-atlassian library does not support user properties.
+	jiraEntityProperty struct {
+		Key   string          `json:"key"`
+		Value json.RawMessage `json:"value"`
+	}
+)
+
+var (
+	_ resource.Resource                = (*jiraUserPropertyResource)(nil)
+	_ resource.ResourceWithImportState = (*jiraUserPropertyResource)(nil)
+)
+
+func NewJiraUserPropertyResource() resource.Resource {
+	return &jiraUserPropertyResource{}
+}
+
+func (*jiraUserPropertyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_user_property"
+}
+
+func (*jiraUserPropertyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira User Property Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the user property. It is computed using `account_id` and `key` separated by a slash (`/`).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"account_id": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The account ID of the user, which uniquely identifies the user across all Atlassian products.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The key of the user property. The maximum length is 255 characters.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "The value of the user property as a JSON document, e.g. encoded with `jsonencode`. " +
+					"The maximum size is 32768 bytes.",
+				Required: true,
+				Validators: []validator.String{
+					validators.Json(),
+					stringvalidator.LengthAtMost(32768),
+				},
+			},
+		},
+	}
+}
+
+func (r *jiraUserPropertyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.p.jira = client
+}
+
+func (*jiraUserPropertyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.SplitN(req.ID, "/", 2)
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: account_id/key. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("account_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), idParts[1])...)
+}
+
+func (r *jiraUserPropertyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating user property resource")
+
+	var plan jiraUserPropertyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded user property plan", map[string]interface{}{
+		"createPlan": fmt.Sprintf("%+v", plan),
+	})
+
+	if err := r.setUserProperty(ctx, plan); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create user property, got error: %s", err))
+		return
+	}
+	tflog.Debug(ctx, "Created user property")
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s/%s", plan.AccountID.ValueString(), plan.Key.ValueString()))
+
+	tflog.Debug(ctx, "Storing user property into the state", map[string]interface{}{
+		"createNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraUserPropertyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading user property resource")
+
+	var state jiraUserPropertyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded user property from state", map[string]interface{}{
+		"readState": fmt.Sprintf("%+v", state),
+	})
+
+	params := url.Values{}
+	params.Add("accountId", state.AccountID.ValueString())
+	request, err := r.p.jira.NewRequest(ctx, http.MethodGet, fmt.Sprintf("rest/api/3/user/properties/%s?%s", url.PathEscape(state.Key.ValueString()), params.Encode()), "", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create user property request, got error: %s", err))
+		return
+	}
+	property := new(jiraEntityProperty)
+	res, err := r.p.jira.Call(request, property)
+	if res != nil && res.Code == http.StatusNotFound {
+		tflog.Warn(ctx, "Unable to find user property in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get user property, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Retrieved user property from API state")
+
+	// Keep the configured formatting of the value unless it differs semantically.
+	if !jsonEqual(state.Value.ValueString(), string(property.Value)) {
+		state.Value = types.StringValue(string(property.Value))
+	}
+	state.ID = types.StringValue(fmt.Sprintf("%s/%s", state.AccountID.ValueString(), state.Key.ValueString()))
+
+	tflog.Debug(ctx, "Storing user property into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", state),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraUserPropertyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating user property resource")
+
+	var plan jiraUserPropertyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded user property plan", map[string]interface{}{
+		"updatePlan": fmt.Sprintf("%+v", plan),
+	})
+
+	var state jiraUserPropertyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded user property from state", map[string]interface{}{
+		"updateState": fmt.Sprintf("%+v", state),
+	})
+
+	if err := r.setUserProperty(ctx, plan); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update user property, got error: %s", err))
+		return
+	}
+	tflog.Debug(ctx, "Updated user property in API state")
+
+	plan.ID = state.ID
+
+	tflog.Debug(ctx, "Storing user property into the state", map[string]interface{}{
+		"updateNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraUserPropertyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting user property resource")
+
+	var state jiraUserPropertyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded user property from state")
+
+	params := url.Values{}
+	params.Add("accountId", state.AccountID.ValueString())
+	request, err := r.p.jira.NewRequest(ctx, http.MethodDelete, fmt.Sprintf("rest/api/3/user/properties/%s?%s", url.PathEscape(state.Key.ValueString()), params.Encode()), "", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create user property request, got error: %s", err))
+		return
+	}
+	res, err := r.p.jira.Call(request, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete user property, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Deleted user property from API state")
+
+	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
+}
+
+func (r *jiraUserPropertyResource) setUserProperty(ctx context.Context, plan jiraUserPropertyResourceModel) error {
+	params := url.Values{}
+	params.Add("accountId", plan.AccountID.ValueString())
+	request, err := r.p.jira.NewRequest(ctx, http.MethodPut, fmt.Sprintf("rest/api/3/user/properties/%s?%s", url.PathEscape(plan.Key.ValueString()), params.Encode()), "", json.RawMessage(plan.Value.ValueString()))
+	if err != nil {
+		return err
+	}
+	res, err := r.p.jira.Call(request, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		return fmt.Errorf("%s\n%s", err, resBody)
+	}
+	return nil
+}
+
+// jsonEqual reports whether two JSON documents are semantically equal, ignoring formatting and key order.
+func jsonEqual(a, b string) bool {
+	var va, vb interface{}
+	if err := json.NewDecoder(bytes.NewBufferString(a)).Decode(&va); err != nil {
+		return false
+	}
+	if err := json.NewDecoder(bytes.NewBufferString(b)).Decode(&vb); err != nil {
+		return false
+	}
+	ja, _ := json.Marshal(va)
+	jb, _ := json.Marshal(vb)
+	return bytes.Equal(ja, jb)
+}
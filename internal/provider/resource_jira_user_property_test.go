@@ -0,0 +1,56 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraUserProperty_Basic(t *testing.T) {
+	randomKey := acctest.RandomWithPrefix("tf-test-user-property")
+	resourceName := "atlassian_jira_user_property.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserPropertyConfig_basic(resourceName, randomKey, "foo"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "account_id", "data.atlassian_jira_myself.test", "account_id"),
+					resource.TestCheckResourceAttr(resourceName, "key", randomKey),
+					resource.TestCheckResourceAttr(resourceName, "value", `{"enabled":true,"name":"foo"}`),
+				),
+			},
+			{
+				Config: testAccUserPropertyConfig_basic(resourceName, randomKey, "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "value", `{"enabled":true,"name":"bar"}`),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccUserPropertyConfig_basic(resourceName, key, name string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	data "atlassian_jira_myself" "test" {}
+
+	resource %[1]q %[2]q {
+		account_id = data.atlassian_jira_myself.test.account_id
+		key        = %[3]q
+		value      = jsonencode({
+			enabled = true
+			name    = %[4]q
+		})
+	}
+	`, splits[0], splits[1], key, name)
+}
@@ -0,0 +1,41 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraUser_Basic(t *testing.T) {
+	randomEmail := fmt.Sprintf("%s@example.com", acctest.RandomWithPrefix("tf-test-user"))
+	resourceName := "atlassian_jira_user.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserConfig_basic(resourceName, randomEmail),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "account_id", resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "email_address", randomEmail),
+					resource.TestCheckResourceAttr(resourceName, "products.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "display_name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccUserConfig_basic(resourceName, email string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		email_address = %[3]q
+		products      = []
+	}
+	`, splits[0], splits[1], email)
+}
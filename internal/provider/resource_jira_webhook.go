@@ -0,0 +1,330 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openscientia/terraform-provider-atlassian/internal/provider/validators"
+)
+
+type (
+	jiraWebhookResource struct {
+		p atlassianProvider
+	}
+
+	jiraWebhookResourceModel struct {
+		ID          types.String `tfsdk:"id"`
+		Name        types.String `tfsdk:"name"`
+		Url         types.String `tfsdk:"url"`
+		JqlFilter   types.String `tfsdk:"jql_filter"`
+		Events      types.Set    `tfsdk:"events"`
+		ExcludeBody types.Bool   `tfsdk:"exclude_body"`
+	}
+
+	// The go-atlassian library does not support webhooks, so the following type is used
+	// to call the admin webhook endpoints, which register webhooks that do not expire.
+	jiraWebhook struct {
+		Self        string            `json:"self,omitempty"`
+		Name        string            `json:"name"`
+		Url         string            `json:"url"`
+		Events      []string          `json:"events"`
+		Filters     map[string]string `json:"filters"`
+		ExcludeBody bool              `json:"excludeBody"`
+	}
+)
+
+// jiraWebhookJqlFilter is the key of the JQL filter in the filters of a webhook.
+const jiraWebhookJqlFilter = "issue-related-events-section"
+
+var (
+	_ resource.Resource                = (*jiraWebhookResource)(nil)
+	_ resource.ResourceWithImportState = (*jiraWebhookResource)(nil)
+)
+
+var webhook_events = []string{
+	"comment_created", "comment_deleted", "comment_updated", "issue_property_deleted", "issue_property_set",
+	"jira:issue_created", "jira:issue_deleted", "jira:issue_updated",
+}
+
+func NewJiraWebhookResource() resource.Resource {
+	return &jiraWebhookResource{}
+}
+
+func (*jiraWebhookResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_webhook"
+}
+
+func (*jiraWebhookResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Webhook Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the webhook.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the webhook.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "The URL that receives the webhooks.",
+				Required:            true,
+				Validators: []validator.String{
+					validators.UrlWithScheme("https"),
+				},
+			},
+			"jql_filter": schema.StringAttribute{
+				MarkdownDescription: "The JQL filter that specifies which issues the webhook is sent for.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"events": schema.SetAttribute{
+				MarkdownDescription: "The Jira events that trigger the webhook. " +
+					"Can contain: `comment_created`, `comment_deleted`, `comment_updated`, `issue_property_deleted`, " +
+					"`issue_property_set`, `jira:issue_created`, `jira:issue_deleted` or `jira:issue_updated`.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(webhook_events...)),
+				},
+			},
+			"exclude_body": schema.BoolAttribute{
+				MarkdownDescription: "Whether the request sent to the URL has no body. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *jiraWebhookResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.p.jira = client
+}
+
+func (*jiraWebhookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *jiraWebhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating webhook resource")
+
+	var plan jiraWebhookResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded webhook plan", map[string]interface{}{
+		"createPlan": fmt.Sprintf("%+v", plan),
+	})
+
+	payload := &jiraWebhook{}
+	resp.Diagnostics.Append(expandJiraWebhook(ctx, plan, payload)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webhook, err := r.callWebhook(ctx, http.MethodPost, "rest/webhooks/1.0/webhook", payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create webhook, got error: %s", err))
+		return
+	}
+	tflog.Debug(ctx, "Created webhook")
+
+	// The ID of the webhook is the last segment of its URL.
+	plan.ID = types.StringValue(webhook.Self[strings.LastIndex(webhook.Self, "/")+1:])
+	plan.ExcludeBody = types.BoolValue(webhook.ExcludeBody)
+
+	tflog.Debug(ctx, "Storing webhook into the state", map[string]interface{}{
+		"createNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraWebhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading webhook resource")
+
+	var state jiraWebhookResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded webhook from state", map[string]interface{}{
+		"readState": fmt.Sprintf("%+v", state),
+	})
+
+	request, err := r.p.jira.NewRequest(ctx, http.MethodGet, fmt.Sprintf("rest/webhooks/1.0/webhook/%s", state.ID.ValueString()), "", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create webhook request, got error: %s", err))
+		return
+	}
+	webhook := new(jiraWebhook)
+	res, err := r.p.jira.Call(request, webhook)
+	if res != nil && res.Code == http.StatusNotFound {
+		tflog.Warn(ctx, "Unable to find webhook in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get webhook, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Retrieved webhook from API state")
+
+	state.Name = types.StringValue(webhook.Name)
+	state.Url = types.StringValue(webhook.Url)
+	state.JqlFilter = types.StringValue(webhook.Filters[jiraWebhookJqlFilter])
+	state.Events, _ = types.SetValueFrom(ctx, types.StringType, webhook.Events)
+	state.ExcludeBody = types.BoolValue(webhook.ExcludeBody)
+
+	tflog.Debug(ctx, "Storing webhook into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", state),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraWebhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating webhook resource")
+
+	var plan jiraWebhookResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded webhook plan", map[string]interface{}{
+		"updatePlan": fmt.Sprintf("%+v", plan),
+	})
+
+	var state jiraWebhookResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded webhook from state", map[string]interface{}{
+		"updateState": fmt.Sprintf("%+v", state),
+	})
+
+	payload := &jiraWebhook{}
+	resp.Diagnostics.Append(expandJiraWebhook(ctx, plan, payload)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	webhook, err := r.callWebhook(ctx, http.MethodPut, fmt.Sprintf("rest/webhooks/1.0/webhook/%s", state.ID.ValueString()), payload)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update webhook, got error: %s", err))
+		return
+	}
+	tflog.Debug(ctx, "Updated webhook in API state")
+
+	plan.ID = state.ID
+	plan.ExcludeBody = types.BoolValue(webhook.ExcludeBody)
+
+	tflog.Debug(ctx, "Storing webhook into the state", map[string]interface{}{
+		"updateNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraWebhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting webhook resource")
+
+	var state jiraWebhookResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded webhook from state")
+
+	request, err := r.p.jira.NewRequest(ctx, http.MethodDelete, fmt.Sprintf("rest/webhooks/1.0/webhook/%s", state.ID.ValueString()), "", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create webhook request, got error: %s", err))
+		return
+	}
+	res, err := r.p.jira.Call(request, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete webhook, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Deleted webhook from API state")
+
+	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
+}
+
+// callWebhook sends the webhook to the admin webhook endpoint and returns the webhook in the response.
+func (r *jiraWebhookResource) callWebhook(ctx context.Context, method, endpoint string, payload *jiraWebhook) (*jiraWebhook, error) {
+	request, err := r.p.jira.NewRequest(ctx, method, endpoint, "", payload)
+	if err != nil {
+		return nil, err
+	}
+	webhook := new(jiraWebhook)
+	res, err := r.p.jira.Call(request, webhook)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		return nil, fmt.Errorf("%s\n%s", err, resBody)
+	}
+	if webhook.Self == "" {
+		return nil, fmt.Errorf("the response does not contain the URL of the webhook")
+	}
+	return webhook, nil
+}
+
+// expandJiraWebhook sets the payload of the admin webhook endpoints from the plan.
+func expandJiraWebhook(ctx context.Context, plan jiraWebhookResourceModel, webhook *jiraWebhook) diag.Diagnostics {
+	diags := plan.Events.ElementsAs(ctx, &webhook.Events, false)
+	webhook.Name = plan.Name.ValueString()
+	webhook.Url = plan.Url.ValueString()
+	webhook.Filters = map[string]string{
+		jiraWebhookJqlFilter: plan.JqlFilter.ValueString(),
+	}
+	webhook.ExcludeBody = plan.ExcludeBody.ValueBool()
+	return diags
+}
@@ -0,0 +1,58 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraWebhook_Basic(t *testing.T) {
+	projectKey := strings.ToUpper(acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
+	randomName := acctest.RandomWithPrefix("tf-test-webhook")
+	resourceName := "atlassian_jira_webhook.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebhookConfig_basic(resourceName, randomName, fmt.Sprintf("project = %s", projectKey)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", randomName),
+					resource.TestCheckResourceAttr(resourceName, "jql_filter", fmt.Sprintf("project = %s", projectKey)),
+					resource.TestCheckResourceAttr(resourceName, "events.#", "2"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "events.*", "jira:issue_created"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "events.*", "jira:issue_updated"),
+					resource.TestCheckResourceAttr(resourceName, "exclude_body", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccWebhookConfig_basic(resourceName, randomName+"-updated", fmt.Sprintf("project = %s AND issuetype = Bug", projectKey)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", randomName+"-updated"),
+					resource.TestCheckResourceAttr(resourceName, "jql_filter", fmt.Sprintf("project = %s AND issuetype = Bug", projectKey)),
+				),
+			},
+		},
+	})
+}
+
+func testAccWebhookConfig_basic(resourceName, name, jqlFilter string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		name       = %[3]q
+		url        = "https://example.com/webhook"
+		jql_filter = %[4]q
+		events     = ["jira:issue_created", "jira:issue_updated"]
+	}
+	`, splits[0], splits[1], name, jqlFilter)
+}
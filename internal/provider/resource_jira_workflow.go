@@ -0,0 +1,586 @@
+package atlassian
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openscientia/terraform-provider-atlassian/internal/provider/planmodifiers/stringmodifiers"
+	"github.com/openscientia/terraform-provider-atlassian/internal/provider/validators"
+)
+
+type (
+	jiraWorkflowResource struct {
+		p atlassianProvider
+	}
+
+	jiraWorkflowResourceModel struct {
+		ID          types.String                  `tfsdk:"id"`
+		Name        types.String                  `tfsdk:"name"`
+		Description types.String                  `tfsdk:"description"`
+		Statuses    []jiraWorkflowStatusModel     `tfsdk:"statuses"`
+		Transitions []jiraWorkflowTransitionModel `tfsdk:"transitions"`
+	}
+
+	jiraWorkflowStatusModel struct {
+		ID types.String `tfsdk:"id"`
+	}
+
+	jiraWorkflowTransitionModel struct {
+		Name        types.String                      `tfsdk:"name"`
+		Description types.String                      `tfsdk:"description"`
+		From        types.List                        `tfsdk:"from"`
+		To          types.String                      `tfsdk:"to"`
+		Type        types.String                      `tfsdk:"type"`
+		ScreenId    types.String                      `tfsdk:"screen_id"`
+		Rules       *jiraWorkflowTransitionRulesModel `tfsdk:"rules"`
+	}
+
+	jiraWorkflowTransitionRulesModel struct {
+		Conditions    types.String                      `tfsdk:"conditions"`
+		Validators    []jiraWorkflowTransitionRuleModel `tfsdk:"validators"`
+		PostFunctions []jiraWorkflowTransitionRuleModel `tfsdk:"post_functions"`
+	}
+
+	jiraWorkflowTransitionRuleModel struct {
+		Type          types.String `tfsdk:"type"`
+		Configuration types.String `tfsdk:"configuration"`
+	}
+)
+
+var (
+	_ resource.Resource                   = (*jiraWorkflowResource)(nil)
+	_ resource.ResourceWithImportState    = (*jiraWorkflowResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*jiraWorkflowResource)(nil)
+)
+
+func NewJiraWorkflowResource() resource.Resource {
+	return &jiraWorkflowResource{}
+}
+
+func (*jiraWorkflowResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_workflow"
+}
+
+func (*jiraWorkflowResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Workflow Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The entity ID of the workflow.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The name of the workflow. " +
+					"The name must be unique. The maximum length is 255 characters.",
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The description of the workflow. The maximum length is 1000 characters.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringmodifiers.DefaultValue(""),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(1000),
+				},
+			},
+			"statuses": schema.ListNestedAttribute{
+				MarkdownDescription: "(Forces new) The statuses of the workflow. Every status used by a transition must be included.",
+				Required:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the status.",
+							Required:            true,
+						},
+					},
+				},
+			},
+			"transitions": schema.ListNestedAttribute{
+				MarkdownDescription: "(Forces new) The transitions of the workflow. " +
+					"Exactly one `initial` transition is required.",
+				Required: true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the transition. The maximum length is 60 characters.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtMost(60),
+							},
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The description of the transition. The maximum length is 1000 characters.",
+							Optional:            true,
+							Computed:            true,
+							PlanModifiers: []planmodifier.String{
+								stringmodifiers.DefaultValue(""),
+							},
+							Validators: []validator.String{
+								stringvalidator.LengthAtMost(1000),
+							},
+						},
+						"from": schema.ListAttribute{
+							MarkdownDescription: "The IDs of the statuses the transition can start from. Must not be set for `initial` and `global` transitions.",
+							Optional:            true,
+							ElementType:         types.StringType,
+						},
+						"to": schema.StringAttribute{
+							MarkdownDescription: "The ID of the status the transition goes to.",
+							Required:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of the transition. Can be one of: `initial`, `directed` or `global`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("initial", "directed", "global"),
+							},
+						},
+						"screen_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the screen shown for the transition.",
+							Optional:            true,
+							Computed:            true,
+							PlanModifiers: []planmodifier.String{
+								stringmodifiers.DefaultValue(""),
+							},
+						},
+						"rules": schema.SingleNestedAttribute{
+							MarkdownDescription: "The rules of the transition. Changes made to the rules outside of Terraform are not detected, " +
+								"as Jira adds default post functions to every transition.",
+							Optional: true,
+							Attributes: map[string]schema.Attribute{
+								"conditions": schema.StringAttribute{
+									MarkdownDescription: "The conditions tree of the transition as a JSON object, e.g. encoded with `jsonencode`. " +
+										"Either a single condition with `type` and `configuration`, or a group of conditions with `operator` (`AND` or `OR`) and `conditions`.",
+									Optional: true,
+									Validators: []validator.String{
+										validators.Json(),
+									},
+								},
+								"validators": schema.ListNestedAttribute{
+									MarkdownDescription: "The validators of the transition.",
+									Optional:            true,
+									NestedObject:        workflowTransitionRuleNestedObject(),
+								},
+								"post_functions": schema.ListNestedAttribute{
+									MarkdownDescription: "The post functions of the transition.",
+									Optional:            true,
+									NestedObject:        workflowTransitionRuleNestedObject(),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func workflowTransitionRuleNestedObject() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The type of the rule, e.g. `FieldRequiredValidator` or `UpdateIssueFieldFunction`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"configuration": schema.StringAttribute{
+				MarkdownDescription: "The configuration of the rule as a JSON object, e.g. encoded with `jsonencode`.",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.Json(),
+				},
+			},
+		},
+	}
+}
+
+func (*jiraWorkflowResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var statuses, transitions types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("statuses"), &statuses)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("transitions"), &transitions)...)
+	if resp.Diagnostics.HasError() || statuses.IsUnknown() || transitions.IsUnknown() {
+		return
+	}
+
+	var statusModels []jiraWorkflowStatusModel
+	var transitionModels []jiraWorkflowTransitionModel
+	resp.Diagnostics.Append(statuses.ElementsAs(ctx, &statusModels, false)...)
+	resp.Diagnostics.Append(transitions.ElementsAs(ctx, &transitionModels, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Status references can only be checked once every status ID is known.
+	statusIds := map[string]bool{}
+	for _, s := range statusModels {
+		if s.ID.IsUnknown() {
+			statusIds = nil
+			break
+		}
+		statusIds[s.ID.ValueString()] = true
+	}
+
+	for i, t := range transitionModels {
+		transitionPath := path.Root("transitions").AtListIndex(i)
+
+		if !t.Type.IsUnknown() && t.Type.ValueString() != "directed" && len(t.From.Elements()) > 0 {
+			resp.Diagnostics.AddAttributeError(transitionPath.AtName("from"),
+				fmt.Sprintf("%q transitions must not have a value for \"from\" attribute", t.Type.ValueString()),
+				"A value can only be provided if \"type\" is: directed")
+		}
+
+		if statusIds == nil {
+			continue
+		}
+		if !t.To.IsUnknown() && !statusIds[t.To.ValueString()] {
+			resp.Diagnostics.AddAttributeError(transitionPath.AtName("to"),
+				"Undeclared status in \"to\" attribute",
+				fmt.Sprintf("Status %q must be included in \"statuses\".", t.To.ValueString()))
+		}
+		if t.From.IsUnknown() {
+			continue
+		}
+		for j, from := range t.From.Elements() {
+			id, ok := from.(types.String)
+			if !ok || id.IsUnknown() || id.IsNull() {
+				continue
+			}
+			if !statusIds[id.ValueString()] {
+				resp.Diagnostics.AddAttributeError(transitionPath.AtName("from").AtListIndex(j),
+					"Undeclared status in \"from\" attribute",
+					fmt.Sprintf("Status %q must be included in \"statuses\".", id.ValueString()))
+			}
+		}
+	}
+}
+
+func (r *jiraWorkflowResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.p.jira = client
+}
+
+func (*jiraWorkflowResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
+
+func (r *jiraWorkflowResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating workflow resource")
+
+	var plan jiraWorkflowResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded workflow plan", map[string]interface{}{
+		"createPlan": fmt.Sprintf("%+v", plan),
+	})
+
+	payload := &models.WorkflowPayloadScheme{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+	}
+	for _, s := range plan.Statuses {
+		payload.Statuses = append(payload.Statuses, &models.WorkflowTransitionScreenScheme{
+			ID: s.ID.ValueString(),
+		})
+	}
+	for i, t := range plan.Transitions {
+		transition := &models.WorkflowTransitionPayloadScheme{
+			Name:        t.Name.ValueString(),
+			Description: t.Description.ValueString(),
+			To:          t.To.ValueString(),
+			Type:        t.Type.ValueString(),
+		}
+		resp.Diagnostics.Append(t.From.ElementsAs(ctx, &transition.From, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if t.ScreenId.ValueString() != "" {
+			transition.Screen = &models.WorkflowTransitionScreenPayloadScheme{
+				ID: t.ScreenId.ValueString(),
+			}
+		}
+		if t.Rules != nil {
+			rules, err := workflowTransitionRulesPayload(t.Rules)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(path.Root("transitions").AtListIndex(i).AtName("rules").AtName("conditions"),
+					"Unable to parse value of \"conditions\" attribute.", err.Error())
+				return
+			}
+			transition.Rules = rules
+		}
+		payload.Transitions = append(payload.Transitions, transition)
+	}
+
+	workflow, res, err := r.p.jira.Workflow.Create(ctx, payload)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create workflow, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Created workflow in API state")
+
+	plan.ID = types.StringValue(workflow.EntityID)
+
+	tflog.Debug(ctx, "Storing workflow into the state", map[string]interface{}{
+		"createNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraWorkflowResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading workflow resource")
+
+	var state jiraWorkflowResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded workflow from state", map[string]interface{}{
+		"readState": fmt.Sprintf("%+v", state),
+	})
+
+	options := &models.WorkflowSearchOptions{
+		WorkflowName: []string{state.Name.ValueString()},
+		Expand:       []string{"transitions", "statuses"},
+	}
+	// Workflows used by a workflow scheme assigned to a project are active, so both active and inactive workflows are searched.
+	workflows, err := searchJiraWorkflows(ctx, r.p.jira, options)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	var workflow *models.WorkflowScheme
+	for _, w := range workflows {
+		if w.ID != nil && w.ID.Name == state.Name.ValueString() {
+			workflow = w
+			break
+		}
+	}
+	if workflow == nil {
+		tflog.Debug(ctx, "Workflow not found, removing from the state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	tflog.Debug(ctx, "Retrieved workflow from API state", map[string]interface{}{
+		"workflow": fmt.Sprintf("%+v", workflow.ID),
+	})
+
+	state.ID = types.StringValue(workflow.ID.EntityID)
+	state.Name = types.StringValue(workflow.ID.Name)
+	state.Description = types.StringValue(workflow.Description)
+
+	// Jira does not return statuses and transitions in the order they were created,
+	// so they are ordered as in the prior state to avoid replacing the workflow.
+	var statusIds []string
+	for _, s := range state.Statuses {
+		statusIds = append(statusIds, s.ID.ValueString())
+	}
+	workflowStatuses := workflow.Statuses
+	statusPosition := priorPosition(statusIds)
+	sort.SliceStable(workflowStatuses, func(i, j int) bool {
+		return statusPosition(workflowStatuses[i].ID) < statusPosition(workflowStatuses[j].ID)
+	})
+	var statuses []jiraWorkflowStatusModel
+	for _, s := range workflowStatuses {
+		statuses = append(statuses, jiraWorkflowStatusModel{
+			ID: types.StringValue(s.ID),
+		})
+	}
+
+	var transitionKeys []string
+	priorTransitions := map[string]jiraWorkflowTransitionModel{}
+	for _, t := range state.Transitions {
+		key := workflowTransitionKey(t.Name.ValueString(), t.To.ValueString())
+		transitionKeys = append(transitionKeys, key)
+		priorTransitions[key] = t
+	}
+	workflowTransitions := workflow.Transitions
+	transitionPosition := priorPosition(transitionKeys)
+	sort.SliceStable(workflowTransitions, func(i, j int) bool {
+		return transitionPosition(workflowTransitionKey(workflowTransitions[i].Name, workflowTransitions[i].To)) <
+			transitionPosition(workflowTransitionKey(workflowTransitions[j].Name, workflowTransitions[j].To))
+	})
+
+	var transitions []jiraWorkflowTransitionModel
+	for _, t := range workflowTransitions {
+		transition := jiraWorkflowTransitionModel{
+			Name:        types.StringValue(t.Name),
+			Description: types.StringValue(t.Description),
+			From:        types.ListNull(types.StringType),
+			To:          types.StringValue(t.To),
+			Type:        types.StringValue(t.Type),
+			ScreenId:    types.StringValue(""),
+		}
+		prior, hasPrior := priorTransitions[workflowTransitionKey(t.Name, t.To)]
+		if len(t.From) > 0 {
+			var priorFrom []string
+			if hasPrior {
+				resp.Diagnostics.Append(prior.From.ElementsAs(ctx, &priorFrom, false)...)
+			}
+			fromPosition := priorPosition(priorFrom)
+			sort.SliceStable(t.From, func(i, j int) bool {
+				return fromPosition(t.From[i]) < fromPosition(t.From[j])
+			})
+			from, diags := types.ListValueFrom(ctx, types.StringType, t.From)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			transition.From = from
+		}
+		if t.Screen != nil {
+			transition.ScreenId = types.StringValue(t.Screen.ID)
+		}
+		// Jira adds default post functions to every transition, so the configured rules are kept as is.
+		if hasPrior {
+			transition.Rules = prior.Rules
+		}
+		transitions = append(transitions, transition)
+	}
+	state.Statuses = statuses
+	state.Transitions = transitions
+
+	tflog.Debug(ctx, "Storing workflow into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", state),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraWorkflowResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// The RequiresReplace plan modifier will trigger Terraform to destroy and recreate the resource
+	// if any of the attributes changes, as workflows cannot be modified through the Jira Cloud REST API.
+	tflog.Debug(ctx, "If the value of any attribute changes, Terraform will destroy and recreate the resource")
+}
+
+func (r *jiraWorkflowResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting workflow resource")
+
+	var state jiraWorkflowResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded workflow from state")
+
+	res, err := r.p.jira.Workflow.Delete(ctx, state.ID.ValueString())
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete workflow, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Deleted workflow from API state")
+
+	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
+}
+
+func workflowTransitionRulesPayload(m *jiraWorkflowTransitionRulesModel) (*models.WorkflowTransitionRulePayloadScheme, error) {
+	rules := &models.WorkflowTransitionRulePayloadScheme{}
+	if !m.Conditions.IsNull() {
+		rules.Conditions = new(models.WorkflowConditionScheme)
+		if err := json.Unmarshal([]byte(m.Conditions.ValueString()), rules.Conditions); err != nil {
+			return nil, err
+		}
+	}
+	for _, v := range m.Validators {
+		rules.Validators = append(rules.Validators, workflowTransitionRulePayload(v))
+	}
+	for _, f := range m.PostFunctions {
+		rules.PostFunctions = append(rules.PostFunctions, workflowTransitionRulePayload(f))
+	}
+	return rules, nil
+}
+
+func workflowTransitionRulePayload(m jiraWorkflowTransitionRuleModel) *models.WorkflowTransitionRuleScheme {
+	rule := &models.WorkflowTransitionRuleScheme{
+		Type: m.Type.ValueString(),
+	}
+	if !m.Configuration.IsNull() {
+		rule.Configuration = json.RawMessage(m.Configuration.ValueString())
+	}
+	return rule
+}
+
+// workflowTransitionKey identifies a transition of a workflow by its name and target status.
+func workflowTransitionKey(name, to string) string {
+	return name + "\x00" + to
+}
+
+// priorPosition returns a function which gives the position of a key in prior.
+// Keys which are not in prior are positioned after all keys which are.
+func priorPosition(prior []string) func(key string) int {
+	index := map[string]int{}
+	for i, k := range prior {
+		if _, ok := index[k]; !ok {
+			index[k] = i
+		}
+	}
+	return func(key string) int {
+		if i, ok := index[key]; ok {
+			return i
+		}
+		return len(prior)
+	}
+}
@@ -0,0 +1,446 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/ctreminiom/go-atlassian/pkg/infra/models"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openscientia/terraform-provider-atlassian/internal/provider/planmodifiers/boolmodifiers"
+	"github.com/openscientia/terraform-provider-atlassian/internal/provider/planmodifiers/stringmodifiers"
+)
+
+type (
+	jiraWorkflowSchemeResource struct {
+		p atlassianProvider
+	}
+
+	jiraWorkflowSchemeResourceModel struct {
+		ID                  types.String                           `tfsdk:"id"`
+		Name                types.String                           `tfsdk:"name"`
+		Description         types.String                           `tfsdk:"description"`
+		DefaultWorkflow     types.String                           `tfsdk:"default_workflow"`
+		IssueTypeMappings   types.Map                              `tfsdk:"issue_type_mappings"`
+		UpdateDraftIfNeeded types.Bool                             `tfsdk:"update_draft_if_needed"`
+		PublishDraft        types.Bool                             `tfsdk:"publish_draft"`
+		DraftStatusMappings []jiraWorkflowSchemeStatusMappingModel `tfsdk:"draft_status_mappings"`
+		Draft               types.Bool                             `tfsdk:"draft"`
+		DeletionProtection  types.Bool                             `tfsdk:"deletion_protection"`
+	}
+
+	jiraWorkflowSchemeStatusMappingModel struct {
+		IssueTypeId types.String `tfsdk:"issue_type_id"`
+		StatusId    types.String `tfsdk:"status_id"`
+		NewStatusId types.String `tfsdk:"new_status_id"`
+	}
+
+	// jiraWorkflowSchemeDetails holds the response of the "Get workflow scheme" endpoint,
+	// which includes the issue type mappings missing from models.WorkflowSchemeScheme.
+	jiraWorkflowSchemeDetails struct {
+		ID                int               `json:"id,omitempty"`
+		Name              string            `json:"name,omitempty"`
+		Description       string            `json:"description,omitempty"`
+		DefaultWorkflow   string            `json:"defaultWorkflow,omitempty"`
+		IssueTypeMappings map[string]string `json:"issueTypeMappings,omitempty"`
+		Draft             bool              `json:"draft,omitempty"`
+	}
+
+	// The go-atlassian library does not support publishing draft workflow schemes.
+	jiraWorkflowSchemeStatusMapping struct {
+		IssueTypeId string `json:"issueTypeId"`
+		StatusId    string `json:"statusId"`
+		NewStatusId string `json:"newStatusId"`
+	}
+)
+
+var (
+	_ resource.Resource                = (*jiraWorkflowSchemeResource)(nil)
+	_ resource.ResourceWithImportState = (*jiraWorkflowSchemeResource)(nil)
+)
+
+func NewJiraWorkflowSchemeResource() resource.Resource {
+	return &jiraWorkflowSchemeResource{}
+}
+
+func (*jiraWorkflowSchemeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_workflow_scheme"
+}
+
+func (*jiraWorkflowSchemeResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Workflow Scheme Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the workflow scheme.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the workflow scheme. " +
+					"The name must be unique. The maximum length is 255 characters.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the workflow scheme.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringmodifiers.DefaultValue(""),
+				},
+			},
+			"default_workflow": schema.StringAttribute{
+				MarkdownDescription: "The name of the default workflow for the workflow scheme. " +
+					"The default workflow has all the unassigned issue types assigned to it. Defaults to `jira`.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringmodifiers.DefaultValue("jira"),
+				},
+			},
+			"issue_type_mappings": schema.MapAttribute{
+				MarkdownDescription: "The issue type to workflow mappings, where each mapping is an issue type ID and workflow name pair.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"update_draft_if_needed": schema.BoolAttribute{
+				MarkdownDescription: "Whether to create or update a draft workflow scheme when updating an active workflow scheme. " +
+					"An active workflow scheme is one that is used by at least one project. " +
+					"If `false`, updating an active workflow scheme fails. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolmodifiers.DefaultValue(false),
+				},
+			},
+			"publish_draft": schema.BoolAttribute{
+				MarkdownDescription: "Whether to publish the draft workflow scheme created by `update_draft_if_needed`, " +
+					"which applies the changes to the projects using the workflow scheme. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.Bool{
+					boolvalidator.AlsoRequires(path.MatchRoot("update_draft_if_needed")),
+				},
+				PlanModifiers: []planmodifier.Bool{
+					boolmodifiers.DefaultValue(false),
+				},
+			},
+			"draft_status_mappings": schema.ListNestedAttribute{
+				MarkdownDescription: "The mappings of the statuses of the current workflows to the statuses of the new workflows, used to migrate issues when publishing the draft. " +
+					"A mapping is required for every status, with issues, that is missing from the new workflow of its issue type.",
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"issue_type_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the issue type the mapping applies to.",
+							Required:            true,
+						},
+						"status_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the status in the current workflow.",
+							Required:            true,
+						},
+						"new_status_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the status in the new workflow.",
+							Required:            true,
+						},
+					},
+				},
+			},
+			"draft": schema.BoolAttribute{
+				MarkdownDescription: "Whether the workflow scheme in the state is a draft of an active workflow scheme.",
+				Computed:            true,
+			},
+			"deletion_protection": schema.BoolAttribute{
+				MarkdownDescription: "Whether Terraform is prevented from destroying the workflow scheme. " +
+					"The value must be set to `false` and applied before the workflow scheme can be destroyed or replaced. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolmodifiers.DefaultValue(false),
+				},
+			},
+		},
+	}
+}
+
+func (r *jiraWorkflowSchemeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.p.jira = client
+}
+
+func (*jiraWorkflowSchemeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *jiraWorkflowSchemeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating workflow scheme resource")
+
+	var plan jiraWorkflowSchemeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded workflow scheme plan", map[string]interface{}{
+		"createPlan": fmt.Sprintf("%+v", plan),
+	})
+
+	issueTypeMappings := map[string]string{}
+	resp.Diagnostics.Append(plan.IssueTypeMappings.ElementsAs(ctx, &issueTypeMappings, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	payload := &models.WorkflowSchemePayloadScheme{
+		Name:              plan.Name.ValueString(),
+		Description:       plan.Description.ValueString(),
+		DefaultWorkflow:   plan.DefaultWorkflow.ValueString(),
+		IssueTypeMappings: issueTypeMappings,
+	}
+
+	workflowScheme, res, err := r.p.jira.Workflow.Scheme.Create(ctx, payload)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create workflow scheme, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Created workflow scheme in API state")
+
+	plan.ID = types.StringValue(strconv.Itoa(workflowScheme.ID))
+	plan.Draft = types.BoolValue(false)
+
+	tflog.Debug(ctx, "Storing workflow scheme into the state", map[string]interface{}{
+		"createNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraWorkflowSchemeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading workflow scheme resource")
+
+	var state jiraWorkflowSchemeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded workflow scheme from state", map[string]interface{}{
+		"readState": fmt.Sprintf("%+v", state),
+	})
+
+	if state.UpdateDraftIfNeeded.IsNull() {
+		state.UpdateDraftIfNeeded = types.BoolValue(false)
+	}
+	if state.PublishDraft.IsNull() {
+		state.PublishDraft = types.BoolValue(false)
+	}
+	if state.DeletionProtection.IsNull() {
+		state.DeletionProtection = types.BoolValue(false)
+	}
+
+	// Changes to an active workflow scheme are stored in its draft, so the draft is read back if one exists.
+	endpoint := fmt.Sprintf("rest/api/3/workflowscheme/%s?returnDraftIfExists=%t", state.ID.ValueString(), state.UpdateDraftIfNeeded.ValueBool())
+	request, err := r.p.jira.NewRequest(ctx, http.MethodGet, endpoint, "", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create workflow scheme request, got error: %s", err))
+		return
+	}
+
+	workflowScheme := new(jiraWorkflowSchemeDetails)
+	res, err := r.p.jira.Call(request, workflowScheme)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get workflow scheme, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Retrieved workflow scheme from API state", map[string]interface{}{
+		"readApiState": fmt.Sprintf("%+v", workflowScheme),
+	})
+
+	state.Name = types.StringValue(workflowScheme.Name)
+	state.Description = types.StringValue(workflowScheme.Description)
+	state.DefaultWorkflow = types.StringValue(workflowScheme.DefaultWorkflow)
+	state.Draft = types.BoolValue(workflowScheme.Draft)
+	if len(workflowScheme.IssueTypeMappings) > 0 {
+		issueTypeMappings, diags := types.MapValueFrom(ctx, types.StringType, workflowScheme.IssueTypeMappings)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.IssueTypeMappings = issueTypeMappings
+	} else {
+		state.IssueTypeMappings = types.MapNull(types.StringType)
+	}
+
+	tflog.Debug(ctx, "Storing workflow scheme into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", state),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraWorkflowSchemeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating workflow scheme resource")
+
+	var plan jiraWorkflowSchemeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded workflow scheme plan", map[string]interface{}{
+		"updatePlan": fmt.Sprintf("%+v", plan),
+	})
+
+	var state jiraWorkflowSchemeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded workflow scheme from state", map[string]interface{}{
+		"updateState": fmt.Sprintf("%+v", state),
+	})
+
+	// An empty map must be sent to remove all existing issue type mappings.
+	// ElementsAs sets the map to nil for a null value, which would be sent as null instead.
+	issueTypeMappings := map[string]string{}
+	if !plan.IssueTypeMappings.IsNull() {
+		resp.Diagnostics.Append(plan.IssueTypeMappings.ElementsAs(ctx, &issueTypeMappings, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	workflowSchemeId, _ := strconv.Atoi(state.ID.ValueString())
+	payload := &models.WorkflowSchemePayloadScheme{
+		Name:                plan.Name.ValueString(),
+		Description:         plan.Description.ValueString(),
+		DefaultWorkflow:     plan.DefaultWorkflow.ValueString(),
+		IssueTypeMappings:   issueTypeMappings,
+		UpdateDraftIfNeeded: plan.UpdateDraftIfNeeded.ValueBool(),
+	}
+
+	workflowScheme, res, err := r.p.jira.Workflow.Scheme.Update(ctx, workflowSchemeId, payload)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update workflow scheme, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Updated workflow scheme in API state")
+
+	plan.ID = state.ID
+	plan.Draft = types.BoolValue(workflowScheme.Draft)
+
+	if workflowScheme.Draft && plan.PublishDraft.ValueBool() {
+		if err := r.publishDraft(ctx, state.ID.ValueString(), plan.DraftStatusMappings); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to publish draft workflow scheme, got error: %s", err))
+			return
+		}
+		tflog.Debug(ctx, "Published draft workflow scheme in API state")
+
+		plan.Draft = types.BoolValue(false)
+	}
+
+	tflog.Debug(ctx, "Storing workflow scheme into the state", map[string]interface{}{
+		"updateNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraWorkflowSchemeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting workflow scheme resource")
+
+	var state jiraWorkflowSchemeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded workflow scheme from state")
+
+	if state.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError("Deletion Protection Enabled",
+			"Unable to delete workflow scheme, because \"deletion_protection\" is set to true. Set it to false and apply the configuration before destroying the workflow scheme.")
+		return
+	}
+
+	workflowSchemeId, _ := strconv.Atoi(state.ID.ValueString())
+	res, err := r.p.jira.Workflow.Scheme.Delete(ctx, workflowSchemeId)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete workflow scheme, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Deleted workflow scheme from API state")
+
+	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
+}
+
+// publishDraft publishes the draft of an active workflow scheme and waits for the issues to be migrated.
+func (r *jiraWorkflowSchemeResource) publishDraft(ctx context.Context, workflowSchemeId string, statusMappings []jiraWorkflowSchemeStatusMappingModel) error {
+	payload := map[string][]jiraWorkflowSchemeStatusMapping{
+		"statusMappings": {},
+	}
+	for _, m := range statusMappings {
+		payload["statusMappings"] = append(payload["statusMappings"], jiraWorkflowSchemeStatusMapping{
+			IssueTypeId: m.IssueTypeId.ValueString(),
+			StatusId:    m.StatusId.ValueString(),
+			NewStatusId: m.NewStatusId.ValueString(),
+		})
+	}
+	request, err := r.p.jira.NewRequest(ctx, http.MethodPost, fmt.Sprintf("rest/api/3/workflowscheme/%s/draft/publish", workflowSchemeId), "", payload)
+	if err != nil {
+		return err
+	}
+	res, err := r.p.jira.Call(request, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		return fmt.Errorf("%s\n%s", err, resBody)
+	}
+
+	// Publishing a draft workflow scheme is an asynchronous operation.
+	if taskId := taskIdFromResponse(res); taskId != "" {
+		return waitForJiraTask(ctx, r.p.jira, taskId)
+	}
+	return nil
+}
@@ -0,0 +1,231 @@
+package atlassian
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraWorkflowScheme_Basic(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-workflow-scheme")
+	resourceName := "atlassian_jira_workflow_scheme.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkflowSchemeConfig_basic(resourceName, randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", randomName),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "default_workflow", "jira"),
+					resource.TestCheckNoResourceAttr(resourceName, "issue_type_mappings"),
+					resource.TestCheckResourceAttr(resourceName, "update_draft_if_needed", "false"),
+					resource.TestCheckResourceAttr(resourceName, "publish_draft", "false"),
+					resource.TestCheckNoResourceAttr(resourceName, "draft_status_mappings"),
+					resource.TestCheckResourceAttr(resourceName, "draft", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccJiraWorkflowScheme_Description(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-workflow-scheme")
+	resourceName := "atlassian_jira_workflow_scheme.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkflowSchemeConfig_description(resourceName, randomName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+				),
+			},
+			{
+				Config: testAccWorkflowSchemeConfig_description(resourceName, randomName, "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJiraWorkflowScheme_IssueTypeMappings(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-workflow-scheme")
+	resourceName := "atlassian_jira_workflow_scheme.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkflowSchemeConfig_issueTypeMappings(resourceName, randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "issue_type_mappings.%", "1"),
+				),
+			},
+			{
+				Config: testAccWorkflowSchemeConfig_issueTypeMappingsRemoved(resourceName, randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(resourceName, "issue_type_mappings"),
+				),
+			},
+			{
+				Config: testAccWorkflowSchemeConfig_basic(resourceName, randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr(resourceName, "issue_type_mappings"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJiraWorkflowScheme_PublishDraft(t *testing.T) {
+	randomKey := strings.ToUpper(acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
+	randomName := acctest.RandomWithPrefix("tf-test-workflow-scheme")
+	resourceName := "atlassian_jira_workflow_scheme.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkflowSchemeConfig_publishDraft(resourceName, randomKey, randomName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "publish_draft", "true"),
+					resource.TestCheckResourceAttr(resourceName, "draft", "false"),
+				),
+			},
+			{
+				Config: testAccWorkflowSchemeConfig_publishDraft(resourceName, randomKey, randomName, "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+					resource.TestCheckResourceAttr(resourceName, "draft", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJiraWorkflowScheme_DeletionProtection(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-workflow-scheme")
+	resourceName := "atlassian_jira_workflow_scheme.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkflowSchemeConfig_deletionProtection(resourceName, randomName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "true"),
+				),
+			},
+			{
+				Config:      testAccWorkflowSchemeConfig_deletionProtection(resourceName, randomName, true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("Deletion Protection Enabled"),
+			},
+			{
+				Config: testAccWorkflowSchemeConfig_deletionProtection(resourceName, randomName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccWorkflowSchemeConfig_basic(resourceName, name string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		name = %[3]q
+	}
+	`, splits[0], splits[1], name)
+}
+
+func testAccWorkflowSchemeConfig_description(resourceName, name, description string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		name        = %[3]q
+		description = %[4]q
+	}
+	`, splits[0], splits[1], name, description)
+}
+
+func testAccWorkflowSchemeConfig_issueTypeMappings(resourceName, name string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	resource "atlassian_jira_issue_type" "test" {
+		name = %[3]q
+	}
+
+	resource %[1]q %[2]q {
+		name = %[3]q
+		issue_type_mappings = {
+			(atlassian_jira_issue_type.test.id) = "jira"
+		}
+	}
+	`, splits[0], splits[1], name)
+}
+
+func testAccWorkflowSchemeConfig_issueTypeMappingsRemoved(resourceName, name string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	resource "atlassian_jira_issue_type" "test" {
+		name = %[3]q
+	}
+
+	resource %[1]q %[2]q {
+		name = %[3]q
+	}
+	`, splits[0], splits[1], name)
+}
+
+func testAccWorkflowSchemeConfig_publishDraft(resourceName, key, name, description string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	data "atlassian_jira_myself" "test" {}
+
+	resource "atlassian_jira_project" "test" {
+		key              = %[3]q
+		name             = %[4]q
+		lead_account_id  = data.atlassian_jira_myself.test.account_id
+		project_type_key = "software"
+	}
+
+	resource %[1]q %[2]q {
+		name                   = %[4]q
+		description            = %[5]q
+		update_draft_if_needed = true
+		publish_draft          = true
+	}
+
+	resource "atlassian_jira_project_workflow_scheme" "test" {
+		project_id         = atlassian_jira_project.test.id
+		workflow_scheme_id = %[1]s.%[2]s.id
+	}
+	`, splits[0], splits[1], key, name, description)
+}
+
+func testAccWorkflowSchemeConfig_deletionProtection(resourceName, name string, deletionProtection bool) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		name                = %[3]q
+		deletion_protection = %[4]t
+	}
+	`, splits[0], splits[1], name, deletionProtection)
+}
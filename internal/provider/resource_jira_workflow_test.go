@@ -0,0 +1,334 @@
+package atlassian
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraWorkflow_Basic(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-workflow")
+	resourceName := "atlassian_jira_workflow.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkflowConfig_basic(resourceName, randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", randomName),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+					resource.TestCheckResourceAttr(resourceName, "statuses.#", "2"),
+					resource.TestCheckResourceAttrPair(resourceName, "statuses.0.id", "atlassian_jira_status.todo", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "statuses.1.id", "atlassian_jira_status.done", "id"),
+					resource.TestCheckResourceAttr(resourceName, "transitions.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "transitions.0.name", "Create"),
+					resource.TestCheckResourceAttr(resourceName, "transitions.0.type", "initial"),
+					resource.TestCheckResourceAttr(resourceName, "transitions.1.name", "Done"),
+					resource.TestCheckResourceAttr(resourceName, "transitions.1.type", "directed"),
+					resource.TestCheckResourceAttr(resourceName, "transitions.1.from.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "transitions.1.to", "atlassian_jira_status.done", "id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     randomName,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccJiraWorkflow_Active(t *testing.T) {
+	randomKey := strings.ToUpper(acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
+	randomName := acctest.RandomWithPrefix("tf-test-workflow")
+	resourceName := "atlassian_jira_workflow.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkflowConfig_active(resourceName, randomKey, randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", randomName),
+					resource.TestCheckResourceAttrPair("atlassian_jira_workflow_scheme.test", "default_workflow", resourceName, "name"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateId:     randomName,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccJiraWorkflow_Description(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-workflow")
+	resourceName := "atlassian_jira_workflow.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkflowConfig_description(resourceName, randomName, "description1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "description1"),
+				),
+			},
+			{
+				Config: testAccWorkflowConfig_description(resourceName, randomName, "description2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "description", "description2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJiraWorkflow_Rules(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-workflow")
+	resourceName := "atlassian_jira_workflow.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkflowConfig_rules(resourceName, randomName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckNoResourceAttr(resourceName, "transitions.0.rules"),
+					resource.TestCheckResourceAttr(resourceName, "transitions.1.rules.conditions", `{"conditions":[{"type":"AllowOnlyAssigneeCondition"}],"operator":"AND"}`),
+					resource.TestCheckResourceAttr(resourceName, "transitions.1.rules.validators.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "transitions.1.rules.validators.0.type", "FieldRequiredValidator"),
+					resource.TestCheckResourceAttr(resourceName, "transitions.1.rules.post_functions.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "transitions.1.rules.post_functions.0.type", "UpdateIssueFieldFunction"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccJiraWorkflow_InitialTransitionWithFrom(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-workflow")
+	resourceName := "atlassian_jira_workflow.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccWorkflowConfig_initialTransitionWithFrom(resourceName, randomName),
+				ExpectError: regexp.MustCompile(`"initial" transitions must not have a value for "from" attribute`),
+			},
+		},
+	})
+}
+
+func TestAccJiraWorkflow_UndeclaredStatus(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-workflow")
+	resourceName := "atlassian_jira_workflow.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccWorkflowConfig_undeclaredStatus(resourceName, randomName),
+				ExpectError: regexp.MustCompile(`Status "3" must be included in "statuses"`),
+			},
+		},
+	})
+}
+
+func testAccWorkflowConfig_statuses(name string) string {
+	return fmt.Sprintf(`
+	resource "atlassian_jira_status" "todo" {
+		name            = "%[1]s-todo"
+		status_category = "TODO"
+		status_scope = {
+			type = "GLOBAL"
+		}
+	}
+
+	resource "atlassian_jira_status" "done" {
+		name            = "%[1]s-done"
+		status_category = "DONE"
+		status_scope = {
+			type = "GLOBAL"
+		}
+	}
+	`, name)
+}
+
+func testAccWorkflowConfig_basic(resourceName, name string) string {
+	splits := strings.Split(resourceName, ".")
+	return testAccWorkflowConfig_statuses(name) + fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		name = %[3]q
+		statuses = [
+			{ id = atlassian_jira_status.todo.id },
+			{ id = atlassian_jira_status.done.id },
+		]
+		transitions = [
+			{
+				name = "Create"
+				to   = atlassian_jira_status.todo.id
+				type = "initial"
+			},
+			{
+				name = "Done"
+				from = [atlassian_jira_status.todo.id]
+				to   = atlassian_jira_status.done.id
+				type = "directed"
+			},
+		]
+	}
+	`, splits[0], splits[1], name)
+}
+
+func testAccWorkflowConfig_rules(resourceName, name string) string {
+	splits := strings.Split(resourceName, ".")
+	return testAccWorkflowConfig_statuses(name) + fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		name = %[3]q
+		statuses = [
+			{ id = atlassian_jira_status.todo.id },
+			{ id = atlassian_jira_status.done.id },
+		]
+		transitions = [
+			{
+				name = "Create"
+				to   = atlassian_jira_status.todo.id
+				type = "initial"
+			},
+			{
+				name = "Done"
+				from = [atlassian_jira_status.todo.id]
+				to   = atlassian_jira_status.done.id
+				type = "directed"
+				rules = {
+					conditions = jsonencode({
+						operator   = "AND"
+						conditions = [{ type = "AllowOnlyAssigneeCondition" }]
+					})
+					validators = [
+						{
+							type = "FieldRequiredValidator"
+							configuration = jsonencode({
+								ignoreContext = true
+								errorMessage  = "A resolution is required"
+								fieldIds      = ["resolution"]
+							})
+						},
+					]
+					post_functions = [
+						{
+							type = "UpdateIssueFieldFunction"
+							configuration = jsonencode({
+								fieldId    = "assignee"
+								fieldValue = ""
+							})
+						},
+					]
+				}
+			},
+		]
+	}
+	`, splits[0], splits[1], name)
+}
+
+func testAccWorkflowConfig_description(resourceName, name, description string) string {
+	splits := strings.Split(resourceName, ".")
+	return testAccWorkflowConfig_statuses(name) + fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		name        = %[3]q
+		description = %[4]q
+		statuses = [
+			{ id = atlassian_jira_status.todo.id },
+		]
+		transitions = [
+			{
+				name = "Create"
+				to   = atlassian_jira_status.todo.id
+				type = "initial"
+			},
+		]
+	}
+	`, splits[0], splits[1], name, description)
+}
+
+func testAccWorkflowConfig_initialTransitionWithFrom(resourceName, name string) string {
+	splits := strings.Split(resourceName, ".")
+	return testAccWorkflowConfig_statuses(name) + fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		name = %[3]q
+		statuses = [
+			{ id = atlassian_jira_status.todo.id },
+			{ id = atlassian_jira_status.done.id },
+		]
+		transitions = [
+			{
+				name = "Create"
+				from = [atlassian_jira_status.done.id]
+				to   = atlassian_jira_status.todo.id
+				type = "initial"
+			},
+		]
+	}
+	`, splits[0], splits[1], name)
+}
+
+func testAccWorkflowConfig_undeclaredStatus(resourceName, name string) string {
+	splits := strings.Split(resourceName, ".")
+	return fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		name = %[3]q
+		statuses = [
+			{ id = "1" },
+		]
+		transitions = [
+			{
+				name = "Create"
+				to   = "1"
+				type = "initial"
+			},
+			{
+				name = "Done"
+				from = ["1"]
+				to   = "3"
+				type = "directed"
+			},
+		]
+	}
+	`, splits[0], splits[1], name)
+}
+
+func testAccWorkflowConfig_active(resourceName, key, name string) string {
+	return testAccWorkflowConfig_basic(resourceName, name) + fmt.Sprintf(`
+	data "atlassian_jira_myself" "test" {}
+
+	resource "atlassian_jira_project" "test" {
+		key              = %[1]q
+		name             = %[2]q
+		lead_account_id  = data.atlassian_jira_myself.test.account_id
+		project_type_key = "software"
+	}
+
+	resource "atlassian_jira_workflow_scheme" "test" {
+		name             = %[2]q
+		default_workflow = %[3]s.name
+	}
+
+	resource "atlassian_jira_project_workflow_scheme" "test" {
+		project_id         = atlassian_jira_project.test.id
+		workflow_scheme_id = atlassian_jira_workflow_scheme.test.id
+	}
+	`, key, name, resourceName)
+}
@@ -0,0 +1,338 @@
+package atlassian
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	jira "github.com/ctreminiom/go-atlassian/jira/v3"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openscientia/terraform-provider-atlassian/internal/provider/planmodifiers/stringmodifiers"
+)
+
+type (
+	jiraWorkflowTransitionPropertyResource struct {
+		p atlassianProvider
+	}
+
+	jiraWorkflowTransitionPropertyResourceModel struct {
+		ID           types.String `tfsdk:"id"`
+		WorkflowName types.String `tfsdk:"workflow_name"`
+		TransitionId types.String `tfsdk:"transition_id"`
+		Key          types.String `tfsdk:"key"`
+		Value        types.String `tfsdk:"value"`
+		WorkflowMode types.String `tfsdk:"workflow_mode"`
+	}
+
+	// The go-atlassian library does not support workflow transition properties.
+	jiraWorkflowTransitionProperty struct {
+		ID    string `json:"id,omitempty"`
+		Key   string `json:"key,omitempty"`
+		Value string `json:"value"`
+	}
+)
+
+var (
+	_ resource.Resource                = (*jiraWorkflowTransitionPropertyResource)(nil)
+	_ resource.ResourceWithImportState = (*jiraWorkflowTransitionPropertyResource)(nil)
+)
+
+func NewJiraWorkflowTransitionPropertyResource() resource.Resource {
+	return &jiraWorkflowTransitionPropertyResource{}
+}
+
+func (*jiraWorkflowTransitionPropertyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_jira_workflow_transition_property"
+}
+
+func (*jiraWorkflowTransitionPropertyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Version:             1,
+		MarkdownDescription: "Jira Workflow Transition Property Resource",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the workflow transition property. " +
+					"It is computed using `workflow_name`, `transition_id` and `key` separated by a slash (`/`).",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workflow_name": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The name of the workflow that the transition belongs to.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"transition_id": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The ID of the transition, e.g. `11`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The key of the transition property, e.g. `jira.permission.comment.group` or `opsbar-sequence`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "The value of the transition property.",
+				Required:            true,
+			},
+			"workflow_mode": schema.StringAttribute{
+				MarkdownDescription: "(Forces new) The workflow status. Can be one of: `live` or `draft`. Defaults to `live`. " +
+					"Properties of active workflows can only be changed on their `draft`.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("live", "draft"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringmodifiers.DefaultValue("live"),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *jiraWorkflowTransitionPropertyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*jira.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jira.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.p.jira = client
+}
+
+func (*jiraWorkflowTransitionPropertyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, "/")
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: workflow_name/transition_id/key. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workflow_name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("transition_id"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), idParts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workflow_mode"), "live")...)
+}
+
+func (r *jiraWorkflowTransitionPropertyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Debug(ctx, "Creating workflow transition property resource")
+
+	var plan jiraWorkflowTransitionPropertyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded workflow transition property plan", map[string]interface{}{
+		"createPlan": fmt.Sprintf("%+v", plan),
+	})
+
+	if err := r.setTransitionProperty(ctx, http.MethodPost, &plan); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create workflow transition property, got error: %s", err))
+		return
+	}
+	tflog.Debug(ctx, "Created workflow transition property")
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s/%s/%s", plan.WorkflowName.ValueString(), plan.TransitionId.ValueString(), plan.Key.ValueString()))
+
+	tflog.Debug(ctx, "Storing workflow transition property into the state", map[string]interface{}{
+		"createNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraWorkflowTransitionPropertyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Debug(ctx, "Reading workflow transition property resource")
+
+	var state jiraWorkflowTransitionPropertyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded workflow transition property from state", map[string]interface{}{
+		"readState": fmt.Sprintf("%+v", state),
+	})
+
+	request, err := r.p.jira.NewRequest(ctx, http.MethodGet, transitionPropertyEndpoint(&state), "", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create workflow transition property request, got error: %s", err))
+		return
+	}
+	// The API returns either a single property or a list of properties, so the response is decoded below.
+	res, err := r.p.jira.Call(request, nil)
+	if res != nil && res.Code == http.StatusNotFound {
+		tflog.Warn(ctx, "Unable to find workflow transition property in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get workflow transition property, got error: %s\n%s", err, resBody))
+		return
+	}
+	var properties []jiraWorkflowTransitionProperty
+	if err := json.Unmarshal(res.Bytes.Bytes(), &properties); err != nil {
+		var property jiraWorkflowTransitionProperty
+		if err := json.Unmarshal(res.Bytes.Bytes(), &property); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to decode workflow transition property, got error: %s", err))
+			return
+		}
+		properties = append(properties, property)
+	}
+	tflog.Debug(ctx, "Retrieved workflow transition property from API state")
+
+	var property *jiraWorkflowTransitionProperty
+	for i, p := range properties {
+		if p.Key == state.Key.ValueString() {
+			property = &properties[i]
+			break
+		}
+	}
+	if property == nil {
+		tflog.Warn(ctx, "Unable to find workflow transition property in API state, deleting resource from state")
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Value = types.StringValue(property.Value)
+
+	tflog.Debug(ctx, "Storing workflow transition property into the state", map[string]interface{}{
+		"readNewState": fmt.Sprintf("%+v", state),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *jiraWorkflowTransitionPropertyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating workflow transition property resource")
+
+	var plan jiraWorkflowTransitionPropertyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded workflow transition property plan", map[string]interface{}{
+		"updatePlan": fmt.Sprintf("%+v", plan),
+	})
+
+	var state jiraWorkflowTransitionPropertyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded workflow transition property from state", map[string]interface{}{
+		"updateState": fmt.Sprintf("%+v", state),
+	})
+
+	if err := r.setTransitionProperty(ctx, http.MethodPut, &plan); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update workflow transition property, got error: %s", err))
+		return
+	}
+	tflog.Debug(ctx, "Updated workflow transition property in API state")
+
+	plan.ID = state.ID
+
+	tflog.Debug(ctx, "Storing workflow transition property into the state", map[string]interface{}{
+		"updateNewState": fmt.Sprintf("%+v", plan),
+	})
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *jiraWorkflowTransitionPropertyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Debug(ctx, "Deleting workflow transition property resource")
+
+	var state jiraWorkflowTransitionPropertyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Loaded workflow transition property from state")
+
+	request, err := r.p.jira.NewRequest(ctx, http.MethodDelete, transitionPropertyEndpoint(&state), "", nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create workflow transition property request, got error: %s", err))
+		return
+	}
+	res, err := r.p.jira.Call(request, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete workflow transition property, got error: %s\n%s", err, resBody))
+		return
+	}
+	tflog.Debug(ctx, "Deleted workflow transition property from API state")
+
+	// If a Resource type Delete method is completed without error, the framework will automatically remove the resource.
+}
+
+func (r *jiraWorkflowTransitionPropertyResource) setTransitionProperty(ctx context.Context, method string, plan *jiraWorkflowTransitionPropertyResourceModel) error {
+	payload := &jiraWorkflowTransitionProperty{
+		Value: plan.Value.ValueString(),
+	}
+	request, err := r.p.jira.NewRequest(ctx, method, transitionPropertyEndpoint(plan), "", payload)
+	if err != nil {
+		return err
+	}
+	res, err := r.p.jira.Call(request, nil)
+	if err != nil {
+		var resBody string
+		if res != nil {
+			resBody = res.Bytes.String()
+		}
+		return fmt.Errorf("%s\n%s", err, resBody)
+	}
+	return nil
+}
+
+func transitionPropertyEndpoint(m *jiraWorkflowTransitionPropertyResourceModel) string {
+	params := url.Values{}
+	params.Add("key", m.Key.ValueString())
+	params.Add("workflowName", m.WorkflowName.ValueString())
+	params.Add("workflowMode", m.WorkflowMode.ValueString())
+	return fmt.Sprintf("rest/api/3/workflow/transitions/%s/properties?%s", url.PathEscape(m.TransitionId.ValueString()), params.Encode())
+}
@@ -0,0 +1,55 @@
+package atlassian
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccJiraWorkflowTransitionProperty_Basic(t *testing.T) {
+	randomName := acctest.RandomWithPrefix("tf-test-workflow")
+	resourceName := "atlassian_jira_workflow_transition_property.test"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkflowTransitionPropertyConfig_basic(resourceName, randomName, "10"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", randomName+"/11/opsbar-sequence"),
+					resource.TestCheckResourceAttr(resourceName, "workflow_name", randomName),
+					resource.TestCheckResourceAttr(resourceName, "transition_id", "11"),
+					resource.TestCheckResourceAttr(resourceName, "key", "opsbar-sequence"),
+					resource.TestCheckResourceAttr(resourceName, "value", "10"),
+					resource.TestCheckResourceAttr(resourceName, "workflow_mode", "live"),
+				),
+			},
+			{
+				Config: testAccWorkflowTransitionPropertyConfig_basic(resourceName, randomName, "20"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "value", "20"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccWorkflowTransitionPropertyConfig_basic(resourceName, name, value string) string {
+	splits := strings.Split(resourceName, ".")
+	return testAccWorkflowConfig_basic("atlassian_jira_workflow.test", name) + fmt.Sprintf(`
+	resource %[1]q %[2]q {
+		workflow_name = atlassian_jira_workflow.test.name
+		transition_id = "11"
+		key           = "opsbar-sequence"
+		value         = %[3]q
+	}
+	`, splits[0], splits[1], value)
+}
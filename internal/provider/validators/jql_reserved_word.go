@@ -0,0 +1,73 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ validator.String = (*notJqlReservedWordValidator)(nil)
+
+// jqlReservedWords are the words that Jira reserves for JQL, see
+// https://support.atlassian.com/jira-software-cloud/docs/use-advanced-search-with-jira-query-language-jql/#Reserved-words
+var jqlReservedWords = []string{
+	"abort", "access", "add", "after", "alias", "all", "alter", "and", "any", "as", "asc", "audit", "avg",
+	"before", "begin", "between", "boolean", "break", "by", "byte",
+	"catch", "cf", "char", "character", "check", "checkpoint", "collate", "collation", "column", "commit", "connect", "continue", "count", "create", "current",
+	"date", "decimal", "declare", "decrement", "default", "defaults", "define", "delete", "delimiter", "desc", "difference", "distinct", "divide", "do", "double", "drop",
+	"else", "empty", "encoding", "end", "equals", "escape", "exclusive", "exec", "execute", "exists", "explain",
+	"false", "fetch", "file", "field", "first", "float", "for", "from", "function",
+	"go", "goto", "grant", "greater", "group",
+	"having",
+	"identified", "if", "immediate", "in", "increment", "index", "initial", "inner", "inout", "input", "insert", "int", "integer", "intersect", "intersection", "into", "is", "isempty", "isnull",
+	"join",
+	"last", "left", "less", "like", "limit", "lock", "long",
+	"max", "min", "minus", "mode", "modify", "modulo", "more", "multiply",
+	"next", "noaudit", "not", "notin", "nowait", "null", "number",
+	"object", "of", "on", "option", "or", "order", "outer", "output",
+	"power", "previous", "prior", "privileges", "public",
+	"raise", "raw", "remainder", "rename", "resource", "return", "returns", "revoke", "right", "row", "rowid", "rownum", "rows",
+	"select", "session", "set", "share", "size", "sqrt", "start", "strict", "string", "subtract", "sum", "synonym",
+	"table", "then", "to", "trans", "transaction", "trigger", "true",
+	"uid", "union", "unique", "update", "user",
+	"validate", "values", "view",
+	"when", "whenever", "where", "while", "with",
+}
+
+type notJqlReservedWordValidator struct{}
+
+func (v notJqlReservedWordValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v notJqlReservedWordValidator) MarkdownDescription(_ context.Context) string {
+	return "Must not be a JQL reserved word"
+}
+
+func (v notJqlReservedWordValidator) ValidateString(ctx context.Context, req validator.StringRequest, res *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	tflog.Debug(ctx, "Validating attribute value is not a JQL reserved word", map[string]interface{}{
+		"attribute": req.Path.String(),
+	})
+
+	for _, w := range jqlReservedWords {
+		if strings.EqualFold(req.ConfigValue.ValueString(), w) {
+			res.Diagnostics.AddAttributeError(
+				req.Path,
+				"Invalid Attribute Value",
+				fmt.Sprintf("Value %q is a JQL reserved word", req.ConfigValue.ValueString()),
+			)
+			return
+		}
+	}
+}
+
+func NotJqlReservedWord() validator.String {
+	return notJqlReservedWordValidator{}
+}
@@ -0,0 +1,44 @@
+package validators
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ validator.String = (*jsonValidator)(nil)
+
+type jsonValidator struct{}
+
+func (v jsonValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v jsonValidator) MarkdownDescription(_ context.Context) string {
+	return "Must be a valid JSON document"
+}
+
+func (v jsonValidator) ValidateString(ctx context.Context, req validator.StringRequest, res *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	tflog.Debug(ctx, "Validating attribute value is a JSON document", map[string]interface{}{
+		"attribute": req.Path.String(),
+	})
+
+	if !json.Valid([]byte(req.ConfigValue.ValueString())) {
+		res.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSON",
+			fmt.Sprintf("Value %q is not a valid JSON document", req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+func Json() validator.String {
+	return jsonValidator{}
+}
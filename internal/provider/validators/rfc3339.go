@@ -0,0 +1,44 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ validator.String = (*rfc3339Validator)(nil)
+
+type rfc3339Validator struct{}
+
+func (v rfc3339Validator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v rfc3339Validator) MarkdownDescription(_ context.Context) string {
+	return "Must be a timestamp in RFC 3339 format"
+}
+
+func (v rfc3339Validator) ValidateString(ctx context.Context, req validator.StringRequest, res *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	tflog.Debug(ctx, "Validating attribute value is an RFC 3339 timestamp", map[string]interface{}{
+		"attribute": req.Path.String(),
+	})
+
+	if _, err := time.Parse(time.RFC3339, req.ConfigValue.ValueString()); err != nil {
+		res.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid RFC 3339 timestamp",
+			fmt.Sprintf("Value %q is not a valid RFC 3339 timestamp, e.g. 2006-01-02T15:04:05Z", req.ConfigValue.ValueString()),
+		)
+	}
+}
+
+func Rfc3339() validator.String {
+	return rfc3339Validator{}
+}